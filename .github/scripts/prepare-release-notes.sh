@@ -103,7 +103,7 @@ cat > "${OUTPUT_FILE}" <<EOF
 
 ### Installation
 \`\`\`bash
-kubectl apply -f https://github.com/konflux-ci/konflux-ci/releases/download/${VERSION}/install.yaml
+kubectl apply --server-side -f https://github.com/konflux-ci/konflux-ci/releases/download/${VERSION}/install.yaml
 \`\`\`
 
 ### Image
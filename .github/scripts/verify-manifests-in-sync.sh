@@ -7,7 +7,7 @@
 #
 # Chart versions come from export-third-party-chart-env.sh (same pins as the scheduled update workflow).
 #
-# Requires: kustomize, helm, yq on PATH (same expectation as other workflows that
+# Requires: kustomize, helm, yq, jq on PATH (same expectation as other workflows that
 # invoke kustomize / update-third-party-manifests.sh without installing tools).
 set -euo pipefail
 
@@ -46,6 +46,14 @@ for dir in "${REPO_ROOT}/operator/upstream-kustomizations"/*; do
   else
     echo "  OK ${component}"
   fi
+  # The build time differs between builds; everything else must match.
+  bash "${REPO_ROOT}/operator/pkg/manifests/provenance.sh" "${REPO_ROOT}" "${component}" |
+    jq 'del(.buildTime)' >"${tmp}"
+  if ! jq 'del(.buildTime)' "${REPO_ROOT}/operator/pkg/manifests/${component}/provenance.json" |
+      diff -u - "${tmp}" >&2; then
+    echo "❌ Manifest provenance out of date for component: ${component}" >&2
+    fail=true
+  fi
   rm -f "${tmp}"
 done
 
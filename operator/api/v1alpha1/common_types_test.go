@@ -0,0 +1,65 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestLoggingSpecMerge(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewWithT(t)
+
+	var unset *LoggingSpec
+	g.Expect(unset.Merge(nil)).To(gomega.BeNil())
+	g.Expect((&LoggingSpec{}).Merge(&LoggingSpec{})).To(gomega.BeNil())
+
+	global := &LoggingSpec{Level: LogLevelInfo, Encoder: LogEncoderJSON}
+	g.Expect(unset.Merge(global)).To(gomega.Equal(global))
+	g.Expect(unset.Merge(global)).NotTo(gomega.BeIdenticalTo(global))
+	g.Expect((&LoggingSpec{Level: LogLevelDebug}).Merge(global)).To(gomega.Equal(
+		&LoggingSpec{Level: LogLevelDebug, Encoder: LogEncoderJSON}))
+	g.Expect((&LoggingSpec{Encoder: LogEncoderConsole}).Merge(nil)).To(gomega.Equal(
+		&LoggingSpec{Encoder: LogEncoderConsole}))
+}
+
+func TestGetLoggingFallsBackToDeprecatedFields(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewWithT(t)
+
+	buildService := KonfluxBuildServiceConfigSpec{LogEncoder: LogEncoderConsole}
+	g.Expect(buildService.GetLogging()).To(gomega.Equal(&LoggingSpec{Encoder: LogEncoderConsole}))
+
+	buildService.Logging = &LoggingSpec{Level: LogLevelDebug, Encoder: LogEncoderJSON}
+	g.Expect(buildService.GetLogging()).To(gomega.Equal(&LoggingSpec{Level: LogLevelDebug, Encoder: LogEncoderJSON}))
+
+	namespaceLister := KonfluxNamespaceListerSpec{LogLevel: LogLevelWarn}
+	g.Expect(namespaceLister.GetLogging()).To(gomega.Equal(&LoggingSpec{Level: LogLevelWarn}))
+	g.Expect((&KonfluxImageControllerConfigSpec{}).GetLogging()).To(gomega.BeNil())
+}
+
+func TestControllerManagerDeploymentSpecGetReplicas(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewWithT(t)
+
+	var spec *ControllerManagerDeploymentSpec
+	g.Expect(spec.GetReplicas()).To(gomega.Equal(int32(1)))
+	g.Expect((&ControllerManagerDeploymentSpec{}).GetReplicas()).To(gomega.Equal(int32(1)))
+	g.Expect((&ControllerManagerDeploymentSpec{Replicas: 3}).GetReplicas()).To(gomega.Equal(int32(3)))
+}
@@ -29,3 +29,29 @@ type ConditionAccessor interface {
 	GetConditions() []metav1.Condition
 	SetConditions(conditions []metav1.Condition)
 }
+
+// DriftAccessor is implemented by component CRs that report drifted resources in their Status.
+// +kubebuilder:object:generate=false
+type DriftAccessor interface {
+	ConditionAccessor
+	GetDriftedResources() []DriftedResource
+	SetDriftedResources(resources []DriftedResource)
+}
+
+// OperatorVersionAccessor is implemented by component CRs that report which operator version
+// last applied their manifests.
+// +kubebuilder:object:generate=false
+type OperatorVersionAccessor interface {
+	ConditionAccessor
+	GetOperatorVersion() string
+	SetOperatorVersion(version string)
+}
+
+// ManifestProvenanceAccessor is implemented by component CRs that report the provenance of
+// the manifests last applied for them.
+// +kubebuilder:object:generate=false
+type ManifestProvenanceAccessor interface {
+	ConditionAccessor
+	GetManifestProvenance() *ManifestProvenance
+	SetManifestProvenance(provenance *ManifestProvenance)
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// v1alpha1 is the storage version and the conversion hub for the kinds that are also
+// served as v1beta1. The spokes implement conversion.Convertible against these types.
+
+// Hub marks Konflux as a conversion hub.
+func (*Konflux) Hub() {}
+
+// Hub marks KonfluxIntegrationService as a conversion hub.
+func (*KonfluxIntegrationService) Hub() {}
+
+// Hub marks KonfluxNamespaceLister as a conversion hub.
+func (*KonfluxNamespaceLister) Hub() {}
+
+// Hub marks KonfluxRBAC as a conversion hub.
+func (*KonfluxRBAC) Hub() {}
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -90,13 +91,86 @@ type KonfluxSpec struct {
 	// +optional
 	Telemetry *TelemetryConfig `json:"telemetry,omitempty"`
 
+	// CLI configures the CLI component.
+	// +optional
+	CLI *CLIConfig `json:"cli,omitempty"`
+
 	// ComponentMetrics controls Prometheus scrape resources for metrics-enabled components
 	// (see operator/docs/component-monitoring.md#scope). When disabled, those reconcilers
 	// do not apply ServiceMonitor, metrics-reader RBAC, or operand scrape-token resources.
 	// +optional
 	ComponentMetrics *ComponentMetricsConfig `json:"componentMetrics,omitempty"`
+
+	// PodPlacement schedules the pods of every component Deployment and CronJob, for example
+	// onto dedicated infra nodes. Components can override it with their own podPlacement.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches change objects rendered from the embedded manifests before they are applied,
+	// for settings that the typed API does not cover. Each patch is forwarded to the component
+	// whose manifests contain its target; patches that match no object are reported in the
+	// PatchesMatched condition.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of every component to the registries that
+	// mirror them, for clusters that cannot pull from the upstream registries. The longest
+	// matching source wins. Images of the embedded manifests that match no source are listed
+	// in status.unmirroredImages.
+	// +optional
+	// +listType=map
+	// +listMapKey=source
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePullSecrets are Secrets with registry credentials for the component images. The
+	// operator copies them into the namespaces of the components and adds them to the pod
+	// templates and ServiceAccounts. Their names must be unique.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ImagePullSecrets []ImagePullSecretReference `json:"imagePullSecrets,omitempty"`
+
+	// ImagePullPolicy replaces the imagePullPolicy of every container of the components.
+	// When empty, the policies of the manifests are kept.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// Proxy sets the egress proxy of every container of the components, for clusters that
+	// reach external services such as GitHub or Quay only through a proxy. When not set, the
+	// cluster-wide Proxy of OpenShift is used; set it to {} to disable that.
+	// +optional
+	Proxy *EgressProxySpec `json:"proxy,omitempty"`
+
+	// Logging sets the log level and format of every component that supports them. The
+	// components with a spec accept a logging override, for example to enable debug logs
+	// of a single service.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+
+	// Profile sizes the components for a kind of installation: the resources of their
+	// containers, their replicas, and with them leader election and PodDisruptionBudgets.
+	// Settings of the components take precedence over the profile. When omitted, the sizing
+	// of the manifests is kept.
+	// +optional
+	Profile KonfluxProfile `json:"profile,omitempty"`
 }
 
+// KonfluxProfile names a curated sizing of the Konflux components.
+// +kubebuilder:validation:Enum=dev;small;production-ha
+type KonfluxProfile string
+
+const (
+	// ProfileDev keeps the footprint minimal, for local and CI clusters.
+	ProfileDev KonfluxProfile = "dev"
+	// ProfileSmall runs one replica of each component, for small teams.
+	ProfileSmall KonfluxProfile = "small"
+	// ProfileProductionHA runs two replicas of each component, with leader election and
+	// PodDisruptionBudgets, and sizes them for production load.
+	ProfileProductionHA KonfluxProfile = "production-ha"
+)
+
 // ImageControllerConfig defines the configuration for the image-controller component.
 // The Enabled field controls whether the component is deployed (top-level concern).
 // The Spec field is the runtime configuration passed to the KonfluxImageController CR.
@@ -112,40 +186,70 @@ type ImageControllerConfig struct {
 }
 
 // KonfluxUIConfig defines the configuration for the UI component.
+// The Enabled field controls whether the component is deployed.
 // The Spec field is the runtime configuration passed to the component.
 type KonfluxUIConfig struct {
+	// Enabled controls whether the UI is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Spec configures the UI component (excludes componentMetrics; see spec.componentMetrics).
 	// +optional
 	Spec *KonfluxUIConfigSpec `json:"spec,omitempty"`
 }
 
 // IntegrationServiceConfig defines the configuration for the integration-service component.
+// The Enabled field controls whether the component is deployed.
 // The Spec field is the runtime configuration passed to the component.
 type IntegrationServiceConfig struct {
+	// Enabled controls whether integration-service is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Spec configures the integration-service component (excludes componentMetrics; see spec.componentMetrics).
 	// +optional
 	Spec *KonfluxIntegrationServiceConfigSpec `json:"spec,omitempty"`
 }
 
 // ReleaseServiceConfig defines the configuration for the release-service component.
+// The Enabled field controls whether the component is deployed.
 // The Spec field is the runtime configuration passed to the component.
 type ReleaseServiceConfig struct {
+	// Enabled controls whether release-service is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Spec configures the release-service component (excludes componentMetrics; see spec.componentMetrics).
 	// +optional
 	Spec *KonfluxReleaseServiceConfigSpec `json:"spec,omitempty"`
 }
 
 // BuildServiceConfig defines the configuration for the build-service component.
+// The Enabled field controls whether the component is deployed.
 // The Spec field is the runtime configuration passed to the component.
 type BuildServiceConfig struct {
+	// Enabled controls whether build-service is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Spec configures the build-service component (excludes componentMetrics; see spec.componentMetrics).
 	// +optional
 	Spec *KonfluxBuildServiceConfigSpec `json:"spec,omitempty"`
 }
 
 // NamespaceListerConfig defines the configuration for the namespace-lister component.
+// The Enabled field controls whether the component is deployed.
 // The Spec field is the runtime configuration passed to the component.
 type NamespaceListerConfig struct {
+	// Enabled controls whether namespace-lister is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Spec configures the namespace-lister component.
 	// +optional
 	Spec *KonfluxNamespaceListerSpec `json:"spec,omitempty"`
@@ -153,6 +257,11 @@ type NamespaceListerConfig struct {
 
 // EnterpriseContractConfig defines the configuration for the enterprise-contract component.
 type EnterpriseContractConfig struct {
+	// Enabled controls whether enterprise-contract is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// SkipPolicies disables deployment of EnterpriseContractPolicy resources.
 	// When true, only CRDs, namespace, RBAC, and ConfigMap are deployed;
 	// users are expected to manage policies externally.
@@ -162,6 +271,11 @@ type EnterpriseContractConfig struct {
 
 // CertManagerConfig defines the configuration for the cert-manager component.
 type CertManagerConfig struct {
+	// Enabled controls whether the cert-manager component is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// CreateClusterIssuer controls whether cluster issuer resources are created.
 	// Defaults to true if not specified.
 	// +optional
@@ -175,6 +289,10 @@ type InternalRegistryConfig struct {
 	// Defaults to false if not specified.
 	// +optional
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the internal registry component.
+	// +optional
+	Spec *KonfluxInternalRegistrySpec `json:"spec,omitempty"`
 }
 
 // DefaultTenantConfig defines the configuration for the default tenant component.
@@ -222,13 +340,27 @@ type TelemetryConfig struct {
 }
 
 // KonfluxInfoConfig defines the configuration for the info component.
+// The Enabled field controls whether the component is deployed.
 // The Spec field is the runtime configuration passed to the component.
 type KonfluxInfoConfig struct {
+	// Enabled controls whether the info component is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Spec configures the info component.
 	// +optional
 	Spec *KonfluxInfoSpec `json:"spec,omitempty"`
 }
 
+// CLIConfig defines the configuration for the CLI component.
+type CLIConfig struct {
+	// Enabled controls whether the CLI component is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
 // ComponentStatus represents the status of a Konflux component.
 type ComponentStatus struct {
 	// Name of the component
@@ -238,6 +370,91 @@ type ComponentStatus struct {
 	// Message provides additional information about the component status
 	// +optional
 	Message string `json:"message,omitempty"`
+	// ObservedGeneration is the generation of the component CR that its Ready condition reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is when the component's Ready condition last changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// ManifestVersion is the operator version whose embedded manifests were last applied for the component.
+	// +optional
+	ManifestVersion string `json:"manifestVersion,omitempty"`
+	// UpstreamRevisions lists the upstream repositories and revisions the component's
+	// manifests were built from, as "<owner>/<repo>@<ref>". It is only reported once the
+	// component runs the manifests of the current operator version.
+	// +optional
+	UpstreamRevisions []string `json:"upstreamRevisions,omitempty"`
+	// ManifestSource reports where the operator loads the component's manifests from.
+	// +optional
+	ManifestSource *ManifestSourceStatus `json:"manifestSource,omitempty"`
+	// Replicas summarizes the replica availability of the component's Deployments.
+	// +optional
+	Replicas *ReplicaStatus `json:"replicas,omitempty"`
+	// Images lists the container images running in the component's pods.
+	// +optional
+	Images []RunningImage `json:"images,omitempty"`
+}
+
+// ManifestSourceStatus reports where the operator loads a component's manifests from: the
+// manifests embedded in the operator, or a source configured with the --manifest-source flag.
+type ManifestSourceStatus struct {
+	// Type is the source the manifests are loaded from.
+	// +kubebuilder:validation:Enum=Embedded;Directory;OCI
+	Type string `json:"type"`
+	// Reference is the directory or the OCI repository the manifests are loaded from.
+	// +optional
+	Reference string `json:"reference,omitempty"`
+	// Digest is the sha256 digest the manifests were verified against.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+	// FallbackReason is set when the configured source could not be loaded and the embedded
+	// manifests are used instead.
+	// +optional
+	FallbackReason string `json:"fallbackReason,omitempty"`
+}
+
+// ReplicaStatus summarizes the replicas of a component's Deployments.
+type ReplicaStatus struct {
+	// Desired is the total number of replicas requested by the Deployments.
+	Desired int32 `json:"desired"`
+	// Available is the total number of available replicas.
+	Available int32 `json:"available"`
+}
+
+// RunningImage is a container image running in a component's pods.
+type RunningImage struct {
+	// Container is the name of the container.
+	Container string `json:"container"`
+	// Image is the image reference from the pod spec.
+	Image string `json:"image"`
+	// ImageID is the image reference including the digest that is actually running,
+	// as reported by the kubelet.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+}
+
+// RolloutStatus reports the progress of the phased rollout of Konflux components.
+// A component is applied only after every component it depends on reports Ready,
+// so components are grouped into phases by the length of their dependency chain.
+type RolloutStatus struct {
+	// Phase is the first phase, starting at 1, that still has a component that is not Ready.
+	// It equals TotalPhases once every component is Ready.
+	Phase int32 `json:"phase"`
+	// TotalPhases is the number of phases in the rollout of the enabled components.
+	TotalPhases int32 `json:"totalPhases"`
+	// Blocked lists the components that were not applied because a dependency is not Ready.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Blocked []BlockedComponent `json:"blocked,omitempty"`
+}
+
+// BlockedComponent is a component whose apply is waiting on its dependencies.
+type BlockedComponent struct {
+	// Name of the component
+	Name string `json:"name"`
+	// WaitingFor lists the dependencies that are not Ready yet
+	WaitingFor []string `json:"waitingFor"`
 }
 
 // KonfluxStatus defines the observed state of Konflux.
@@ -248,19 +465,55 @@ type KonfluxStatus struct {
 
 	// Components shows the status of individual Konflux components
 	// +optional
+	// +listType=map
+	// +listMapKey=name
 	Components []ComponentStatus `json:"components,omitempty"`
 
 	// UIURL is the URL to access the Konflux UI.
 	// This is populated from the KonfluxUI status when ingress is enabled.
 	// +optional
 	UIURL string `json:"uiURL,omitempty"`
+
+	// Rollout shows the current rollout phase and which dependencies block the remaining components.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// InstalledVersion is the operator version whose manifests were last rolled out completely,
+	// including the post-upgrade migration steps.
+	// +optional
+	InstalledVersion string `json:"installedVersion,omitempty"`
+
+	// TargetVersion is the version of the running operator, which is rolled out when it
+	// differs from InstalledVersion.
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// CompletedUpgradeSteps lists the migration steps that already ran for the upgrade to
+	// TargetVersion. It is cleared once the upgrade completes.
+	// +optional
+	// +listType=set
+	CompletedUpgradeSteps []string `json:"completedUpgradeSteps,omitempty"`
+
+	// UnmirroredImages lists the images of the enabled components that match no entry of
+	// spec.imageRegistryMirrors and are still pulled from their upstream registry.
+	// +optional
+	// +listType=set
+	UnmirroredImages []string `json:"unmirroredImages,omitempty"`
+
+	// Proxy is the egress proxy applied to the components: spec.proxy or the cluster-wide
+	// Proxy of OpenShift, with noProxy extended by the in-cluster destinations.
+	// +optional
+	Proxy *EgressProxySpec `json:"proxy,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Ready status"
 // +kubebuilder:printcolumn:name="UI-URL",type="string",JSONPath=".status.uiURL",description="URL to access the Konflux UI"
+// +kubebuilder:printcolumn:name="Phase",type="integer",JSONPath=".status.rollout.phase",description="Current rollout phase",priority=1
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.installedVersion",description="Installed operator version",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'konflux'",message="Konflux CR must be named 'konflux'. Only one instance is allowed per cluster."
 
@@ -307,6 +560,65 @@ func (k *Konflux) ReadyConditionMessage() string {
 	return fmt.Sprintf("konflux Ready=%s reason=%s message=%s", cond.Status, cond.Reason, cond.Message)
 }
 
+// IsUIEnabled returns true if the UI is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsUIEnabled() bool {
+	return k.KonfluxUI == nil || isEnabledOrDefault(k.KonfluxUI.Enabled)
+}
+
+// IsIntegrationServiceEnabled returns true if integration-service is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsIntegrationServiceEnabled() bool {
+	return k.KonfluxIntegrationService == nil || isEnabledOrDefault(k.KonfluxIntegrationService.Enabled)
+}
+
+// IsReleaseServiceEnabled returns true if release-service is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsReleaseServiceEnabled() bool {
+	return k.KonfluxReleaseService == nil || isEnabledOrDefault(k.KonfluxReleaseService.Enabled)
+}
+
+// IsBuildServiceEnabled returns true if build-service is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsBuildServiceEnabled() bool {
+	return k.KonfluxBuildService == nil || isEnabledOrDefault(k.KonfluxBuildService.Enabled)
+}
+
+// IsNamespaceListerEnabled returns true if namespace-lister is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsNamespaceListerEnabled() bool {
+	return k.NamespaceLister == nil || isEnabledOrDefault(k.NamespaceLister.Enabled)
+}
+
+// IsInfoEnabled returns true if the info component is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsInfoEnabled() bool {
+	return k.KonfluxInfo == nil || isEnabledOrDefault(k.KonfluxInfo.Enabled)
+}
+
+// IsEnterpriseContractEnabled returns true if enterprise-contract is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsEnterpriseContractEnabled() bool {
+	return k.EnterpriseContract == nil || isEnabledOrDefault(k.EnterpriseContract.Enabled)
+}
+
+// IsCertManagerEnabled returns true if the cert-manager component is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsCertManagerEnabled() bool {
+	return k.CertManager == nil || isEnabledOrDefault(k.CertManager.Enabled)
+}
+
+// IsCLIEnabled returns true if the CLI component is enabled.
+// Defaults to true if not specified.
+func (k *KonfluxSpec) IsCLIEnabled() bool {
+	return k.CLI == nil || isEnabledOrDefault(k.CLI.Enabled)
+}
+
+// isEnabledOrDefault returns the value of an enabled switch of a component that is deployed by default.
+func isEnabledOrDefault(enabled *bool) bool {
+	return enabled == nil || *enabled
+}
+
 // IsImageControllerEnabled returns true if image-controller is enabled.
 // Defaults to false if not specified.
 func (k *KonfluxSpec) IsImageControllerEnabled() bool {
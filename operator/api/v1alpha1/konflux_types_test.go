@@ -91,3 +91,50 @@ func TestKonfluxSpec_IsComponentMetricsEnabled(t *testing.T) {
 		ComponentMetrics: &ComponentMetricsConfig{Enabled: &enabled},
 	}).IsComponentMetricsEnabled()).To(gomega.BeTrue())
 }
+
+func TestKonfluxSpec_DefaultOnComponentsEnabled(t *testing.T) {
+	disabled := false
+	enabled := true
+
+	tests := []struct {
+		name      string
+		isEnabled func(*KonfluxSpec) bool
+		set       func(*KonfluxSpec, *bool)
+	}{
+		{"ui", (*KonfluxSpec).IsUIEnabled,
+			func(s *KonfluxSpec, v *bool) { s.KonfluxUI = &KonfluxUIConfig{Enabled: v} }},
+		{"integrationService", (*KonfluxSpec).IsIntegrationServiceEnabled,
+			func(s *KonfluxSpec, v *bool) { s.KonfluxIntegrationService = &IntegrationServiceConfig{Enabled: v} }},
+		{"releaseService", (*KonfluxSpec).IsReleaseServiceEnabled,
+			func(s *KonfluxSpec, v *bool) { s.KonfluxReleaseService = &ReleaseServiceConfig{Enabled: v} }},
+		{"buildService", (*KonfluxSpec).IsBuildServiceEnabled,
+			func(s *KonfluxSpec, v *bool) { s.KonfluxBuildService = &BuildServiceConfig{Enabled: v} }},
+		{"namespaceLister", (*KonfluxSpec).IsNamespaceListerEnabled,
+			func(s *KonfluxSpec, v *bool) { s.NamespaceLister = &NamespaceListerConfig{Enabled: v} }},
+		{"info", (*KonfluxSpec).IsInfoEnabled,
+			func(s *KonfluxSpec, v *bool) { s.KonfluxInfo = &KonfluxInfoConfig{Enabled: v} }},
+		{"enterpriseContract", (*KonfluxSpec).IsEnterpriseContractEnabled,
+			func(s *KonfluxSpec, v *bool) { s.EnterpriseContract = &EnterpriseContractConfig{Enabled: v} }},
+		{"certManager", (*KonfluxSpec).IsCertManagerEnabled,
+			func(s *KonfluxSpec, v *bool) { s.CertManager = &CertManagerConfig{Enabled: v} }},
+		{"cli", (*KonfluxSpec).IsCLIEnabled,
+			func(s *KonfluxSpec, v *bool) { s.CLI = &CLIConfig{Enabled: v} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			g.Expect(tt.isEnabled(&KonfluxSpec{})).To(gomega.BeTrue())
+
+			spec := &KonfluxSpec{}
+			tt.set(spec, nil)
+			g.Expect(tt.isEnabled(spec)).To(gomega.BeTrue())
+
+			tt.set(spec, &enabled)
+			g.Expect(tt.isEnabled(spec)).To(gomega.BeTrue())
+
+			tt.set(spec, &disabled)
+			g.Expect(tt.isEnabled(spec)).To(gomega.BeFalse())
+		})
+	}
+}
@@ -30,6 +30,28 @@ type KonfluxCertManagerSpec struct {
 	// The cluster-Issuer will be used for generating certificates for the Konflux components
 	// +optional
 	CreateClusterIssuer *bool `json:"createClusterIssuer,omitempty"`
+
+	// Patches are applied to the objects of this component.
+	// Set by the Konflux reconciler from spec.patches on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 }
 
 // KonfluxCertManagerStatus defines the observed state of KonfluxCertManager.
@@ -37,6 +59,20 @@ type KonfluxCertManagerStatus struct {
 	// Conditions represent the latest available observations of the KonfluxCertManager state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -73,6 +109,36 @@ func (k *KonfluxCertManager) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
 
+// GetDriftedResources returns the drifted resources from the KonfluxCertManager status.
+func (k *KonfluxCertManager) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxCertManager status.
+func (k *KonfluxCertManager) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxCertManager manifests.
+func (k *KonfluxCertManager) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxCertManager manifests.
+func (k *KonfluxCertManager) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxCertManager.
+func (k *KonfluxCertManager) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxCertManager.
+func (k *KonfluxCertManager) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
+
 // ShouldCreateClusterIssuer returns true if cluster issuer resources should be created.
 // Defaults to true if not specified.
 func (k *KonfluxCertManagerSpec) ShouldCreateClusterIssuer() bool {
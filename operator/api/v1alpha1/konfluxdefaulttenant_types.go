@@ -24,13 +24,49 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // KonfluxDefaultTenantSpec defines the desired state of KonfluxDefaultTenant.
-type KonfluxDefaultTenantSpec struct{}
+type KonfluxDefaultTenantSpec struct {
+	// Patches are applied to the objects of this component.
+	// Set by the Konflux reconciler from spec.patches on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
+}
 
 // KonfluxDefaultTenantStatus defines the observed state of KonfluxDefaultTenant.
 type KonfluxDefaultTenantStatus struct {
 	// Conditions represent the latest available observations of the KonfluxDefaultTenant state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -58,6 +94,36 @@ func (k *KonfluxDefaultTenant) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
 
+// GetDriftedResources returns the drifted resources from the KonfluxDefaultTenant status.
+func (k *KonfluxDefaultTenant) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxDefaultTenant status.
+func (k *KonfluxDefaultTenant) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxDefaultTenant manifests.
+func (k *KonfluxDefaultTenant) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxDefaultTenant manifests.
+func (k *KonfluxDefaultTenant) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxDefaultTenant.
+func (k *KonfluxDefaultTenant) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxDefaultTenant.
+func (k *KonfluxDefaultTenant) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
+
 // +kubebuilder:object:root=true
 
 // KonfluxDefaultTenantList contains a list of KonfluxDefaultTenant
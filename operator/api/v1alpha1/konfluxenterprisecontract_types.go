@@ -30,6 +30,28 @@ type KonfluxEnterpriseContractSpec struct {
 	// users are expected to manage policies externally.
 	// +optional
 	SkipPolicies bool `json:"skipPolicies"`
+
+	// Patches are applied to the objects of this component.
+	// Set by the Konflux reconciler from spec.patches on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 }
 
 // KonfluxEnterpriseContractStatus defines the observed state of KonfluxEnterpriseContract.
@@ -37,6 +59,20 @@ type KonfluxEnterpriseContractStatus struct {
 	// Conditions represent the latest available observations of the KonfluxEnterpriseContract state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -72,3 +108,33 @@ func (k *KonfluxEnterpriseContract) GetConditions() []metav1.Condition {
 func (k *KonfluxEnterpriseContract) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
+
+// GetDriftedResources returns the drifted resources from the KonfluxEnterpriseContract status.
+func (k *KonfluxEnterpriseContract) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxEnterpriseContract status.
+func (k *KonfluxEnterpriseContract) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxEnterpriseContract manifests.
+func (k *KonfluxEnterpriseContract) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxEnterpriseContract manifests.
+func (k *KonfluxEnterpriseContract) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxEnterpriseContract.
+func (k *KonfluxEnterpriseContract) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxEnterpriseContract.
+func (k *KonfluxEnterpriseContract) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
@@ -44,6 +44,7 @@ type KonfluxImageControllerConfigSpec struct {
 
 	// LogEncoder sets the log encoding format for the image-controller.
 	// When not set, the upstream default (json) is used.
+	// Deprecated: use logging.encoder, which takes precedence.
 	// +optional
 	LogEncoder LogEncoder `json:"logEncoder,omitempty"`
 
@@ -63,6 +64,25 @@ type KonfluxImageControllerConfigSpec struct {
 	// This is required when using a self-hosted Quay registry with a custom CA.
 	// +optional
 	QuayCABundle *QuayCABundleSpec `json:"quayCABundle,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
 }
 
 // KonfluxImageControllerSpec defines the desired state of KonfluxImageController.
@@ -73,6 +93,22 @@ type KonfluxImageControllerSpec struct {
 	// Set by the Konflux reconciler from spec.componentMetrics on the Konflux CR.
 	// +optional
 	ComponentMetrics *ComponentMetricsConfig `json:"componentMetrics,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 }
 
 // KonfluxImageControllerStatus defines the observed state of KonfluxImageController.
@@ -80,6 +116,20 @@ type KonfluxImageControllerStatus struct {
 	// Conditions represent the latest available observations of the KonfluxImageController state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -115,3 +165,39 @@ func (k *KonfluxImageController) GetConditions() []metav1.Condition {
 func (k *KonfluxImageController) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
+
+// GetDriftedResources returns the drifted resources from the KonfluxImageController status.
+func (k *KonfluxImageController) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxImageController status.
+func (k *KonfluxImageController) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxImageController manifests.
+func (k *KonfluxImageController) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxImageController manifests.
+func (k *KonfluxImageController) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxImageController.
+func (k *KonfluxImageController) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxImageController.
+func (k *KonfluxImageController) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
+
+// GetLogging returns the logging settings of the component, with the deprecated logEncoder
+// field as fallback.
+func (s *KonfluxImageControllerConfigSpec) GetLogging() *LoggingSpec {
+	return s.Logging.Merge(&LoggingSpec{Encoder: s.LogEncoder})
+}
@@ -45,6 +45,29 @@ type KonfluxInfoSpec struct {
 	// User-provided values take precedence over auto-detected values.
 	// +optional
 	ClusterConfig *ClusterConfig `json:"clusterConfig,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 }
 
 // Banner contains banner configuration
@@ -490,6 +513,20 @@ type KonfluxInfoStatus struct {
 	// Conditions represent the latest available observations of the KonfluxInfo state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -526,6 +563,36 @@ func (k *KonfluxInfo) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
 
+// GetDriftedResources returns the drifted resources from the KonfluxInfo status.
+func (k *KonfluxInfo) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxInfo status.
+func (k *KonfluxInfo) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxInfo manifests.
+func (k *KonfluxInfo) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxInfo manifests.
+func (k *KonfluxInfo) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxInfo.
+func (k *KonfluxInfo) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxInfo.
+func (k *KonfluxInfo) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
+
 // -----------------------------------------------------------------------------
 // Spec Accessor Methods
 // These methods provide safe access to optional fields with sensible defaults,
@@ -64,6 +64,8 @@ type KonfluxIntegrationServiceConfigSpec struct {
 	// When omitted, the upstream integration-service default applies.
 	// +optional
 	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	// +kubebuilder:validation:MaxLength=10
+	// +kubebuilder:validation:XValidation:rule="int(self) <= 2147483647",message="must be at most 2147483647"
 	PRSnapshotsToKeep string `json:"prSnapshotsToKeep,omitempty"`
 
 	// NonPRSnapshotsToKeep is the number of snapshots to retain per component for non-PR
@@ -72,6 +74,8 @@ type KonfluxIntegrationServiceConfigSpec struct {
 	// When omitted, the upstream integration-service default applies.
 	// +optional
 	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	// +kubebuilder:validation:MaxLength=10
+	// +kubebuilder:validation:XValidation:rule="int(self) <= 2147483647",message="must be at most 2147483647"
 	NonPRSnapshotsToKeep string `json:"nonPRSnapshotsToKeep,omitempty"`
 
 	// MinSnapshotsToKeepPerComponent is the minimum number of snapshots to retain per component,
@@ -81,7 +85,28 @@ type KonfluxIntegrationServiceConfigSpec struct {
 	// When omitted, the upstream integration-service default applies.
 	// +optional
 	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	// +kubebuilder:validation:MaxLength=10
+	// +kubebuilder:validation:XValidation:rule="int(self) <= 2147483647",message="must be at most 2147483647"
 	MinSnapshotsToKeepPerComponent string `json:"minSnapshotsToKeepPerComponent,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
 }
 
 // KonfluxIntegrationServiceSpec defines the desired state of KonfluxIntegrationService.
@@ -92,6 +117,22 @@ type KonfluxIntegrationServiceSpec struct {
 	// Set by the Konflux reconciler from spec.componentMetrics on the Konflux CR.
 	// +optional
 	ComponentMetrics *ComponentMetricsConfig `json:"componentMetrics,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 }
 
 // KonfluxIntegrationServiceStatus defines the observed state of KonfluxIntegrationService
@@ -99,10 +140,25 @@ type KonfluxIntegrationServiceStatus struct {
 	// Conditions represent the latest available observations of the KonfluxIntegrationService state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'konflux-integration-service'",message="KonfluxIntegrationService CR must be named 'konflux-integration-service'. Only one instance is allowed per cluster."
 
@@ -134,3 +190,33 @@ func (k *KonfluxIntegrationService) GetConditions() []metav1.Condition {
 func (k *KonfluxIntegrationService) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
+
+// GetDriftedResources returns the drifted resources from the KonfluxIntegrationService status.
+func (k *KonfluxIntegrationService) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxIntegrationService status.
+func (k *KonfluxIntegrationService) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxIntegrationService manifests.
+func (k *KonfluxIntegrationService) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxIntegrationService manifests.
+func (k *KonfluxIntegrationService) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxIntegrationService.
+func (k *KonfluxIntegrationService) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxIntegrationService.
+func (k *KonfluxIntegrationService) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
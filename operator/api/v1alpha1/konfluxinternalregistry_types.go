@@ -25,8 +25,34 @@ import (
 
 // KonfluxInternalRegistrySpec defines the desired state of KonfluxInternalRegistry.
 type KonfluxInternalRegistrySpec struct {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the registry pods;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 }
 
 // KonfluxInternalRegistryStatus defines the observed state of KonfluxInternalRegistry.
@@ -34,6 +60,20 @@ type KonfluxInternalRegistryStatus struct {
 	// Conditions represent the latest available observations of the KonfluxInternalRegistry state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -70,3 +110,33 @@ func (k *KonfluxInternalRegistry) GetConditions() []metav1.Condition {
 func (k *KonfluxInternalRegistry) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
+
+// GetDriftedResources returns the drifted resources from the KonfluxInternalRegistry status.
+func (k *KonfluxInternalRegistry) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxInternalRegistry status.
+func (k *KonfluxInternalRegistry) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxInternalRegistry manifests.
+func (k *KonfluxInternalRegistry) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxInternalRegistry manifests.
+func (k *KonfluxInternalRegistry) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxInternalRegistry.
+func (k *KonfluxInternalRegistry) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxInternalRegistry.
+func (k *KonfluxInternalRegistry) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
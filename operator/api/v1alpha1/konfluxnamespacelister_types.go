@@ -23,12 +23,19 @@ import (
 // NamespaceListerDeploymentSpec defines customizations for the namespace-lister deployment.
 type NamespaceListerDeploymentSpec struct {
 	// Replicas is the number of replicas for the namespace-lister deployment.
-	// +kubebuilder:default=1
+	// When omitted, the replicas of spec.profile are used, or 1.
+	// +optional
 	// +kubebuilder:validation:Minimum=1
 	Replicas int32 `json:"replicas,omitempty"`
 	// NamespaceLister defines customizations for the namespace-lister container.
 	// +optional
 	NamespaceLister *ContainerSpec `json:"namespaceLister,omitempty"`
+	// PodDisruptionBudget creates a PodDisruptionBudget for the namespace-lister pods.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// Autoscaling creates a HorizontalPodAutoscaler for the namespace-lister deployment.
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
 }
 
 // KonfluxNamespaceListerSpec defines the desired state of KonfluxNamespaceLister.
@@ -49,8 +56,44 @@ type KonfluxNamespaceListerSpec struct {
 	// LogLevel sets the minimum log severity for the namespace-lister.
 	// Accepted values: debug, info, warn, error.
 	// When omitted, the namespace-lister defaults to error level.
+	// Deprecated: use logging.level, which takes precedence.
 	// +optional
 	LogLevel LogLevel `json:"logLevel,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 }
 
 // KonfluxNamespaceListerStatus defines the observed state of KonfluxNamespaceLister.
@@ -58,10 +101,25 @@ type KonfluxNamespaceListerStatus struct {
 	// Conditions represent the latest available observations of the KonfluxNamespaceLister state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'konflux-namespace-lister'",message="KonfluxNamespaceLister CR must be named 'konflux-namespace-lister'. Only one instance is allowed per cluster."
 
@@ -93,3 +151,39 @@ func (k *KonfluxNamespaceLister) GetConditions() []metav1.Condition {
 func (k *KonfluxNamespaceLister) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
+
+// GetDriftedResources returns the drifted resources from the KonfluxNamespaceLister status.
+func (k *KonfluxNamespaceLister) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxNamespaceLister status.
+func (k *KonfluxNamespaceLister) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxNamespaceLister manifests.
+func (k *KonfluxNamespaceLister) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxNamespaceLister manifests.
+func (k *KonfluxNamespaceLister) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxNamespaceLister.
+func (k *KonfluxNamespaceLister) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxNamespaceLister.
+func (k *KonfluxNamespaceLister) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
+
+// GetLogging returns the logging settings of the component, with the deprecated logLevel
+// field as fallback.
+func (s *KonfluxNamespaceListerSpec) GetLogging() *LoggingSpec {
+	return s.Logging.Merge(&LoggingSpec{Level: s.LogLevel})
+}
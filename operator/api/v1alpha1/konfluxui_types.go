@@ -87,7 +87,8 @@ type IngressSpec struct {
 // ProxyDeploymentSpec defines customizations for the proxy deployment.
 type ProxyDeploymentSpec struct {
 	// Replicas is the number of replicas for the proxy deployment.
-	// +kubebuilder:default=1
+	// When omitted, the replicas of spec.profile are used, or 1.
+	// +optional
 	// +kubebuilder:validation:Minimum=1
 	Replicas int32 `json:"replicas,omitempty"`
 	// ReverseProxy defines customizations for the reverse proxy container.
@@ -100,6 +101,12 @@ type ProxyDeploymentSpec struct {
 	// Each endpoint can be independently enabled and customized.
 	// +optional
 	Endpoints *ProxyEndpointsSpec `json:"endpoints,omitempty"`
+	// PodDisruptionBudget creates a PodDisruptionBudget for the proxy pods.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// Autoscaling creates a HorizontalPodAutoscaler for the proxy deployment.
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
 }
 
 // ProxyEndpointsSpec configures optional backend endpoints proxied by the UI reverse proxy.
@@ -150,12 +157,16 @@ type WatsonEndpointSpec struct {
 // DexDeploymentSpec defines customizations for the dex deployment.
 type DexDeploymentSpec struct {
 	// Replicas is the number of replicas for the dex deployment.
-	// +kubebuilder:default=1
+	// When omitted, the replicas of spec.profile are used, or 1.
+	// +optional
 	// +kubebuilder:validation:Minimum=1
 	Replicas int32 `json:"replicas,omitempty"`
 	// Dex defines customizations for the dex container.
 	// +optional
 	Dex *ContainerSpec `json:"dex,omitempty"`
+	// PodDisruptionBudget creates a PodDisruptionBudget for the dex pods.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 	// Config defines the Dex IdP configuration parameters.
 	// +optional
 	Config *dex.DexParams `json:"config,omitempty"`
@@ -257,6 +268,25 @@ type KonfluxUIConfigSpec struct {
 	// These settings are injected as window.KONFLUX_RUNTIME properties in the SPA.
 	// +optional
 	RuntimeConfig *RuntimeConfigSpec `json:"runtimeConfig,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
 }
 
 // KonfluxUISpec defines the desired state of KonfluxUI.
@@ -267,6 +297,22 @@ type KonfluxUISpec struct {
 	// Set by the Konflux reconciler from spec.componentMetrics on the Konflux CR.
 	// +optional
 	ComponentMetrics *ComponentMetricsConfig `json:"componentMetrics,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 }
 
 // IngressStatus defines the observed state of the Ingress configuration.
@@ -290,6 +336,20 @@ type KonfluxUIStatus struct {
 	// Ingress contains the observed state of the Ingress configuration.
 	// +optional
 	Ingress *IngressStatus `json:"ingress,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -326,6 +386,36 @@ func (k *KonfluxUI) SetConditions(conditions []metav1.Condition) {
 	k.Status.Conditions = conditions
 }
 
+// GetDriftedResources returns the drifted resources from the KonfluxUI status.
+func (k *KonfluxUI) GetDriftedResources() []DriftedResource {
+	return k.Status.DriftedResources
+}
+
+// SetDriftedResources sets the drifted resources on the KonfluxUI status.
+func (k *KonfluxUI) SetDriftedResources(resources []DriftedResource) {
+	k.Status.DriftedResources = resources
+}
+
+// GetOperatorVersion returns the operator version that last applied the KonfluxUI manifests.
+func (k *KonfluxUI) GetOperatorVersion() string {
+	return k.Status.OperatorVersion
+}
+
+// SetOperatorVersion sets the operator version that last applied the KonfluxUI manifests.
+func (k *KonfluxUI) SetOperatorVersion(version string) {
+	k.Status.OperatorVersion = version
+}
+
+// GetManifestProvenance returns the provenance of the manifests last applied for the KonfluxUI.
+func (k *KonfluxUI) GetManifestProvenance() *ManifestProvenance {
+	return k.Status.ManifestProvenance
+}
+
+// SetManifestProvenance sets the provenance of the manifests last applied for the KonfluxUI.
+func (k *KonfluxUI) SetManifestProvenance(provenance *ManifestProvenance) {
+	k.Status.ManifestProvenance = provenance
+}
+
 // -----------------------------------------------------------------------------
 // Spec Accessor Methods
 // These methods provide safe access to optional fields with sensible defaults,
@@ -348,20 +438,28 @@ func (s *KonfluxUIConfigSpec) GetNodePortService() *NodePortServiceSpec {
 	return s.Ingress.NodePortService
 }
 
-// GetProxy returns the ProxyDeploymentSpec with safe defaults if nil.
+// GetProxy returns the ProxyDeploymentSpec with safe defaults if nil or if replicas are not set.
 func (s *KonfluxUIConfigSpec) GetProxy() ProxyDeploymentSpec {
 	if s.Proxy == nil {
 		return ProxyDeploymentSpec{Replicas: 1}
 	}
-	return *s.Proxy
+	proxy := *s.Proxy
+	if proxy.Replicas == 0 {
+		proxy.Replicas = 1
+	}
+	return proxy
 }
 
-// GetDex returns the DexDeploymentSpec with safe defaults if nil.
+// GetDex returns the DexDeploymentSpec with safe defaults if nil or if replicas are not set.
 func (s *KonfluxUIConfigSpec) GetDex() DexDeploymentSpec {
 	if s.Dex == nil {
 		return DexDeploymentSpec{Replicas: 1}
 	}
-	return *s.Dex
+	dex := *s.Dex
+	if dex.Replicas == 0 {
+		dex.Replicas = 1
+	}
+	return dex
 }
 
 // -----------------------------------------------------------------------------
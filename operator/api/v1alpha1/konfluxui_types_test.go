@@ -152,6 +152,11 @@ func TestKonfluxUIConfigSpec_Accessors(t *testing.T) {
 	g.Expect(cfg.GetNodePortService()).To(gomega.BeNil())
 	g.Expect(cfg.GetProxy()).To(gomega.Equal(ProxyDeploymentSpec{Replicas: 1}))
 	g.Expect(cfg.GetDex()).To(gomega.Equal(DexDeploymentSpec{Replicas: 1}))
+
+	cfg.Proxy = &ProxyDeploymentSpec{}
+	cfg.Dex = &DexDeploymentSpec{Replicas: 2}
+	g.Expect(cfg.GetProxy().Replicas).To(gomega.Equal(int32(1)))
+	g.Expect(cfg.GetDex().Replicas).To(gomega.Equal(int32(2)))
 }
 
 // Patterns must stay in sync with +kubebuilder:validation:Pattern on IngressSpec.
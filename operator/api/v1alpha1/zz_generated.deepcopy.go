@@ -25,8 +25,39 @@ import (
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingSpec) DeepCopyInto(out *AutoscalingSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingSpec.
+func (in *AutoscalingSpec) DeepCopy() *AutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Banner) DeepCopyInto(out *Banner) {
 	*out = *in
@@ -88,9 +119,34 @@ func (in *BannerItem) DeepCopy() *BannerItem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockedComponent) DeepCopyInto(out *BlockedComponent) {
+	*out = *in
+	if in.WaitingFor != nil {
+		in, out := &in.WaitingFor, &out.WaitingFor
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockedComponent.
+func (in *BlockedComponent) DeepCopy() *BlockedComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockedComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildServiceConfig) DeepCopyInto(out *BuildServiceConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(KonfluxBuildServiceConfigSpec)
@@ -108,9 +164,34 @@ func (in *BuildServiceConfig) DeepCopy() *BuildServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CLIConfig) DeepCopyInto(out *CLIConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CLIConfig.
+func (in *CLIConfig) DeepCopy() *CLIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CLIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertManagerConfig) DeepCopyInto(out *CertManagerConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CreateClusterIssuer != nil {
 		in, out := &in.CreateClusterIssuer, &out.CreateClusterIssuer
 		*out = new(bool)
@@ -226,6 +307,30 @@ func (in *ComponentMetricsConfig) DeepCopy() *ComponentMetricsConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
 	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpstreamRevisions != nil {
+		in, out := &in.UpstreamRevisions, &out.UpstreamRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManifestSource != nil {
+		in, out := &in.ManifestSource, &out.ManifestSource
+		*out = new(ManifestSourceStatus)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(ReplicaStatus)
+		**out = **in
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]RunningImage, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
@@ -253,6 +358,38 @@ func (in *ContainerSpec) DeepCopyInto(out *ContainerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSpec.
@@ -273,6 +410,11 @@ func (in *ControllerManagerDeploymentSpec) DeepCopyInto(out *ControllerManagerDe
 		*out = new(ContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerManagerDeploymentSpec.
@@ -313,6 +455,11 @@ func (in *DexDeploymentSpec) DeepCopyInto(out *DexDeploymentSpec) {
 		*out = new(ContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Config != nil {
 		in, out := &in.Config, &out.Config
 		*out = new(dex.DexParams)
@@ -330,6 +477,42 @@ func (in *DexDeploymentSpec) DeepCopy() *DexDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedResource) DeepCopyInto(out *DriftedResource) {
+	*out = *in
+	if in.Managers != nil {
+		in, out := &in.Managers, &out.Managers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastDetected.DeepCopyInto(&out.LastDetected)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedResource.
+func (in *DriftedResource) DeepCopy() *DriftedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressProxySpec) DeepCopyInto(out *EgressProxySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressProxySpec.
+func (in *EgressProxySpec) DeepCopy() *EgressProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmptyDirOverride) DeepCopyInto(out *EmptyDirOverride) {
 	*out = *in
@@ -363,6 +546,11 @@ func (in *EndpointSpec) DeepCopy() *EndpointSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnterpriseContractConfig) DeepCopyInto(out *EnterpriseContractConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseContractConfig.
@@ -415,6 +603,56 @@ func (in *ImageControllerConfig) DeepCopy() *ImageControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullConfig) DeepCopyInto(out *ImagePullConfig) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullConfig.
+func (in *ImagePullConfig) DeepCopy() *ImagePullConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullSecretReference) DeepCopyInto(out *ImagePullSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecretReference.
+func (in *ImagePullSecretReference) DeepCopy() *ImagePullSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistryMirror) DeepCopyInto(out *ImageRegistryMirror) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryMirror.
+func (in *ImageRegistryMirror) DeepCopy() *ImageRegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfoImageControllerConfig) DeepCopyInto(out *InfoImageControllerConfig) {
 	*out = *in
@@ -508,6 +746,11 @@ func (in *IngressStatus) DeepCopy() *IngressStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationServiceConfig) DeepCopyInto(out *IntegrationServiceConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(KonfluxIntegrationServiceConfigSpec)
@@ -563,6 +806,11 @@ func (in *InternalRegistryConfig) DeepCopyInto(out *InternalRegistryConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxInternalRegistrySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalRegistryConfig.
@@ -607,7 +855,7 @@ func (in *KonfluxApplicationAPI) DeepCopyInto(out *KonfluxApplicationAPI) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -664,6 +912,26 @@ func (in *KonfluxApplicationAPIList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonfluxApplicationAPISpec) DeepCopyInto(out *KonfluxApplicationAPISpec) {
 	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxApplicationAPISpec.
@@ -686,6 +954,18 @@ func (in *KonfluxApplicationAPIStatus) DeepCopyInto(out *KonfluxApplicationAPISt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxApplicationAPIStatus.
@@ -750,6 +1030,21 @@ func (in *KonfluxBuildServiceConfigSpec) DeepCopyInto(out *KonfluxBuildServiceCo
 		*out = new(PipelineConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxBuildServiceConfigSpec.
@@ -803,6 +1098,21 @@ func (in *KonfluxBuildServiceSpec) DeepCopyInto(out *KonfluxBuildServiceSpec) {
 		*out = new(ComponentMetricsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxBuildServiceSpec.
@@ -825,6 +1135,18 @@ func (in *KonfluxBuildServiceStatus) DeepCopyInto(out *KonfluxBuildServiceStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxBuildServiceStatus.
@@ -842,7 +1164,7 @@ func (in *KonfluxCLI) DeepCopyInto(out *KonfluxCLI) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -899,6 +1221,26 @@ func (in *KonfluxCLIList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonfluxCLISpec) DeepCopyInto(out *KonfluxCLISpec) {
 	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxCLISpec.
@@ -921,6 +1263,18 @@ func (in *KonfluxCLIStatus) DeepCopyInto(out *KonfluxCLIStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxCLIStatus.
@@ -1000,6 +1354,26 @@ func (in *KonfluxCertManagerSpec) DeepCopyInto(out *KonfluxCertManagerSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxCertManagerSpec.
@@ -1022,6 +1396,18 @@ func (in *KonfluxCertManagerStatus) DeepCopyInto(out *KonfluxCertManagerStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxCertManagerStatus.
@@ -1039,7 +1425,7 @@ func (in *KonfluxDefaultTenant) DeepCopyInto(out *KonfluxDefaultTenant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1096,6 +1482,26 @@ func (in *KonfluxDefaultTenantList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonfluxDefaultTenantSpec) DeepCopyInto(out *KonfluxDefaultTenantSpec) {
 	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxDefaultTenantSpec.
@@ -1118,6 +1524,18 @@ func (in *KonfluxDefaultTenantStatus) DeepCopyInto(out *KonfluxDefaultTenantStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxDefaultTenantStatus.
@@ -1135,7 +1553,7 @@ func (in *KonfluxEnterpriseContract) DeepCopyInto(out *KonfluxEnterpriseContract
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1192,6 +1610,26 @@ func (in *KonfluxEnterpriseContractList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonfluxEnterpriseContractSpec) DeepCopyInto(out *KonfluxEnterpriseContractSpec) {
 	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxEnterpriseContractSpec.
@@ -1214,6 +1652,18 @@ func (in *KonfluxEnterpriseContractStatus) DeepCopyInto(out *KonfluxEnterpriseCo
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxEnterpriseContractStatus.
@@ -1276,11 +1726,26 @@ func (in *KonfluxImageControllerConfigSpec) DeepCopyInto(out *KonfluxImageContro
 		*out = new(QuayCABundleSpec)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxImageControllerConfigSpec.
-func (in *KonfluxImageControllerConfigSpec) DeepCopy() *KonfluxImageControllerConfigSpec {
-	if in == nil {
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxImageControllerConfigSpec.
+func (in *KonfluxImageControllerConfigSpec) DeepCopy() *KonfluxImageControllerConfigSpec {
+	if in == nil {
 		return nil
 	}
 	out := new(KonfluxImageControllerConfigSpec)
@@ -1329,6 +1794,21 @@ func (in *KonfluxImageControllerSpec) DeepCopyInto(out *KonfluxImageControllerSp
 		*out = new(ComponentMetricsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxImageControllerSpec.
@@ -1351,6 +1831,18 @@ func (in *KonfluxImageControllerStatus) DeepCopyInto(out *KonfluxImageController
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxImageControllerStatus.
@@ -1393,6 +1885,11 @@ func (in *KonfluxInfo) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonfluxInfoConfig) DeepCopyInto(out *KonfluxInfoConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(KonfluxInfoSpec)
@@ -1460,6 +1957,26 @@ func (in *KonfluxInfoSpec) DeepCopyInto(out *KonfluxInfoSpec) {
 		*out = new(ClusterConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxInfoSpec.
@@ -1482,6 +1999,18 @@ func (in *KonfluxInfoStatus) DeepCopyInto(out *KonfluxInfoStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxInfoStatus.
@@ -1534,6 +2063,21 @@ func (in *KonfluxIntegrationServiceConfigSpec) DeepCopyInto(out *KonfluxIntegrat
 		*out = new(ContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxIntegrationServiceConfigSpec.
@@ -1587,6 +2131,21 @@ func (in *KonfluxIntegrationServiceSpec) DeepCopyInto(out *KonfluxIntegrationSer
 		*out = new(ComponentMetricsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxIntegrationServiceSpec.
@@ -1609,6 +2168,18 @@ func (in *KonfluxIntegrationServiceStatus) DeepCopyInto(out *KonfluxIntegrationS
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxIntegrationServiceStatus.
@@ -1626,7 +2197,7 @@ func (in *KonfluxInternalRegistry) DeepCopyInto(out *KonfluxInternalRegistry) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1683,6 +2254,31 @@ func (in *KonfluxInternalRegistryList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonfluxInternalRegistrySpec) DeepCopyInto(out *KonfluxInternalRegistrySpec) {
 	*out = *in
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxInternalRegistrySpec.
@@ -1705,6 +2301,18 @@ func (in *KonfluxInternalRegistryStatus) DeepCopyInto(out *KonfluxInternalRegist
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxInternalRegistryStatus.
@@ -1816,6 +2424,36 @@ func (in *KonfluxNamespaceListerSpec) DeepCopyInto(out *KonfluxNamespaceListerSp
 		*out = new(NamespaceListerDeploymentSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxNamespaceListerSpec.
@@ -1838,6 +2476,18 @@ func (in *KonfluxNamespaceListerStatus) DeepCopyInto(out *KonfluxNamespaceLister
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxNamespaceListerStatus.
@@ -1855,7 +2505,7 @@ func (in *KonfluxRBAC) DeepCopyInto(out *KonfluxRBAC) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1912,6 +2562,26 @@ func (in *KonfluxRBACList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonfluxRBACSpec) DeepCopyInto(out *KonfluxRBACSpec) {
 	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxRBACSpec.
@@ -1934,6 +2604,18 @@ func (in *KonfluxRBACStatus) DeepCopyInto(out *KonfluxRBACStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxRBACStatus.
@@ -1986,6 +2668,21 @@ func (in *KonfluxReleaseServiceConfigSpec) DeepCopyInto(out *KonfluxReleaseServi
 		*out = new(ControllerManagerDeploymentSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxReleaseServiceConfigSpec.
@@ -2039,6 +2736,21 @@ func (in *KonfluxReleaseServiceSpec) DeepCopyInto(out *KonfluxReleaseServiceSpec
 		*out = new(ComponentMetricsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxReleaseServiceSpec.
@@ -2061,6 +2773,18 @@ func (in *KonfluxReleaseServiceStatus) DeepCopyInto(out *KonfluxReleaseServiceSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxReleaseServiceStatus.
@@ -2150,6 +2874,31 @@ func (in *KonfluxSegmentBridgeSpec) DeepCopyInto(out *KonfluxSegmentBridgeSpec)
 		*out = new(ContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxSegmentBridgeSpec.
@@ -2172,6 +2921,18 @@ func (in *KonfluxSegmentBridgeStatus) DeepCopyInto(out *KonfluxSegmentBridgeStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxSegmentBridgeStatus.
@@ -2225,7 +2986,7 @@ func (in *KonfluxSpec) DeepCopyInto(out *KonfluxSpec) {
 	if in.EnterpriseContract != nil {
 		in, out := &in.EnterpriseContract, &out.EnterpriseContract
 		*out = new(EnterpriseContractConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.CertManager != nil {
 		in, out := &in.CertManager, &out.CertManager
@@ -2247,11 +3008,46 @@ func (in *KonfluxSpec) DeepCopyInto(out *KonfluxSpec) {
 		*out = new(TelemetryConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CLI != nil {
+		in, out := &in.CLI, &out.CLI
+		*out = new(CLIConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ComponentMetrics != nil {
 		in, out := &in.ComponentMetrics, &out.ComponentMetrics
 		*out = new(ComponentMetricsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]ImagePullSecretReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxSpec.
@@ -2277,8 +3073,30 @@ func (in *KonfluxStatus) DeepCopyInto(out *KonfluxStatus) {
 	if in.Components != nil {
 		in, out := &in.Components, &out.Components
 		*out = make([]ComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompletedUpgradeSteps != nil {
+		in, out := &in.CompletedUpgradeSteps, &out.CompletedUpgradeSteps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnmirroredImages != nil {
+		in, out := &in.UnmirroredImages, &out.UnmirroredImages
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxStatus.
@@ -2321,6 +3139,11 @@ func (in *KonfluxUI) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonfluxUIConfig) DeepCopyInto(out *KonfluxUIConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(KonfluxUIConfigSpec)
@@ -2361,6 +3184,21 @@ func (in *KonfluxUIConfigSpec) DeepCopyInto(out *KonfluxUIConfigSpec) {
 		*out = new(RuntimeConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxUIConfigSpec.
@@ -2414,6 +3252,21 @@ func (in *KonfluxUISpec) DeepCopyInto(out *KonfluxUISpec) {
 		*out = new(ComponentMetricsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxUISpec.
@@ -2441,6 +3294,18 @@ func (in *KonfluxUIStatus) DeepCopyInto(out *KonfluxUIStatus) {
 		*out = new(IngressStatus)
 		**out = **in
 	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxUIStatus.
@@ -2453,6 +3318,75 @@ func (in *KonfluxUIStatus) DeepCopy() *KonfluxUIStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSpec) DeepCopyInto(out *LoggingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSpec.
+func (in *LoggingSpec) DeepCopy() *LoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestProvenance) DeepCopyInto(out *ManifestProvenance) {
+	*out = *in
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]ManifestUpstream, len(*in))
+		copy(*out, *in)
+	}
+	if in.BuildTime != nil {
+		in, out := &in.BuildTime, &out.BuildTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestProvenance.
+func (in *ManifestProvenance) DeepCopy() *ManifestProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestSourceStatus) DeepCopyInto(out *ManifestSourceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestSourceStatus.
+func (in *ManifestSourceStatus) DeepCopy() *ManifestSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestUpstream) DeepCopyInto(out *ManifestUpstream) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestUpstream.
+func (in *ManifestUpstream) DeepCopy() *ManifestUpstream {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestUpstream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
 	*out = *in
@@ -2476,6 +3410,11 @@ func (in *MonitoringConfig) DeepCopy() *MonitoringConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceListerConfig) DeepCopyInto(out *NamespaceListerConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(KonfluxNamespaceListerSpec)
@@ -2501,6 +3440,16 @@ func (in *NamespaceListerDeploymentSpec) DeepCopyInto(out *NamespaceListerDeploy
 		*out = new(ContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceListerDeploymentSpec.
@@ -2533,6 +3482,37 @@ func (in *NodePortServiceSpec) DeepCopy() *NodePortServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectPatch) DeepCopyInto(out *ObjectPatch) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectPatch.
+func (in *ObjectPatch) DeepCopy() *ObjectPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchTarget) DeepCopyInto(out *PatchTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchTarget.
+func (in *PatchTarget) DeepCopy() *PatchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineConfigData) DeepCopyInto(out *PipelineConfigData) {
 	*out = *in
@@ -2603,6 +3583,72 @@ func (in *PipelineSpec) DeepCopy() *PipelineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
+func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPlacementSpec) DeepCopyInto(out *PodPlacementSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlacementSpec.
+func (in *PodPlacementSpec) DeepCopy() *PodPlacementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPlacementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyDeploymentSpec) DeepCopyInto(out *ProxyDeploymentSpec) {
 	*out = *in
@@ -2621,6 +3667,16 @@ func (in *ProxyDeploymentSpec) DeepCopyInto(out *ProxyDeploymentSpec) {
 		*out = new(ProxyEndpointsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyDeploymentSpec.
@@ -2721,6 +3777,11 @@ func (in *RBACRole) DeepCopy() *RBACRole {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseServiceConfig) DeepCopyInto(out *ReleaseServiceConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(KonfluxReleaseServiceConfigSpec)
@@ -2738,6 +3799,58 @@ func (in *ReleaseServiceConfig) DeepCopy() *ReleaseServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaStatus) DeepCopyInto(out *ReplicaStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaStatus.
+func (in *ReplicaStatus) DeepCopy() *ReplicaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.Blocked != nil {
+		in, out := &in.Blocked, &out.Blocked
+		*out = make([]BlockedComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunningImage) DeepCopyInto(out *RunningImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunningImage.
+func (in *RunningImage) DeepCopy() *RunningImage {
+	if in == nil {
+		return nil
+	}
+	out := new(RunningImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeConfigSpec) DeepCopyInto(out *RuntimeConfigSpec) {
 	*out = *in
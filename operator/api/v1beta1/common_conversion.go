@@ -0,0 +1,367 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func containerSpecToHub(src *ContainerSpec) *konfluxv1alpha1.ContainerSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ContainerSpec{
+		Resources:       src.Resources,
+		Env:             src.Env,
+		LivenessProbe:   src.LivenessProbe,
+		ReadinessProbe:  src.ReadinessProbe,
+		StartupProbe:    src.StartupProbe,
+		SecurityContext: src.SecurityContext,
+		VolumeMounts:    src.VolumeMounts,
+		Args:            src.Args,
+	}
+}
+
+func containerSpecFromHub(src *konfluxv1alpha1.ContainerSpec) *ContainerSpec {
+	if src == nil {
+		return nil
+	}
+	return &ContainerSpec{
+		Resources:       src.Resources,
+		Env:             src.Env,
+		LivenessProbe:   src.LivenessProbe,
+		ReadinessProbe:  src.ReadinessProbe,
+		StartupProbe:    src.StartupProbe,
+		SecurityContext: src.SecurityContext,
+		VolumeMounts:    src.VolumeMounts,
+		Args:            src.Args,
+	}
+}
+
+func loggingSpecToHub(src *LoggingSpec) *konfluxv1alpha1.LoggingSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.LoggingSpec{
+		Level:   konfluxv1alpha1.LogLevel(src.Level),
+		Encoder: konfluxv1alpha1.LogEncoder(src.Encoder),
+	}
+}
+
+func loggingSpecFromHub(src *konfluxv1alpha1.LoggingSpec) *LoggingSpec {
+	if src == nil {
+		return nil
+	}
+	return &LoggingSpec{
+		Level:   LogLevel(src.Level),
+		Encoder: LogEncoder(src.Encoder),
+	}
+}
+
+func controllerManagerDeploymentSpecToHub(src *ControllerManagerDeploymentSpec) *konfluxv1alpha1.ControllerManagerDeploymentSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ControllerManagerDeploymentSpec{
+		Replicas:            src.Replicas,
+		Manager:             containerSpecToHub(src.Manager),
+		PodDisruptionBudget: podDisruptionBudgetSpecToHub(src.PodDisruptionBudget),
+	}
+}
+
+func controllerManagerDeploymentSpecFromHub(src *konfluxv1alpha1.ControllerManagerDeploymentSpec) *ControllerManagerDeploymentSpec {
+	if src == nil {
+		return nil
+	}
+	return &ControllerManagerDeploymentSpec{
+		Replicas:            src.Replicas,
+		Manager:             containerSpecFromHub(src.Manager),
+		PodDisruptionBudget: podDisruptionBudgetSpecFromHub(src.PodDisruptionBudget),
+	}
+}
+
+func podDisruptionBudgetSpecToHub(src *PodDisruptionBudgetSpec) *konfluxv1alpha1.PodDisruptionBudgetSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.PodDisruptionBudgetSpec{
+		MinAvailable:   src.MinAvailable,
+		MaxUnavailable: src.MaxUnavailable,
+	}
+}
+
+func podDisruptionBudgetSpecFromHub(src *konfluxv1alpha1.PodDisruptionBudgetSpec) *PodDisruptionBudgetSpec {
+	if src == nil {
+		return nil
+	}
+	return &PodDisruptionBudgetSpec{
+		MinAvailable:   src.MinAvailable,
+		MaxUnavailable: src.MaxUnavailable,
+	}
+}
+
+func autoscalingSpecToHub(src *AutoscalingSpec) *konfluxv1alpha1.AutoscalingSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.AutoscalingSpec{
+		MinReplicas:                       src.MinReplicas,
+		MaxReplicas:                       src.MaxReplicas,
+		TargetCPUUtilizationPercentage:    src.TargetCPUUtilizationPercentage,
+		TargetMemoryUtilizationPercentage: src.TargetMemoryUtilizationPercentage,
+	}
+}
+
+func autoscalingSpecFromHub(src *konfluxv1alpha1.AutoscalingSpec) *AutoscalingSpec {
+	if src == nil {
+		return nil
+	}
+	return &AutoscalingSpec{
+		MinReplicas:                       src.MinReplicas,
+		MaxReplicas:                       src.MaxReplicas,
+		TargetCPUUtilizationPercentage:    src.TargetCPUUtilizationPercentage,
+		TargetMemoryUtilizationPercentage: src.TargetMemoryUtilizationPercentage,
+	}
+}
+
+func podPlacementSpecToHub(src *PodPlacementSpec) *konfluxv1alpha1.PodPlacementSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.PodPlacementSpec{
+		NodeSelector:              src.NodeSelector,
+		Tolerations:               src.Tolerations,
+		Affinity:                  src.Affinity,
+		PriorityClassName:         src.PriorityClassName,
+		TopologySpreadConstraints: src.TopologySpreadConstraints,
+	}
+}
+
+func podPlacementSpecFromHub(src *konfluxv1alpha1.PodPlacementSpec) *PodPlacementSpec {
+	if src == nil {
+		return nil
+	}
+	return &PodPlacementSpec{
+		NodeSelector:              src.NodeSelector,
+		Tolerations:               src.Tolerations,
+		Affinity:                  src.Affinity,
+		PriorityClassName:         src.PriorityClassName,
+		TopologySpreadConstraints: src.TopologySpreadConstraints,
+	}
+}
+
+func patchTargetToHub(src *PatchTarget) *konfluxv1alpha1.PatchTarget {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.PatchTarget{
+		Group:     src.Group,
+		Version:   src.Version,
+		Kind:      src.Kind,
+		Namespace: src.Namespace,
+		Name:      src.Name,
+	}
+}
+
+func patchTargetFromHub(src *konfluxv1alpha1.PatchTarget) *PatchTarget {
+	if src == nil {
+		return nil
+	}
+	return &PatchTarget{
+		Group:     src.Group,
+		Version:   src.Version,
+		Kind:      src.Kind,
+		Namespace: src.Namespace,
+		Name:      src.Name,
+	}
+}
+
+func objectPatchToHub(src *ObjectPatch) *konfluxv1alpha1.ObjectPatch {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ObjectPatch{
+		Target: *patchTargetToHub(&src.Target),
+		Type:   konfluxv1alpha1.PatchType(src.Type),
+		Patch:  src.Patch,
+	}
+}
+
+func objectPatchFromHub(src *konfluxv1alpha1.ObjectPatch) *ObjectPatch {
+	if src == nil {
+		return nil
+	}
+	return &ObjectPatch{
+		Target: *patchTargetFromHub(&src.Target),
+		Type:   PatchType(src.Type),
+		Patch:  src.Patch,
+	}
+}
+
+func imageRegistryMirrorToHub(src *ImageRegistryMirror) *konfluxv1alpha1.ImageRegistryMirror {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ImageRegistryMirror{
+		Source: src.Source,
+		Mirror: src.Mirror,
+	}
+}
+
+func imageRegistryMirrorFromHub(src *konfluxv1alpha1.ImageRegistryMirror) *ImageRegistryMirror {
+	if src == nil {
+		return nil
+	}
+	return &ImageRegistryMirror{
+		Source: src.Source,
+		Mirror: src.Mirror,
+	}
+}
+
+func imagePullSecretReferenceToHub(src *ImagePullSecretReference) *konfluxv1alpha1.ImagePullSecretReference {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ImagePullSecretReference{
+		Name:      src.Name,
+		Namespace: src.Namespace,
+	}
+}
+
+func imagePullSecretReferenceFromHub(src *konfluxv1alpha1.ImagePullSecretReference) *ImagePullSecretReference {
+	if src == nil {
+		return nil
+	}
+	return &ImagePullSecretReference{
+		Name:      src.Name,
+		Namespace: src.Namespace,
+	}
+}
+
+func imagePullConfigToHub(src *ImagePullConfig) *konfluxv1alpha1.ImagePullConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ImagePullConfig{
+		Secrets: src.Secrets,
+		Policy:  src.Policy,
+	}
+}
+
+func imagePullConfigFromHub(src *konfluxv1alpha1.ImagePullConfig) *ImagePullConfig {
+	if src == nil {
+		return nil
+	}
+	return &ImagePullConfig{
+		Secrets: src.Secrets,
+		Policy:  src.Policy,
+	}
+}
+
+func egressProxySpecToHub(src *EgressProxySpec) *konfluxv1alpha1.EgressProxySpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.EgressProxySpec{
+		HTTPProxy:  src.HTTPProxy,
+		HTTPSProxy: src.HTTPSProxy,
+		NoProxy:    src.NoProxy,
+	}
+}
+
+func egressProxySpecFromHub(src *konfluxv1alpha1.EgressProxySpec) *EgressProxySpec {
+	if src == nil {
+		return nil
+	}
+	return &EgressProxySpec{
+		HTTPProxy:  src.HTTPProxy,
+		HTTPSProxy: src.HTTPSProxy,
+		NoProxy:    src.NoProxy,
+	}
+}
+
+func driftedResourceToHub(src *DriftedResource) *konfluxv1alpha1.DriftedResource {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.DriftedResource{
+		Kind:         src.Kind,
+		Namespace:    src.Namespace,
+		Name:         src.Name,
+		Managers:     src.Managers,
+		LastDetected: src.LastDetected,
+	}
+}
+
+func driftedResourceFromHub(src *konfluxv1alpha1.DriftedResource) *DriftedResource {
+	if src == nil {
+		return nil
+	}
+	return &DriftedResource{
+		Kind:         src.Kind,
+		Namespace:    src.Namespace,
+		Name:         src.Name,
+		Managers:     src.Managers,
+		LastDetected: src.LastDetected,
+	}
+}
+
+func manifestProvenanceToHub(src *ManifestProvenance) *konfluxv1alpha1.ManifestProvenance {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ManifestProvenance{
+		KustomizePath: src.KustomizePath,
+		Upstreams:     convertSlice(src.Upstreams, manifestUpstreamToHub),
+		BuildTime:     src.BuildTime,
+		ContentHash:   src.ContentHash,
+	}
+}
+
+func manifestProvenanceFromHub(src *konfluxv1alpha1.ManifestProvenance) *ManifestProvenance {
+	if src == nil {
+		return nil
+	}
+	return &ManifestProvenance{
+		KustomizePath: src.KustomizePath,
+		Upstreams:     convertSlice(src.Upstreams, manifestUpstreamFromHub),
+		BuildTime:     src.BuildTime,
+		ContentHash:   src.ContentHash,
+	}
+}
+
+func manifestUpstreamToHub(src *ManifestUpstream) *konfluxv1alpha1.ManifestUpstream {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ManifestUpstream{
+		Repository: src.Repository,
+		Path:       src.Path,
+		Revision:   src.Revision,
+	}
+}
+
+func manifestUpstreamFromHub(src *konfluxv1alpha1.ManifestUpstream) *ManifestUpstream {
+	if src == nil {
+		return nil
+	}
+	return &ManifestUpstream{
+		Repository: src.Repository,
+		Path:       src.Path,
+		Revision:   src.Revision,
+	}
+}
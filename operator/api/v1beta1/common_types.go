@@ -0,0 +1,352 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ContainerSpec defines customizations for a specific container.
+// This type is reused across all deployment specs.
+type ContainerSpec struct {
+	// Resources specifies the resource requirements for the container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Env specifies environment variables for the container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// LivenessProbe overrides fields of the container's liveness probe. A probe handler
+	// (httpGet, tcpSocket, exec or grpc) replaces the handler of the manifest; the other fields
+	// are merged.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	LivenessProbe *corev1.Probe `json:"livenessProbe,omitempty"`
+
+	// ReadinessProbe overrides fields of the container's readiness probe, like LivenessProbe.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ReadinessProbe *corev1.Probe `json:"readinessProbe,omitempty"`
+
+	// StartupProbe overrides fields of the container's startup probe, like LivenessProbe. A
+	// startup probe delays the liveness probe until it succeeds, which helps controllers that
+	// need long to sync their caches.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	StartupProbe *corev1.Probe `json:"startupProbe,omitempty"`
+
+	// SecurityContext is merged into the container's security context; fields that are not
+	// set keep the values of the manifest.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// VolumeMounts are added to the container's volume mounts; a mount with the same
+	// mountPath replaces the mount of the manifest. The volumes must exist in the pod.
+	// +optional
+	// +listType=map
+	// +listMapKey=mountPath
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// Args are appended to the container's arguments. Flags that the operator manages
+	// cannot be set.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=4096
+	// +kubebuilder:validation:XValidation:rule="self.all(arg, !['--metrics-bind-address', '--health-probe-bind-address', '--metrics-secure', '--leader-elect', '--zap-encoder', '--zap-log-level'].exists(flag, arg == flag || arg.startsWith(flag + '=')))",message="args must not set --metrics-bind-address, --health-probe-bind-address, --metrics-secure, --leader-elect, --zap-encoder or --zap-log-level, which are managed by the operator"
+	Args []string `json:"args,omitempty"`
+}
+
+// LogLevel sets the minimum severity for log output.
+// +kubebuilder:validation:Enum=debug;info;warn;error
+type LogLevel string
+
+// LogEncoder sets the log encoding format for controller-runtime based services.
+// "json" produces structured JSON logs (upstream default), "console" produces
+// human-readable output useful for development and debugging.
+// +kubebuilder:validation:Enum=json;console
+type LogEncoder string
+
+// LoggingSpec configures the log output of the components.
+type LoggingSpec struct {
+	// Level sets the minimum log severity: debug, info, warn or error.
+	// When omitted, each component keeps its default level.
+	// +optional
+	Level LogLevel `json:"level,omitempty"`
+
+	// Encoder sets the log format: json or console. It applies to the controllers and Dex.
+	// When omitted, each component keeps its default format.
+	// +optional
+	Encoder LogEncoder `json:"encoder,omitempty"`
+}
+
+// ControllerManagerDeploymentSpec defines customizations for the controller-manager deployment.
+type ControllerManagerDeploymentSpec struct {
+	// Replicas is the number of replicas for the controller-manager deployment.
+	// When omitted, the replicas of spec.profile are used, or 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas,omitempty"`
+	// Manager defines customizations for the manager container.
+	// +optional
+	Manager *ContainerSpec `json:"manager,omitempty"`
+	// PodDisruptionBudget creates a PodDisruptionBudget for the controller-manager pods.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+}
+
+// PodDisruptionBudgetSpec configures the PodDisruptionBudget that the operator creates for a
+// Deployment. The budget is only created while the Deployment runs more than one replica, as a
+// budget for a single pod would block node drains. When neither field is set, maxUnavailable is 1.
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="minAvailable and maxUnavailable are mutually exclusive"
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number or percentage of pods that must stay available during a
+	// voluntary disruption such as a node drain.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of pods that may be unavailable during a
+	// voluntary disruption such as a node drain.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// AutoscalingSpec configures a HorizontalPodAutoscaler for a stateless Deployment. While it is
+// set, the replica count is left to the HorizontalPodAutoscaler and the replicas field is ignored.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not be greater than maxReplicas"
+type AutoscalingSpec struct {
+	// MinReplicas is the lower limit for the number of replicas. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetCPUUtilizationPercentage is the average CPU utilization, relative to the CPU
+	// requests of the pods, that the autoscaler aims for. Defaults to 80.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// TargetMemoryUtilizationPercentage, when set, also scales on the average memory
+	// utilization relative to the memory requests of the pods.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+}
+
+// PodPlacementSpec controls where the pods of operator-managed Deployments and CronJobs are
+// scheduled. It is set for all components with spec.podPlacement on the Konflux CR and can be
+// overridden per component, in which case each field that is set replaces the Konflux-wide one.
+type PodPlacementSpec struct {
+	// NodeSelector is merged into the nodeSelector of every pod.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations replace the tolerations of every pod.
+	// +optional
+	// +listType=atomic
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity is merged into the affinity of every pod. It is not validated by the CRD
+	// schema, which would otherwise exceed the size limit of the API server; an invalid
+	// affinity fails the apply of the affected workloads.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// PriorityClassName sets the priority class of every pod. The PriorityClass must exist.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// TopologySpreadConstraints are merged, by topologyKey, into the topology spread
+	// constraints of every pod. Constraints without a labelSelector spread the pods of
+	// each workload on their own, using the workload's pod labels.
+	// +optional
+	// +listType=atomic
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// PatchType is the format of an ObjectPatch.
+// +kubebuilder:validation:Enum=StrategicMerge;JSON6902
+type PatchType string
+
+// PatchTarget selects an object rendered from the operator's embedded manifests.
+type PatchTarget struct {
+	// Group is the API group of the object; empty for the core group.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the object. When empty, any version matches.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Kind is the kind of the object.
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the object. When empty, objects in any namespace and
+	// cluster-scoped objects match.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the object.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// ObjectPatch changes an object rendered from the embedded manifests before it is applied,
+// for settings that the typed API does not cover. Patches are applied after all other
+// customizations of the object.
+type ObjectPatch struct {
+	// Target selects the object to patch.
+	Target PatchTarget `json:"target"`
+
+	// Type is the format of Patch.
+	// +kubebuilder:default=StrategicMerge
+	// +optional
+	Type PatchType `json:"type,omitempty"`
+
+	// Patch is the patch in YAML or JSON: a partial object for StrategicMerge, a list of
+	// operations for JSON6902.
+	// +kubebuilder:validation:MinLength=1
+	Patch string `json:"patch"`
+}
+
+// ImageRegistryMirror maps a registry or repository prefix to the mirror that serves its images.
+type ImageRegistryMirror struct {
+	// Source is the registry or repository prefix of the upstream images, for example quay.io
+	// or quay.io/konflux-ci. It matches whole path segments of the image repository.
+	// +kubebuilder:validation:MinLength=1
+	Source string `json:"source"`
+	// Mirror replaces Source in the matching images, for example registry.example.com/konflux-ci.
+	// The rest of the repository, the tag and the digest are kept.
+	// +kubebuilder:validation:MinLength=1
+	Mirror string `json:"mirror"`
+}
+
+// ImagePullSecretReference names a Secret with registry credentials for the component images.
+type ImagePullSecretReference struct {
+	// Name of the Secret. The copies in the component namespaces have the same name.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// ImagePullConfig holds the image pull settings that the Konflux reconciler forwards to a
+// component.
+type ImagePullConfig struct {
+	// Secrets are added to the imagePullSecrets of the pod templates and ServiceAccounts.
+	// +optional
+	// +listType=atomic
+	Secrets []corev1.LocalObjectReference `json:"secrets,omitempty"`
+	// Policy replaces the imagePullPolicy of every container.
+	// +optional
+	Policy corev1.PullPolicy `json:"policy,omitempty"`
+}
+
+// EgressProxySpec configures the egress proxy of the component containers.
+type EgressProxySpec struct {
+	// HTTPProxy is the proxy URL for HTTP requests, set as HTTP_PROXY.
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:XValidation:rule="isURL(self) && url(self).getScheme() in ['http', 'https']",message="httpProxy must be an http or https URL"
+	HTTPProxy string `json:"httpProxy,omitempty"`
+	// HTTPSProxy is the proxy URL for HTTPS requests, set as HTTPS_PROXY.
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:XValidation:rule="isURL(self) && url(self).getScheme() in ['http', 'https']",message="httpsProxy must be an http or https URL"
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	// NoProxy is a comma-separated list of hostnames, domains, IP addresses and CIDRs that
+	// are reached directly, set as NO_PROXY. The service CIDRs of the cluster and the
+	// in-cluster service domains are added to it.
+	// +optional
+	// +kubebuilder:validation:MaxLength=8192
+	NoProxy string `json:"noProxy,omitempty"`
+}
+
+// DriftedResource records an operator-managed resource that was changed outside the operator
+// (for example with kubectl edit) and restored by a later reconcile.
+type DriftedResource struct {
+	// Kind of the drifted resource.
+	Kind string `json:"kind"`
+
+	// Namespace of the drifted resource. Empty for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the drifted resource.
+	Name string `json:"name"`
+
+	// Managers are the field managers whose changes were reverted.
+	// +optional
+	// +listType=set
+	Managers []string `json:"managers,omitempty"`
+
+	// LastDetected is when the drift was last detected.
+	LastDetected metav1.Time `json:"lastDetected"`
+}
+
+// ManifestProvenance describes what the manifests the operator applied for a component were
+// built from.
+type ManifestProvenance struct {
+	// KustomizePath is the kustomization in the operator repository the manifests were built
+	// from. Empty for manifests loaded from a --manifest-source.
+	// +optional
+	KustomizePath string `json:"kustomizePath,omitempty"`
+
+	// Upstreams are the upstream resources the kustomization pulls in.
+	// +optional
+	// +listType=atomic
+	Upstreams []ManifestUpstream `json:"upstreams,omitempty"`
+
+	// BuildTime is when the manifests were built. Unset for manifests loaded from a
+	// --manifest-source.
+	// +optional
+	BuildTime *metav1.Time `json:"buildTime,omitempty"`
+
+	// ContentHash is the sha256 digest of the manifests, "sha256:<hex>".
+	// +optional
+	ContentHash string `json:"contentHash,omitempty"`
+}
+
+// ManifestUpstream is an upstream resource the manifests of a component were built from.
+type ManifestUpstream struct {
+	// Repository is the GitHub repository, "<owner>/<repo>".
+	Repository string `json:"repository"`
+
+	// Path is the directory of the resource in the repository.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Revision is the git ref the resource is pinned to.
+	Revision string `json:"revision"`
+}
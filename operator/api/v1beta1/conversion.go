@@ -0,0 +1,109 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// durationToHub formats a typed duration as the v1alpha1 duration string.
+// Durations admitted by the v1beta1 schema are whole seconds, so the h/m/s string
+// produced by time.Duration.String also satisfies the v1alpha1 pattern.
+func durationToHub(d *metav1.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return d.Duration.String()
+}
+
+// durationFromHub parses a v1alpha1 duration string. An empty string means unset.
+func durationFromHub(field, s string) (*metav1.Duration, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s %q: %w", field, s, err)
+	}
+	return &metav1.Duration{Duration: d}, nil
+}
+
+// countToHub formats a typed count as the v1alpha1 decimal string.
+func countToHub(n *int32) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.FormatInt(int64(*n), 10)
+}
+
+// countFromHub parses a v1alpha1 decimal count string. An empty string means unset.
+// Objects stored before the v1alpha1 schema bounded counts to int32 may hold larger values;
+// they are read as math.MaxInt32, which keeps as many snapshots as any int32 count can.
+func countFromHub(field, s string) (*int32, error) {
+	if s == "" {
+		return nil, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if errors.Is(err, strconv.ErrRange) && !strings.HasPrefix(s, "-") {
+		return ptr.To[int32](math.MaxInt32), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s %q: %w", field, s, err)
+	}
+	return ptr.To(int32(n)), nil
+}
+
+// convertSlice converts every element of src with convert.
+func convertSlice[S, D any](src []S, convert func(*S) *D) []D {
+	if src == nil {
+		return nil
+	}
+	dst := make([]D, len(src))
+	for i := range src {
+		dst[i] = *convert(&src[i])
+	}
+	return dst
+}
+
+// convertSlicePtr converts every element of *src with convert.
+func convertSlicePtr[S, D any](src *[]S, convert func(*S) *D) *[]D {
+	if src == nil {
+		return nil
+	}
+	dst := convertSlice(*src, convert)
+	return &dst
+}
+
+// convertMap converts every value of src with convert.
+func convertMap[K comparable, S, D any](src map[K]S, convert func(*S) *D) map[K]D {
+	if src == nil {
+		return nil
+	}
+	dst := make(map[K]D, len(src))
+	for k, v := range src {
+		dst[k] = *convert(&v)
+	}
+	return dst
+}
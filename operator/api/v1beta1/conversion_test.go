@@ -0,0 +1,233 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/randfill"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+const fuzzIterations = 500
+
+// newFiller returns a randfill.Filler restricted to values that are representable in both
+// versions. The v1alpha1 string fields are filled with canonical strings (as produced by
+// time.Duration.String and strconv.Itoa), and the settings-only v1beta1 components always
+// carry a spec, since an empty and an absent spec do not survive a round trip by design.
+func newFiller() *randfill.Filler {
+	return randfill.New().NilChance(0.3).NumElements(0, 2).Funcs(
+		func(s *KonfluxSpec, c randfill.Continue) {
+			c.FillNoCustom(s)
+			if s.EnterpriseContract != nil && s.EnterpriseContract.Spec == nil {
+				s.EnterpriseContract.Spec = &EnterpriseContractSpec{}
+			}
+			if s.CertManager != nil && s.CertManager.Spec == nil {
+				s.CertManager.Spec = &CertManagerSpec{}
+			}
+		},
+		func(s *konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec, c randfill.Continue) {
+			c.FillNoCustom(s)
+			s.PipelineTimeout = hubDuration(c)
+			s.TasksTimeout = hubDuration(c)
+			s.FinallyTimeout = hubDuration(c)
+			s.PRSnapshotsToKeep = hubCount(c)
+			s.NonPRSnapshotsToKeep = hubCount(c)
+			s.MinSnapshotsToKeepPerComponent = hubCount(c)
+		},
+		func(s *konfluxv1alpha1.KonfluxNamespaceListerSpec, c randfill.Continue) {
+			c.FillNoCustom(s)
+			s.CacheResyncPeriod = hubDuration(c)
+		},
+		func(s *konfluxv1alpha1.KonfluxRBACSpec, _ randfill.Continue) {
+			s.Foo = ""
+		},
+	)
+}
+
+func hubDuration(c randfill.Continue) string {
+	if c.Bool() {
+		return ""
+	}
+	return time.Duration(c.Int63()).String()
+}
+
+func hubCount(c randfill.Continue) string {
+	if c.Bool() {
+		return ""
+	}
+	return strconv.Itoa(int(c.Int31()))
+}
+
+// testRoundTrip fuzzes spoke -> hub -> spoke and hub -> spoke -> hub conversions and
+// expects both to be lossless. TypeMeta is cleared as conversions do not set it.
+func testRoundTrip(t *testing.T, newSpoke func() conversion.Convertible, newHub func() conversion.Hub) {
+	t.Run("spoke-hub-spoke", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		f := newFiller()
+		for range fuzzIterations {
+			spoke := newSpoke()
+			f.Fill(spoke)
+			spoke.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+
+			hub := newHub()
+			g.Expect(spoke.ConvertTo(hub)).To(gomega.Succeed())
+			got := newSpoke()
+			g.Expect(got.ConvertFrom(hub)).To(gomega.Succeed())
+
+			g.Expect(apiequality.Semantic.DeepEqual(spoke, got)).To(gomega.BeTrue(),
+				"round trip mismatch:\nwant: %#v\ngot:  %#v", spoke, got)
+		}
+	})
+
+	t.Run("hub-spoke-hub", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		f := newFiller()
+		for range fuzzIterations {
+			hub := newHub()
+			f.Fill(hub)
+			hub.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+
+			spoke := newSpoke()
+			g.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+			got := newHub()
+			g.Expect(spoke.ConvertTo(got)).To(gomega.Succeed())
+
+			g.Expect(apiequality.Semantic.DeepEqual(hub, got)).To(gomega.BeTrue(),
+				"round trip mismatch:\nwant: %#v\ngot:  %#v", hub, got)
+		}
+	})
+}
+
+func TestKonfluxConversionRoundTrip(t *testing.T) {
+	testRoundTrip(t,
+		func() conversion.Convertible { return &Konflux{} },
+		func() conversion.Hub { return &konfluxv1alpha1.Konflux{} },
+	)
+}
+
+func TestKonfluxIntegrationServiceConversionRoundTrip(t *testing.T) {
+	testRoundTrip(t,
+		func() conversion.Convertible { return &KonfluxIntegrationService{} },
+		func() conversion.Hub { return &konfluxv1alpha1.KonfluxIntegrationService{} },
+	)
+}
+
+func TestKonfluxNamespaceListerConversionRoundTrip(t *testing.T) {
+	testRoundTrip(t,
+		func() conversion.Convertible { return &KonfluxNamespaceLister{} },
+		func() conversion.Hub { return &konfluxv1alpha1.KonfluxNamespaceLister{} },
+	)
+}
+
+func TestKonfluxRBACConversionRoundTrip(t *testing.T) {
+	testRoundTrip(t,
+		func() conversion.Convertible { return &KonfluxRBAC{} },
+		func() conversion.Hub { return &konfluxv1alpha1.KonfluxRBAC{} },
+	)
+}
+
+func TestKonfluxIntegrationServiceConvertFrom(t *testing.T) {
+	t.Run("parses durations and counts", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		hub := &konfluxv1alpha1.KonfluxIntegrationService{
+			Spec: konfluxv1alpha1.KonfluxIntegrationServiceSpec{
+				KonfluxIntegrationServiceConfigSpec: konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec{
+					PipelineTimeout:   "90m",
+					PRSnapshotsToKeep: "512",
+				},
+			},
+		}
+
+		spoke := &KonfluxIntegrationService{}
+		g.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+		g.Expect(spoke.Spec.PipelineTimeout).To(gomega.Equal(&metav1.Duration{Duration: 90 * time.Minute}))
+		g.Expect(spoke.Spec.TasksTimeout).To(gomega.BeNil())
+		g.Expect(spoke.Spec.PRSnapshotsToKeep).To(gomega.Equal(ptr.To[int32](512)))
+		g.Expect(spoke.Spec.NonPRSnapshotsToKeep).To(gomega.BeNil())
+
+		back := &konfluxv1alpha1.KonfluxIntegrationService{}
+		g.Expect(spoke.ConvertTo(back)).To(gomega.Succeed())
+		g.Expect(back.Spec.PipelineTimeout).To(gomega.Equal("1h30m0s"))
+		g.Expect(back.Spec.PRSnapshotsToKeep).To(gomega.Equal("512"))
+	})
+
+	t.Run("reads counts beyond int32 as the largest int32", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		hub := &konfluxv1alpha1.KonfluxIntegrationService{
+			Spec: konfluxv1alpha1.KonfluxIntegrationServiceSpec{
+				KonfluxIntegrationServiceConfigSpec: konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec{
+					PRSnapshotsToKeep:    "4294967296",
+					NonPRSnapshotsToKeep: "2147483647",
+				},
+			},
+		}
+
+		spoke := &KonfluxIntegrationService{}
+		g.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+		g.Expect(spoke.Spec.PRSnapshotsToKeep).To(gomega.Equal(ptr.To[int32](math.MaxInt32)))
+		g.Expect(spoke.Spec.NonPRSnapshotsToKeep).To(gomega.Equal(ptr.To[int32](math.MaxInt32)))
+	})
+
+	t.Run("rejects unparsable values", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		hub := &konfluxv1alpha1.KonfluxIntegrationService{
+			Spec: konfluxv1alpha1.KonfluxIntegrationServiceSpec{
+				KonfluxIntegrationServiceConfigSpec: konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec{
+					TasksTimeout:         "forever",
+					NonPRSnapshotsToKeep: "many",
+				},
+			},
+		}
+
+		err := (&KonfluxIntegrationService{}).ConvertFrom(hub)
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(err.Error()).To(gomega.ContainSubstring(`tasksTimeout "forever"`))
+		g.Expect(err.Error()).To(gomega.ContainSubstring(`nonPRSnapshotsToKeep "many"`))
+	})
+}
+
+func TestKonfluxConvertTo(t *testing.T) {
+	g := gomega.NewWithT(t)
+	spoke := &Konflux{
+		Spec: KonfluxSpec{
+			UI:                 &UIConfig{Enabled: ptr.To(true)},
+			ReleaseService:     &ReleaseServiceConfig{Enabled: ptr.To(false)},
+			EnterpriseContract: &EnterpriseContractConfig{Spec: &EnterpriseContractSpec{SkipPolicies: true}},
+			CertManager:        &CertManagerConfig{Spec: &CertManagerSpec{CreateClusterIssuer: ptr.To(false)}},
+			InternalRegistry:   &InternalRegistryConfig{Enabled: ptr.To(true)},
+		},
+	}
+
+	hub := &konfluxv1alpha1.Konflux{}
+	g.Expect(spoke.ConvertTo(hub)).To(gomega.Succeed())
+	g.Expect(hub.Spec.KonfluxUI).To(gomega.Equal(&konfluxv1alpha1.KonfluxUIConfig{Enabled: ptr.To(true)}))
+	g.Expect(hub.Spec.IsReleaseServiceEnabled()).To(gomega.BeFalse())
+	g.Expect(hub.Spec.EnterpriseContract.SkipPolicies).To(gomega.BeTrue())
+	g.Expect(hub.Spec.CertManager.CreateClusterIssuer).To(gomega.Equal(ptr.To(false)))
+	g.Expect(hub.Spec.IsInternalRegistryEnabled()).To(gomega.BeTrue())
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1beta1 contains API Schema definitions for the konflux v1beta1 API group.
+//
+// v1beta1 replaces the stringly-typed durations and counts of v1alpha1 with typed fields
+// and gives every component on the Konflux CR the same {enabled, spec} shape. Only the
+// kinds whose schema changed are defined here; v1alpha1 remains the storage version and
+// the conversion hub.
+// +kubebuilder:object:generate=true
+// +groupName=konflux.konflux-ci.dev
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "konflux.konflux-ci.dev", Version: "v1beta1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+// addKnownTypes registers all v1beta1 types with the scheme.
+// When adding a new CR, register its type and list type here and in TestAddToScheme.
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(GroupVersion,
+		&Konflux{}, &KonfluxList{},
+		&KonfluxIntegrationService{}, &KonfluxIntegrationServiceList{},
+		&KonfluxNamespaceLister{}, &KonfluxNamespaceListerList{},
+		&KonfluxRBAC{}, &KonfluxRBACList{},
+	)
+	metav1.AddToGroupVersion(scheme, GroupVersion)
+	return nil
+}
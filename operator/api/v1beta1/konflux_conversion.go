@@ -0,0 +1,389 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// ConvertTo converts this Konflux to the Hub version (v1alpha1).
+func (src *Konflux) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*konfluxv1alpha1.Konflux)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = *konfluxStatusToHub(&src.Status)
+
+	in := &src.Spec
+	out := &dst.Spec
+	*out = konfluxv1alpha1.KonfluxSpec{}
+
+	if in.ImageController != nil {
+		out.ImageController = &konfluxv1alpha1.ImageControllerConfig{
+			Enabled: in.ImageController.Enabled,
+			Spec:    konfluxImageControllerConfigSpecToHub(in.ImageController.Spec),
+		}
+	}
+	if in.UI != nil {
+		out.KonfluxUI = &konfluxv1alpha1.KonfluxUIConfig{Enabled: in.UI.Enabled, Spec: konfluxUIConfigSpecToHub(in.UI.Spec)}
+	}
+	if in.IntegrationService != nil {
+		out.KonfluxIntegrationService = &konfluxv1alpha1.IntegrationServiceConfig{Enabled: in.IntegrationService.Enabled}
+		if in.IntegrationService.Spec != nil {
+			out.KonfluxIntegrationService.Spec = &konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec{}
+			convertIntegrationServiceConfigSpecToHub(in.IntegrationService.Spec, out.KonfluxIntegrationService.Spec)
+		}
+	}
+	if in.ReleaseService != nil {
+		out.KonfluxReleaseService = &konfluxv1alpha1.ReleaseServiceConfig{
+			Enabled: in.ReleaseService.Enabled,
+			Spec:    konfluxReleaseServiceConfigSpecToHub(in.ReleaseService.Spec),
+		}
+	}
+	if in.BuildService != nil {
+		out.KonfluxBuildService = &konfluxv1alpha1.BuildServiceConfig{
+			Enabled: in.BuildService.Enabled,
+			Spec:    konfluxBuildServiceConfigSpecToHub(in.BuildService.Spec),
+		}
+	}
+	if in.NamespaceLister != nil {
+		out.NamespaceLister = &konfluxv1alpha1.NamespaceListerConfig{Enabled: in.NamespaceLister.Enabled}
+		if in.NamespaceLister.Spec != nil {
+			out.NamespaceLister.Spec = &konfluxv1alpha1.KonfluxNamespaceListerSpec{}
+			convertNamespaceListerSpecToHub(in.NamespaceLister.Spec, out.NamespaceLister.Spec)
+		}
+	}
+	if in.Info != nil {
+		out.KonfluxInfo = &konfluxv1alpha1.KonfluxInfoConfig{Enabled: in.Info.Enabled, Spec: konfluxInfoSpecToHub(in.Info.Spec)}
+	}
+	if in.EnterpriseContract != nil {
+		out.EnterpriseContract = &konfluxv1alpha1.EnterpriseContractConfig{Enabled: in.EnterpriseContract.Enabled}
+		if in.EnterpriseContract.Spec != nil {
+			out.EnterpriseContract.SkipPolicies = in.EnterpriseContract.Spec.SkipPolicies
+		}
+	}
+	if in.CertManager != nil {
+		out.CertManager = &konfluxv1alpha1.CertManagerConfig{Enabled: in.CertManager.Enabled}
+		if in.CertManager.Spec != nil {
+			out.CertManager.CreateClusterIssuer = in.CertManager.Spec.CreateClusterIssuer
+		}
+	}
+	if in.InternalRegistry != nil {
+		out.InternalRegistry = &konfluxv1alpha1.InternalRegistryConfig{
+			Enabled: in.InternalRegistry.Enabled,
+			Spec:    konfluxInternalRegistrySpecToHub(in.InternalRegistry.Spec),
+		}
+	}
+	if in.DefaultTenant != nil {
+		out.DefaultTenant = &konfluxv1alpha1.DefaultTenantConfig{Enabled: in.DefaultTenant.Enabled}
+	}
+	if in.Telemetry != nil {
+		out.Telemetry = &konfluxv1alpha1.TelemetryConfig{
+			Enabled: in.Telemetry.Enabled,
+			Spec:    konfluxSegmentBridgeSpecToHub(in.Telemetry.Spec),
+		}
+	}
+	if in.CLI != nil {
+		out.CLI = &konfluxv1alpha1.CLIConfig{Enabled: in.CLI.Enabled}
+	}
+	out.ComponentMetrics = componentMetricsToHub(in.ComponentMetrics)
+	out.PodPlacement = podPlacementSpecToHub(in.PodPlacement)
+	out.Patches = convertSlice(in.Patches, objectPatchToHub)
+	out.ImageRegistryMirrors = convertSlice(in.ImageRegistryMirrors, imageRegistryMirrorToHub)
+	out.ImagePullSecrets = convertSlice(in.ImagePullSecrets, imagePullSecretReferenceToHub)
+	out.ImagePullPolicy = in.ImagePullPolicy
+	out.Proxy = egressProxySpecToHub(in.Proxy)
+	out.Logging = loggingSpecToHub(in.Logging)
+	out.Profile = konfluxv1alpha1.KonfluxProfile(in.Profile)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version.
+// Settings-only components (enterpriseContract, certManager) always get a spec when
+// present, so an empty v1beta1 spec and an absent one are equivalent.
+func (dst *Konflux) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*konfluxv1alpha1.Konflux)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = *konfluxStatusFromHub(&src.Status)
+
+	in := &src.Spec
+	out := &dst.Spec
+	*out = KonfluxSpec{}
+
+	if in.ImageController != nil {
+		out.ImageController = &ImageControllerConfig{
+			Enabled: in.ImageController.Enabled,
+			Spec:    konfluxImageControllerConfigSpecFromHub(in.ImageController.Spec),
+		}
+	}
+	if in.KonfluxUI != nil {
+		out.UI = &UIConfig{Enabled: in.KonfluxUI.Enabled, Spec: konfluxUIConfigSpecFromHub(in.KonfluxUI.Spec)}
+	}
+	if in.KonfluxIntegrationService != nil {
+		out.IntegrationService = &IntegrationServiceConfig{Enabled: in.KonfluxIntegrationService.Enabled}
+		if in.KonfluxIntegrationService.Spec != nil {
+			out.IntegrationService.Spec = &KonfluxIntegrationServiceConfigSpec{}
+			if err := convertIntegrationServiceConfigSpecFromHub(in.KonfluxIntegrationService.Spec, out.IntegrationService.Spec); err != nil {
+				return err
+			}
+		}
+	}
+	if in.KonfluxReleaseService != nil {
+		out.ReleaseService = &ReleaseServiceConfig{
+			Enabled: in.KonfluxReleaseService.Enabled,
+			Spec:    konfluxReleaseServiceConfigSpecFromHub(in.KonfluxReleaseService.Spec),
+		}
+	}
+	if in.KonfluxBuildService != nil {
+		out.BuildService = &BuildServiceConfig{
+			Enabled: in.KonfluxBuildService.Enabled,
+			Spec:    konfluxBuildServiceConfigSpecFromHub(in.KonfluxBuildService.Spec),
+		}
+	}
+	if in.NamespaceLister != nil {
+		out.NamespaceLister = &NamespaceListerConfig{Enabled: in.NamespaceLister.Enabled}
+		if in.NamespaceLister.Spec != nil {
+			out.NamespaceLister.Spec = &KonfluxNamespaceListerSpec{}
+			if err := convertNamespaceListerSpecFromHub(in.NamespaceLister.Spec, out.NamespaceLister.Spec); err != nil {
+				return err
+			}
+		}
+	}
+	if in.KonfluxInfo != nil {
+		out.Info = &InfoConfig{Enabled: in.KonfluxInfo.Enabled, Spec: konfluxInfoSpecFromHub(in.KonfluxInfo.Spec)}
+	}
+	if in.EnterpriseContract != nil {
+		out.EnterpriseContract = &EnterpriseContractConfig{
+			Enabled: in.EnterpriseContract.Enabled,
+			Spec:    &EnterpriseContractSpec{SkipPolicies: in.EnterpriseContract.SkipPolicies},
+		}
+	}
+	if in.CertManager != nil {
+		out.CertManager = &CertManagerConfig{
+			Enabled: in.CertManager.Enabled,
+			Spec:    &CertManagerSpec{CreateClusterIssuer: in.CertManager.CreateClusterIssuer},
+		}
+	}
+	if in.InternalRegistry != nil {
+		out.InternalRegistry = &InternalRegistryConfig{
+			Enabled: in.InternalRegistry.Enabled,
+			Spec:    konfluxInternalRegistrySpecFromHub(in.InternalRegistry.Spec),
+		}
+	}
+	if in.DefaultTenant != nil {
+		out.DefaultTenant = &DefaultTenantConfig{Enabled: in.DefaultTenant.Enabled}
+	}
+	if in.Telemetry != nil {
+		out.Telemetry = &TelemetryConfig{
+			Enabled: in.Telemetry.Enabled,
+			Spec:    konfluxSegmentBridgeSpecFromHub(in.Telemetry.Spec),
+		}
+	}
+	if in.CLI != nil {
+		out.CLI = &CLIConfig{Enabled: in.CLI.Enabled}
+	}
+	out.ComponentMetrics = componentMetricsFromHub(in.ComponentMetrics)
+	out.PodPlacement = podPlacementSpecFromHub(in.PodPlacement)
+	out.Patches = convertSlice(in.Patches, objectPatchFromHub)
+	out.ImageRegistryMirrors = convertSlice(in.ImageRegistryMirrors, imageRegistryMirrorFromHub)
+	out.ImagePullSecrets = convertSlice(in.ImagePullSecrets, imagePullSecretReferenceFromHub)
+	out.ImagePullPolicy = in.ImagePullPolicy
+	out.Proxy = egressProxySpecFromHub(in.Proxy)
+	out.Logging = loggingSpecFromHub(in.Logging)
+	out.Profile = KonfluxProfile(in.Profile)
+
+	return nil
+}
+
+func componentStatusToHub(src *ComponentStatus) *konfluxv1alpha1.ComponentStatus {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ComponentStatus{
+		Name:               src.Name,
+		Ready:              src.Ready,
+		Message:            src.Message,
+		ObservedGeneration: src.ObservedGeneration,
+		LastTransitionTime: src.LastTransitionTime,
+		ManifestVersion:    src.ManifestVersion,
+		UpstreamRevisions:  src.UpstreamRevisions,
+		ManifestSource:     manifestSourceStatusToHub(src.ManifestSource),
+		Replicas:           replicaStatusToHub(src.Replicas),
+		Images:             convertSlice(src.Images, runningImageToHub),
+	}
+}
+
+func componentStatusFromHub(src *konfluxv1alpha1.ComponentStatus) *ComponentStatus {
+	if src == nil {
+		return nil
+	}
+	return &ComponentStatus{
+		Name:               src.Name,
+		Ready:              src.Ready,
+		Message:            src.Message,
+		ObservedGeneration: src.ObservedGeneration,
+		LastTransitionTime: src.LastTransitionTime,
+		ManifestVersion:    src.ManifestVersion,
+		UpstreamRevisions:  src.UpstreamRevisions,
+		ManifestSource:     manifestSourceStatusFromHub(src.ManifestSource),
+		Replicas:           replicaStatusFromHub(src.Replicas),
+		Images:             convertSlice(src.Images, runningImageFromHub),
+	}
+}
+
+func manifestSourceStatusToHub(src *ManifestSourceStatus) *konfluxv1alpha1.ManifestSourceStatus {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ManifestSourceStatus{
+		Type:           src.Type,
+		Reference:      src.Reference,
+		Digest:         src.Digest,
+		FallbackReason: src.FallbackReason,
+	}
+}
+
+func manifestSourceStatusFromHub(src *konfluxv1alpha1.ManifestSourceStatus) *ManifestSourceStatus {
+	if src == nil {
+		return nil
+	}
+	return &ManifestSourceStatus{
+		Type:           src.Type,
+		Reference:      src.Reference,
+		Digest:         src.Digest,
+		FallbackReason: src.FallbackReason,
+	}
+}
+
+func replicaStatusToHub(src *ReplicaStatus) *konfluxv1alpha1.ReplicaStatus {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ReplicaStatus{
+		Desired:   src.Desired,
+		Available: src.Available,
+	}
+}
+
+func replicaStatusFromHub(src *konfluxv1alpha1.ReplicaStatus) *ReplicaStatus {
+	if src == nil {
+		return nil
+	}
+	return &ReplicaStatus{
+		Desired:   src.Desired,
+		Available: src.Available,
+	}
+}
+
+func runningImageToHub(src *RunningImage) *konfluxv1alpha1.RunningImage {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.RunningImage{
+		Container: src.Container,
+		Image:     src.Image,
+		ImageID:   src.ImageID,
+	}
+}
+
+func runningImageFromHub(src *konfluxv1alpha1.RunningImage) *RunningImage {
+	if src == nil {
+		return nil
+	}
+	return &RunningImage{
+		Container: src.Container,
+		Image:     src.Image,
+		ImageID:   src.ImageID,
+	}
+}
+
+func rolloutStatusToHub(src *RolloutStatus) *konfluxv1alpha1.RolloutStatus {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.RolloutStatus{
+		Phase:       src.Phase,
+		TotalPhases: src.TotalPhases,
+		Blocked:     convertSlice(src.Blocked, blockedComponentToHub),
+	}
+}
+
+func rolloutStatusFromHub(src *konfluxv1alpha1.RolloutStatus) *RolloutStatus {
+	if src == nil {
+		return nil
+	}
+	return &RolloutStatus{
+		Phase:       src.Phase,
+		TotalPhases: src.TotalPhases,
+		Blocked:     convertSlice(src.Blocked, blockedComponentFromHub),
+	}
+}
+
+func blockedComponentToHub(src *BlockedComponent) *konfluxv1alpha1.BlockedComponent {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.BlockedComponent{
+		Name:       src.Name,
+		WaitingFor: src.WaitingFor,
+	}
+}
+
+func blockedComponentFromHub(src *konfluxv1alpha1.BlockedComponent) *BlockedComponent {
+	if src == nil {
+		return nil
+	}
+	return &BlockedComponent{
+		Name:       src.Name,
+		WaitingFor: src.WaitingFor,
+	}
+}
+
+func konfluxStatusToHub(src *KonfluxStatus) *konfluxv1alpha1.KonfluxStatus {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxStatus{
+		Conditions:            src.Conditions,
+		Components:            convertSlice(src.Components, componentStatusToHub),
+		UIURL:                 src.UIURL,
+		Rollout:               rolloutStatusToHub(src.Rollout),
+		InstalledVersion:      src.InstalledVersion,
+		TargetVersion:         src.TargetVersion,
+		CompletedUpgradeSteps: src.CompletedUpgradeSteps,
+		UnmirroredImages:      src.UnmirroredImages,
+		Proxy:                 egressProxySpecToHub(src.Proxy),
+	}
+}
+
+func konfluxStatusFromHub(src *konfluxv1alpha1.KonfluxStatus) *KonfluxStatus {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxStatus{
+		Conditions:            src.Conditions,
+		Components:            convertSlice(src.Components, componentStatusFromHub),
+		UIURL:                 src.UIURL,
+		Rollout:               rolloutStatusFromHub(src.Rollout),
+		InstalledVersion:      src.InstalledVersion,
+		TargetVersion:         src.TargetVersion,
+		CompletedUpgradeSteps: src.CompletedUpgradeSteps,
+		UnmirroredImages:      src.UnmirroredImages,
+		Proxy:                 egressProxySpecFromHub(src.Proxy),
+	}
+}
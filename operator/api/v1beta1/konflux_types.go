@@ -0,0 +1,493 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KonfluxSpec defines the desired state of Konflux.
+// Every component uses the same shape: an optional Enabled switch and an optional Spec
+// that is copied to the component CR by the operator.
+type KonfluxSpec struct {
+	// ImageController configures the image-controller component.
+	// +optional
+	ImageController *ImageControllerConfig `json:"imageController,omitempty"`
+
+	// UI configures the UI component.
+	// +optional
+	UI *UIConfig `json:"ui,omitempty"`
+
+	// IntegrationService configures the integration-service component.
+	// +optional
+	IntegrationService *IntegrationServiceConfig `json:"integrationService,omitempty"`
+
+	// ReleaseService configures the release-service component.
+	// +optional
+	ReleaseService *ReleaseServiceConfig `json:"releaseService,omitempty"`
+
+	// BuildService configures the build-service component.
+	// +optional
+	BuildService *BuildServiceConfig `json:"buildService,omitempty"`
+
+	// NamespaceLister configures the namespace-lister component.
+	// +optional
+	NamespaceLister *NamespaceListerConfig `json:"namespaceLister,omitempty"`
+
+	// Info configures the info component.
+	// +optional
+	Info *InfoConfig `json:"info,omitempty"`
+
+	// EnterpriseContract configures the enterprise-contract component.
+	// +optional
+	EnterpriseContract *EnterpriseContractConfig `json:"enterpriseContract,omitempty"`
+
+	// CertManager configures the cert-manager component.
+	// +optional
+	CertManager *CertManagerConfig `json:"certManager,omitempty"`
+
+	// InternalRegistry configures the internal registry component.
+	// Enabling internal registry requires trust-manager to be deployed.
+	// +optional
+	InternalRegistry *InternalRegistryConfig `json:"internalRegistry,omitempty"`
+
+	// DefaultTenant configures the default tenant component.
+	// The default tenant provides a namespace accessible by all authenticated users.
+	// +optional
+	DefaultTenant *DefaultTenantConfig `json:"defaultTenant,omitempty"`
+
+	// Telemetry configures the user-facing telemetry component
+	// (internally backed by segment-bridge).
+	// +optional
+	Telemetry *TelemetryConfig `json:"telemetry,omitempty"`
+
+	// CLI configures the CLI component.
+	// +optional
+	CLI *CLIConfig `json:"cli,omitempty"`
+
+	// ComponentMetrics controls Prometheus scrape resources for metrics-enabled components
+	// (see operator/docs/component-monitoring.md#scope).
+	// +optional
+	ComponentMetrics *ComponentMetricsConfig `json:"componentMetrics,omitempty"`
+
+	// PodPlacement schedules the pods of every component Deployment and CronJob, for example
+	// onto dedicated infra nodes. Components can override it with their own podPlacement.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches change objects rendered from the embedded manifests before they are applied,
+	// for settings that the typed API does not cover. Each patch is forwarded to the component
+	// whose manifests contain its target; patches that match no object are reported in the
+	// PatchesMatched condition.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of every component to the registries that
+	// mirror them, for clusters that cannot pull from the upstream registries. The longest
+	// matching source wins. Images of the embedded manifests that match no source are listed
+	// in status.unmirroredImages.
+	// +optional
+	// +listType=map
+	// +listMapKey=source
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePullSecrets are Secrets with registry credentials for the component images. The
+	// operator copies them into the namespaces of the components and adds them to the pod
+	// templates and ServiceAccounts. Their names must be unique.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ImagePullSecrets []ImagePullSecretReference `json:"imagePullSecrets,omitempty"`
+
+	// ImagePullPolicy replaces the imagePullPolicy of every container of the components.
+	// When empty, the policies of the manifests are kept.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// Proxy sets the egress proxy of every container of the components, for clusters that
+	// reach external services such as GitHub or Quay only through a proxy. When not set, the
+	// cluster-wide Proxy of OpenShift is used; set it to {} to disable that.
+	// +optional
+	Proxy *EgressProxySpec `json:"proxy,omitempty"`
+
+	// Logging sets the log level and format of every component that supports them. The
+	// components with a spec accept a logging override, for example to enable debug logs
+	// of a single service.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+
+	// Profile sizes the components for a kind of installation: the resources of their
+	// containers, their replicas, and with them leader election and PodDisruptionBudgets.
+	// Settings of the components take precedence over the profile. When omitted, the sizing
+	// of the manifests is kept.
+	// +optional
+	Profile KonfluxProfile `json:"profile,omitempty"`
+}
+
+// ImageControllerConfig defines the configuration for the image-controller component.
+type ImageControllerConfig struct {
+	// Enabled indicates whether image-controller should be deployed.
+	// Defaults to false if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the image-controller component.
+	// +optional
+	Spec *KonfluxImageControllerConfigSpec `json:"spec,omitempty"`
+}
+
+// UIConfig defines the configuration for the UI component.
+type UIConfig struct {
+	// Enabled controls whether the UI is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the UI component.
+	// +optional
+	Spec *KonfluxUIConfigSpec `json:"spec,omitempty"`
+}
+
+// IntegrationServiceConfig defines the configuration for the integration-service component.
+type IntegrationServiceConfig struct {
+	// Enabled controls whether integration-service is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the integration-service component.
+	// +optional
+	Spec *KonfluxIntegrationServiceConfigSpec `json:"spec,omitempty"`
+}
+
+// ReleaseServiceConfig defines the configuration for the release-service component.
+type ReleaseServiceConfig struct {
+	// Enabled controls whether release-service is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the release-service component.
+	// +optional
+	Spec *KonfluxReleaseServiceConfigSpec `json:"spec,omitempty"`
+}
+
+// BuildServiceConfig defines the configuration for the build-service component.
+type BuildServiceConfig struct {
+	// Enabled controls whether build-service is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the build-service component.
+	// +optional
+	Spec *KonfluxBuildServiceConfigSpec `json:"spec,omitempty"`
+}
+
+// NamespaceListerConfig defines the configuration for the namespace-lister component.
+type NamespaceListerConfig struct {
+	// Enabled controls whether namespace-lister is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the namespace-lister component.
+	// +optional
+	Spec *KonfluxNamespaceListerSpec `json:"spec,omitempty"`
+}
+
+// InfoConfig defines the configuration for the info component.
+type InfoConfig struct {
+	// Enabled controls whether the info component is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the info component.
+	// +optional
+	Spec *KonfluxInfoSpec `json:"spec,omitempty"`
+}
+
+// EnterpriseContractConfig defines the configuration for the enterprise-contract component.
+type EnterpriseContractConfig struct {
+	// Enabled controls whether enterprise-contract is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the enterprise-contract component.
+	// +optional
+	Spec *EnterpriseContractSpec `json:"spec,omitempty"`
+}
+
+// EnterpriseContractSpec defines the enterprise-contract settings.
+type EnterpriseContractSpec struct {
+	// SkipPolicies disables deployment of EnterpriseContractPolicy resources.
+	// When true, only CRDs, namespace, RBAC, and ConfigMap are deployed;
+	// users are expected to manage policies externally.
+	// +optional
+	SkipPolicies bool `json:"skipPolicies,omitempty"`
+}
+
+// CertManagerConfig defines the configuration for the cert-manager component.
+type CertManagerConfig struct {
+	// Enabled controls whether the cert-manager component is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the cert-manager component.
+	// +optional
+	Spec *CertManagerSpec `json:"spec,omitempty"`
+}
+
+// CertManagerSpec defines the cert-manager component settings.
+type CertManagerSpec struct {
+	// CreateClusterIssuer controls whether cluster issuer resources are created.
+	// Defaults to true if not specified.
+	// +optional
+	CreateClusterIssuer *bool `json:"createClusterIssuer,omitempty"`
+}
+
+// InternalRegistryConfig defines the configuration for the internal registry component.
+type InternalRegistryConfig struct {
+	// Enabled controls whether internal registry resources are deployed.
+	// Defaults to false if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the internal registry component.
+	// +optional
+	Spec *KonfluxInternalRegistrySpec `json:"spec,omitempty"`
+}
+
+// DefaultTenantConfig defines the configuration for the default tenant component.
+type DefaultTenantConfig struct {
+	// Enabled controls whether the default tenant is created.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// TelemetryConfig defines the user-facing telemetry configuration.
+type TelemetryConfig struct {
+	// Enabled controls whether the telemetry CronJob is deployed.
+	// Defaults to false if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Spec configures the telemetry component.
+	// +optional
+	Spec *KonfluxSegmentBridgeSpec `json:"spec,omitempty"`
+}
+
+// CLIConfig defines the configuration for the CLI component.
+type CLIConfig struct {
+	// Enabled controls whether the CLI component is deployed.
+	// Defaults to true if not specified.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ComponentMetricsConfig configures Prometheus scrape resources for metrics-enabled components.
+type ComponentMetricsConfig struct {
+	// Enabled controls whether scrape resources are deployed for metrics-enabled components.
+	// Defaults to true when unset.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Ready status"
+// +kubebuilder:printcolumn:name="UI-URL",type="string",JSONPath=".status.uiURL",description="URL to access the Konflux UI"
+// +kubebuilder:printcolumn:name="Phase",type="integer",JSONPath=".status.rollout.phase",description="Current rollout phase",priority=1
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.installedVersion",description="Installed operator version",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'konflux'",message="Konflux CR must be named 'konflux'. Only one instance is allowed per cluster."
+
+// Konflux is the Schema for the konfluxes API.
+type Konflux struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KonfluxSpec   `json:"spec,omitempty"`
+	Status KonfluxStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KonfluxList contains a list of Konflux.
+type KonfluxList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Konflux `json:"items"`
+}
+
+// KonfluxProfile names a curated sizing of the Konflux components.
+// +kubebuilder:validation:Enum=dev;small;production-ha
+type KonfluxProfile string
+
+// ComponentStatus represents the status of a Konflux component.
+type ComponentStatus struct {
+	// Name of the component
+	Name string `json:"name"`
+	// Ready indicates if the component is ready
+	Ready bool `json:"ready"`
+	// Message provides additional information about the component status
+	// +optional
+	Message string `json:"message,omitempty"`
+	// ObservedGeneration is the generation of the component CR that its Ready condition reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is when the component's Ready condition last changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// ManifestVersion is the operator version whose embedded manifests were last applied for the component.
+	// +optional
+	ManifestVersion string `json:"manifestVersion,omitempty"`
+	// UpstreamRevisions lists the upstream repositories and revisions the component's
+	// manifests were built from, as "<owner>/<repo>@<ref>". It is only reported once the
+	// component runs the manifests of the current operator version.
+	// +optional
+	UpstreamRevisions []string `json:"upstreamRevisions,omitempty"`
+	// ManifestSource reports where the operator loads the component's manifests from.
+	// +optional
+	ManifestSource *ManifestSourceStatus `json:"manifestSource,omitempty"`
+	// Replicas summarizes the replica availability of the component's Deployments.
+	// +optional
+	Replicas *ReplicaStatus `json:"replicas,omitempty"`
+	// Images lists the container images running in the component's pods.
+	// +optional
+	Images []RunningImage `json:"images,omitempty"`
+}
+
+// ManifestSourceStatus reports where the operator loads a component's manifests from: the
+// manifests embedded in the operator, or a source configured with the --manifest-source flag.
+type ManifestSourceStatus struct {
+	// Type is the source the manifests are loaded from.
+	// +kubebuilder:validation:Enum=Embedded;Directory;OCI
+	Type string `json:"type"`
+	// Reference is the directory or the OCI repository the manifests are loaded from.
+	// +optional
+	Reference string `json:"reference,omitempty"`
+	// Digest is the sha256 digest the manifests were verified against.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+	// FallbackReason is set when the configured source could not be loaded and the embedded
+	// manifests are used instead.
+	// +optional
+	FallbackReason string `json:"fallbackReason,omitempty"`
+}
+
+// ReplicaStatus summarizes the replicas of a component's Deployments.
+type ReplicaStatus struct {
+	// Desired is the total number of replicas requested by the Deployments.
+	Desired int32 `json:"desired"`
+	// Available is the total number of available replicas.
+	Available int32 `json:"available"`
+}
+
+// RunningImage is a container image running in a component's pods.
+type RunningImage struct {
+	// Container is the name of the container.
+	Container string `json:"container"`
+	// Image is the image reference from the pod spec.
+	Image string `json:"image"`
+	// ImageID is the image reference including the digest that is actually running,
+	// as reported by the kubelet.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+}
+
+// RolloutStatus reports the progress of the phased rollout of Konflux components.
+// A component is applied only after every component it depends on reports Ready,
+// so components are grouped into phases by the length of their dependency chain.
+type RolloutStatus struct {
+	// Phase is the first phase, starting at 1, that still has a component that is not Ready.
+	// It equals TotalPhases once every component is Ready.
+	Phase int32 `json:"phase"`
+	// TotalPhases is the number of phases in the rollout of the enabled components.
+	TotalPhases int32 `json:"totalPhases"`
+	// Blocked lists the components that were not applied because a dependency is not Ready.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Blocked []BlockedComponent `json:"blocked,omitempty"`
+}
+
+// BlockedComponent is a component whose apply is waiting on its dependencies.
+type BlockedComponent struct {
+	// Name of the component
+	Name string `json:"name"`
+	// WaitingFor lists the dependencies that are not Ready yet
+	WaitingFor []string `json:"waitingFor"`
+}
+
+// KonfluxStatus defines the observed state of Konflux.
+type KonfluxStatus struct {
+	// Conditions represent the latest available observations of the Konflux state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Components shows the status of individual Konflux components
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Components []ComponentStatus `json:"components,omitempty"`
+
+	// UIURL is the URL to access the Konflux UI.
+	// This is populated from the KonfluxUI status when ingress is enabled.
+	// +optional
+	UIURL string `json:"uiURL,omitempty"`
+
+	// Rollout shows the current rollout phase and which dependencies block the remaining components.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// InstalledVersion is the operator version whose manifests were last rolled out completely,
+	// including the post-upgrade migration steps.
+	// +optional
+	InstalledVersion string `json:"installedVersion,omitempty"`
+
+	// TargetVersion is the version of the running operator, which is rolled out when it
+	// differs from InstalledVersion.
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// CompletedUpgradeSteps lists the migration steps that already ran for the upgrade to
+	// TargetVersion. It is cleared once the upgrade completes.
+	// +optional
+	// +listType=set
+	CompletedUpgradeSteps []string `json:"completedUpgradeSteps,omitempty"`
+
+	// UnmirroredImages lists the images of the enabled components that match no entry of
+	// spec.imageRegistryMirrors and are still pulled from their upstream registry.
+	// +optional
+	// +listType=set
+	UnmirroredImages []string `json:"unmirroredImages,omitempty"`
+
+	// Proxy is the egress proxy applied to the components: spec.proxy or the cluster-wide
+	// Proxy of OpenShift, with noProxy extended by the in-cluster destinations.
+	// +optional
+	Proxy *EgressProxySpec `json:"proxy,omitempty"`
+}
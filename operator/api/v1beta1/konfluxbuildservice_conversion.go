@@ -0,0 +1,99 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func konfluxBuildServiceConfigSpecToHub(src *KonfluxBuildServiceConfigSpec) *konfluxv1alpha1.KonfluxBuildServiceConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxBuildServiceConfigSpec{
+		BuildControllerManager: controllerManagerDeploymentSpecToHub(src.BuildControllerManager),
+		PACWebhookInsecureSSL:  src.PACWebhookInsecureSSL,
+		LogEncoder:             konfluxv1alpha1.LogEncoder(src.LogEncoder),
+		WebhookURLs:            src.WebhookURLs,
+		PipelineConfig:         pipelineConfigSpecToHub(src.PipelineConfig),
+		PodPlacement:           podPlacementSpecToHub(src.PodPlacement),
+		Patches:                convertSlice(src.Patches, objectPatchToHub),
+		Logging:                loggingSpecToHub(src.Logging),
+	}
+}
+
+func konfluxBuildServiceConfigSpecFromHub(src *konfluxv1alpha1.KonfluxBuildServiceConfigSpec) *KonfluxBuildServiceConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxBuildServiceConfigSpec{
+		BuildControllerManager: controllerManagerDeploymentSpecFromHub(src.BuildControllerManager),
+		PACWebhookInsecureSSL:  src.PACWebhookInsecureSSL,
+		LogEncoder:             LogEncoder(src.LogEncoder),
+		WebhookURLs:            src.WebhookURLs,
+		PipelineConfig:         pipelineConfigSpecFromHub(src.PipelineConfig),
+		PodPlacement:           podPlacementSpecFromHub(src.PodPlacement),
+		Patches:                convertSlice(src.Patches, objectPatchFromHub),
+		Logging:                loggingSpecFromHub(src.Logging),
+	}
+}
+
+func pipelineConfigSpecToHub(src *PipelineConfigSpec) *konfluxv1alpha1.PipelineConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.PipelineConfigSpec{
+		RemoveDefaults:      src.RemoveDefaults,
+		DefaultPipelineName: src.DefaultPipelineName,
+		Pipelines:           convertSlice(src.Pipelines, pipelineSpecToHub),
+	}
+}
+
+func pipelineConfigSpecFromHub(src *konfluxv1alpha1.PipelineConfigSpec) *PipelineConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &PipelineConfigSpec{
+		RemoveDefaults:      src.RemoveDefaults,
+		DefaultPipelineName: src.DefaultPipelineName,
+		Pipelines:           convertSlice(src.Pipelines, pipelineSpecFromHub),
+	}
+}
+
+func pipelineSpecToHub(src *PipelineSpec) *konfluxv1alpha1.PipelineSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.PipelineSpec{
+		Name:        src.Name,
+		Bundle:      src.Bundle,
+		Description: src.Description,
+		Removed:     src.Removed,
+	}
+}
+
+func pipelineSpecFromHub(src *konfluxv1alpha1.PipelineSpec) *PipelineSpec {
+	if src == nil {
+		return nil
+	}
+	return &PipelineSpec{
+		Name:        src.Name,
+		Bundle:      src.Bundle,
+		Description: src.Description,
+		Removed:     src.Removed,
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func quayCABundleSpecToHub(src *QuayCABundleSpec) *konfluxv1alpha1.QuayCABundleSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.QuayCABundleSpec{
+		ConfigMapName: src.ConfigMapName,
+		Key:           src.Key,
+	}
+}
+
+func quayCABundleSpecFromHub(src *konfluxv1alpha1.QuayCABundleSpec) *QuayCABundleSpec {
+	if src == nil {
+		return nil
+	}
+	return &QuayCABundleSpec{
+		ConfigMapName: src.ConfigMapName,
+		Key:           src.Key,
+	}
+}
+
+func konfluxImageControllerConfigSpecToHub(src *KonfluxImageControllerConfigSpec) *konfluxv1alpha1.KonfluxImageControllerConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxImageControllerConfigSpec{
+		ImageControllerManager: controllerManagerDeploymentSpecToHub(src.ImageControllerManager),
+		LogEncoder:             konfluxv1alpha1.LogEncoder(src.LogEncoder),
+		ImagePruner:            containerSpecToHub(src.ImagePruner),
+		NotificationResetter:   containerSpecToHub(src.NotificationResetter),
+		QuayCABundle:           quayCABundleSpecToHub(src.QuayCABundle),
+		PodPlacement:           podPlacementSpecToHub(src.PodPlacement),
+		Patches:                convertSlice(src.Patches, objectPatchToHub),
+		Logging:                loggingSpecToHub(src.Logging),
+	}
+}
+
+func konfluxImageControllerConfigSpecFromHub(src *konfluxv1alpha1.KonfluxImageControllerConfigSpec) *KonfluxImageControllerConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxImageControllerConfigSpec{
+		ImageControllerManager: controllerManagerDeploymentSpecFromHub(src.ImageControllerManager),
+		LogEncoder:             LogEncoder(src.LogEncoder),
+		ImagePruner:            containerSpecFromHub(src.ImagePruner),
+		NotificationResetter:   containerSpecFromHub(src.NotificationResetter),
+		QuayCABundle:           quayCABundleSpecFromHub(src.QuayCABundle),
+		PodPlacement:           podPlacementSpecFromHub(src.PodPlacement),
+		Patches:                convertSlice(src.Patches, objectPatchFromHub),
+		Logging:                loggingSpecFromHub(src.Logging),
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// QuayCABundleSpec configures a custom CA bundle for Quay registry communication.
+// The referenced ConfigMap must exist in the image-controller namespace.
+type QuayCABundleSpec struct {
+	// ConfigMapName is the name of the ConfigMap containing the CA certificate.
+	// +kubebuilder:validation:MinLength=1
+	ConfigMapName string `json:"configMapName"`
+	// Key is the key within the ConfigMap that contains the CA certificate in PEM format.
+	// Must be a plain filename without path separators or directory traversal sequences.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`
+	Key string `json:"key"`
+}
+
+// KonfluxImageControllerConfigSpec defines user-configurable image-controller settings on the Konflux CR.
+type KonfluxImageControllerConfigSpec struct {
+	// ImageControllerManager defines customizations for the controller-manager deployment.
+	// +optional
+	ImageControllerManager *ControllerManagerDeploymentSpec `json:"imageControllerManager,omitempty"`
+
+	// LogEncoder sets the log encoding format for the image-controller.
+	// When not set, the upstream default (json) is used.
+	// Deprecated: use logging.encoder, which takes precedence.
+	// +optional
+	LogEncoder LogEncoder `json:"logEncoder,omitempty"`
+
+	// ImagePruner defines customizations for the image-pruner CronJob container
+	// (resources, env vars). When not set, the upstream defaults apply.
+	// +optional
+	ImagePruner *ContainerSpec `json:"imagePruner,omitempty"`
+
+	// NotificationResetter defines customizations for the notification-resetter CronJob
+	// container (resources, env vars). When not set, the upstream defaults apply.
+	// +optional
+	NotificationResetter *ContainerSpec `json:"notificationResetter,omitempty"`
+
+	// QuayCABundle configures a custom CA bundle for Quay registry communication.
+	// When set, the CA certificate from the referenced ConfigMap is mounted into the
+	// image-controller pod and used for TLS verification when connecting to Quay.
+	// This is required when using a self-hosted Quay registry with a custom CA.
+	// +optional
+	QuayCABundle *QuayCABundleSpec `json:"quayCABundle,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+}
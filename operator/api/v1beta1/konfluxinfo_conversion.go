@@ -0,0 +1,333 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func konfluxInfoSpecToHub(src *KonfluxInfoSpec) *konfluxv1alpha1.KonfluxInfoSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxInfoSpec{
+		PublicInfo:           publicInfoToHub(src.PublicInfo),
+		Banner:               bannerToHub(src.Banner),
+		ClusterConfig:        clusterConfigToHub(src.ClusterConfig),
+		Patches:              convertSlice(src.Patches, objectPatchToHub),
+		ImageRegistryMirrors: convertSlice(src.ImageRegistryMirrors, imageRegistryMirrorToHub),
+		ImagePull:            imagePullConfigToHub(src.ImagePull),
+		EgressProxy:          egressProxySpecToHub(src.EgressProxy),
+	}
+}
+
+func konfluxInfoSpecFromHub(src *konfluxv1alpha1.KonfluxInfoSpec) *KonfluxInfoSpec {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxInfoSpec{
+		PublicInfo:           publicInfoFromHub(src.PublicInfo),
+		Banner:               bannerFromHub(src.Banner),
+		ClusterConfig:        clusterConfigFromHub(src.ClusterConfig),
+		Patches:              convertSlice(src.Patches, objectPatchFromHub),
+		ImageRegistryMirrors: convertSlice(src.ImageRegistryMirrors, imageRegistryMirrorFromHub),
+		ImagePull:            imagePullConfigFromHub(src.ImagePull),
+		EgressProxy:          egressProxySpecFromHub(src.EgressProxy),
+	}
+}
+
+func bannerToHub(src *Banner) *konfluxv1alpha1.Banner {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.Banner{
+		Items: convertSlicePtr(src.Items, bannerItemToHub),
+	}
+}
+
+func bannerFromHub(src *konfluxv1alpha1.Banner) *Banner {
+	if src == nil {
+		return nil
+	}
+	return &Banner{
+		Items: convertSlicePtr(src.Items, bannerItemFromHub),
+	}
+}
+
+func publicInfoToHub(src *PublicInfo) *konfluxv1alpha1.PublicInfo {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.PublicInfo{
+		Environment:   src.Environment,
+		Visibility:    src.Visibility,
+		Integrations:  integrationsConfigToHub(src.Integrations),
+		StatusPageUrl: src.StatusPageUrl,
+		RBAC:          convertSlice(src.RBAC, rbacRoleToHub),
+	}
+}
+
+func publicInfoFromHub(src *konfluxv1alpha1.PublicInfo) *PublicInfo {
+	if src == nil {
+		return nil
+	}
+	return &PublicInfo{
+		Environment:   src.Environment,
+		Visibility:    src.Visibility,
+		Integrations:  integrationsConfigFromHub(src.Integrations),
+		StatusPageUrl: src.StatusPageUrl,
+		RBAC:          convertSlice(src.RBAC, rbacRoleFromHub),
+	}
+}
+
+func integrationsConfigToHub(src *IntegrationsConfig) *konfluxv1alpha1.IntegrationsConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.IntegrationsConfig{
+		GitHub:          gitHubIntegrationToHub(src.GitHub),
+		SBOMServer:      sbomServerConfigToHub(src.SBOMServer),
+		ImageController: infoImageControllerConfigToHub(src.ImageController),
+	}
+}
+
+func integrationsConfigFromHub(src *konfluxv1alpha1.IntegrationsConfig) *IntegrationsConfig {
+	if src == nil {
+		return nil
+	}
+	return &IntegrationsConfig{
+		GitHub:          gitHubIntegrationFromHub(src.GitHub),
+		SBOMServer:      sbomServerConfigFromHub(src.SBOMServer),
+		ImageController: infoImageControllerConfigFromHub(src.ImageController),
+	}
+}
+
+func gitHubIntegrationToHub(src *GitHubIntegration) *konfluxv1alpha1.GitHubIntegration {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.GitHubIntegration{
+		ApplicationURL: src.ApplicationURL,
+	}
+}
+
+func gitHubIntegrationFromHub(src *konfluxv1alpha1.GitHubIntegration) *GitHubIntegration {
+	if src == nil {
+		return nil
+	}
+	return &GitHubIntegration{
+		ApplicationURL: src.ApplicationURL,
+	}
+}
+
+func sbomServerConfigToHub(src *SBOMServerConfig) *konfluxv1alpha1.SBOMServerConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.SBOMServerConfig{
+		URL:     src.URL,
+		SBOMSha: src.SBOMSha,
+	}
+}
+
+func sbomServerConfigFromHub(src *konfluxv1alpha1.SBOMServerConfig) *SBOMServerConfig {
+	if src == nil {
+		return nil
+	}
+	return &SBOMServerConfig{
+		URL:     src.URL,
+		SBOMSha: src.SBOMSha,
+	}
+}
+
+func infoImageControllerConfigToHub(src *InfoImageControllerConfig) *konfluxv1alpha1.InfoImageControllerConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.InfoImageControllerConfig{
+		Enabled:       src.Enabled,
+		Notifications: convertSlice(src.Notifications, infoNotificationConfigToHub),
+	}
+}
+
+func infoImageControllerConfigFromHub(src *konfluxv1alpha1.InfoImageControllerConfig) *InfoImageControllerConfig {
+	if src == nil {
+		return nil
+	}
+	return &InfoImageControllerConfig{
+		Enabled:       src.Enabled,
+		Notifications: convertSlice(src.Notifications, infoNotificationConfigFromHub),
+	}
+}
+
+func infoNotificationConfigToHub(src *InfoNotificationConfig) *konfluxv1alpha1.InfoNotificationConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.InfoNotificationConfig{
+		Title:  src.Title,
+		Event:  src.Event,
+		Method: src.Method,
+		Config: src.Config,
+	}
+}
+
+func infoNotificationConfigFromHub(src *konfluxv1alpha1.InfoNotificationConfig) *InfoNotificationConfig {
+	if src == nil {
+		return nil
+	}
+	return &InfoNotificationConfig{
+		Title:  src.Title,
+		Event:  src.Event,
+		Method: src.Method,
+		Config: src.Config,
+	}
+}
+
+func rbacRoleToHub(src *RBACRole) *konfluxv1alpha1.RBACRole {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.RBACRole{
+		Name:        src.Name,
+		Description: src.Description,
+		DisplayName: src.DisplayName,
+	}
+}
+
+func rbacRoleFromHub(src *konfluxv1alpha1.RBACRole) *RBACRole {
+	if src == nil {
+		return nil
+	}
+	return &RBACRole{
+		Name:        src.Name,
+		Description: src.Description,
+		DisplayName: src.DisplayName,
+	}
+}
+
+func bannerItemToHub(src *BannerItem) *konfluxv1alpha1.BannerItem {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.BannerItem{
+		Summary:    src.Summary,
+		Type:       src.Type,
+		StartTime:  src.StartTime,
+		EndTime:    src.EndTime,
+		TimeZone:   src.TimeZone,
+		Year:       src.Year,
+		Month:      src.Month,
+		DayOfWeek:  src.DayOfWeek,
+		DayOfMonth: src.DayOfMonth,
+	}
+}
+
+func bannerItemFromHub(src *konfluxv1alpha1.BannerItem) *BannerItem {
+	if src == nil {
+		return nil
+	}
+	return &BannerItem{
+		Summary:    src.Summary,
+		Type:       src.Type,
+		StartTime:  src.StartTime,
+		EndTime:    src.EndTime,
+		TimeZone:   src.TimeZone,
+		Year:       src.Year,
+		Month:      src.Month,
+		DayOfWeek:  src.DayOfWeek,
+		DayOfMonth: src.DayOfMonth,
+	}
+}
+
+func clusterConfigToHub(src *ClusterConfig) *konfluxv1alpha1.ClusterConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ClusterConfig{
+		Data: clusterConfigDataToHub(src.Data),
+	}
+}
+
+func clusterConfigFromHub(src *konfluxv1alpha1.ClusterConfig) *ClusterConfig {
+	if src == nil {
+		return nil
+	}
+	return &ClusterConfig{
+		Data: clusterConfigDataFromHub(src.Data),
+	}
+}
+
+func clusterConfigDataToHub(src *ClusterConfigData) *konfluxv1alpha1.ClusterConfigData {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ClusterConfigData{
+		DefaultOIDCIssuer:            src.DefaultOIDCIssuer,
+		EnableKeylessSigning:         src.EnableKeylessSigning,
+		FulcioInternalUrl:            src.FulcioInternalUrl,
+		FulcioExternalUrl:            src.FulcioExternalUrl,
+		RekorInternalUrl:             src.RekorInternalUrl,
+		RekorExternalUrl:             src.RekorExternalUrl,
+		TufInternalUrl:               src.TufInternalUrl,
+		TufExternalUrl:               src.TufExternalUrl,
+		TrustifyServerInternalUrl:    src.TrustifyServerInternalUrl,
+		TrustifyServerExternalUrl:    src.TrustifyServerExternalUrl,
+		BuildIdentityRegexp:          src.BuildIdentityRegexp,
+		TrustifyOIDCIssuerUrl:        src.TrustifyOIDCIssuerUrl,
+		TektonChainsIdentity:         src.TektonChainsIdentity,
+		AllowCacheProxy:              src.AllowCacheProxy,
+		HTTPProxy:                    src.HTTPProxy,
+		NoProxy:                      src.NoProxy,
+		AllowPackageRegistryProxy:    src.AllowPackageRegistryProxy,
+		PackageRegistryProxyNpmURL:   src.PackageRegistryProxyNpmURL,
+		PackageRegistryProxyYarnURL:  src.PackageRegistryProxyYarnURL,
+		PackageRegistryProxyGomodURL: src.PackageRegistryProxyGomodURL,
+		PackageRegistryProxyPipURL:   src.PackageRegistryProxyPipURL,
+		PackageRegistryProxyPnpmURL:  src.PackageRegistryProxyPnpmURL,
+	}
+}
+
+func clusterConfigDataFromHub(src *konfluxv1alpha1.ClusterConfigData) *ClusterConfigData {
+	if src == nil {
+		return nil
+	}
+	return &ClusterConfigData{
+		DefaultOIDCIssuer:            src.DefaultOIDCIssuer,
+		EnableKeylessSigning:         src.EnableKeylessSigning,
+		FulcioInternalUrl:            src.FulcioInternalUrl,
+		FulcioExternalUrl:            src.FulcioExternalUrl,
+		RekorInternalUrl:             src.RekorInternalUrl,
+		RekorExternalUrl:             src.RekorExternalUrl,
+		TufInternalUrl:               src.TufInternalUrl,
+		TufExternalUrl:               src.TufExternalUrl,
+		TrustifyServerInternalUrl:    src.TrustifyServerInternalUrl,
+		TrustifyServerExternalUrl:    src.TrustifyServerExternalUrl,
+		BuildIdentityRegexp:          src.BuildIdentityRegexp,
+		TrustifyOIDCIssuerUrl:        src.TrustifyOIDCIssuerUrl,
+		TektonChainsIdentity:         src.TektonChainsIdentity,
+		AllowCacheProxy:              src.AllowCacheProxy,
+		HTTPProxy:                    src.HTTPProxy,
+		NoProxy:                      src.NoProxy,
+		AllowPackageRegistryProxy:    src.AllowPackageRegistryProxy,
+		PackageRegistryProxyNpmURL:   src.PackageRegistryProxyNpmURL,
+		PackageRegistryProxyYarnURL:  src.PackageRegistryProxyYarnURL,
+		PackageRegistryProxyGomodURL: src.PackageRegistryProxyGomodURL,
+		PackageRegistryProxyPipURL:   src.PackageRegistryProxyPipURL,
+		PackageRegistryProxyPnpmURL:  src.PackageRegistryProxyPnpmURL,
+	}
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// KonfluxInfoSpec defines the desired state of KonfluxInfo.
+type KonfluxInfoSpec struct {
+	// PublicInfo defines the configuration for the info.json ConfigMap.
+	// If not specified, default development values will be used.
+	// +optional
+	PublicInfo *PublicInfo `json:"publicInfo,omitempty"`
+
+	// Banner defines the configuration for the banner-content.yaml ConfigMap.
+	// If not specified, an empty banner array will be used.
+	// +optional
+	Banner *Banner `json:"banner,omitempty"`
+
+	// ClusterConfig defines cluster-wide key-value configuration.
+	// The key-value pairs will be stored in a ConfigMap named "cluster-config"
+	// in the "konflux-info" namespace, readable by all authenticated users.
+	// User-provided values take precedence over auto-detected values.
+	// +optional
+	ClusterConfig *ClusterConfig `json:"clusterConfig,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
+}
+
+// Banner contains banner configuration
+type Banner struct {
+	// Items is the list of banners to display
+	// +optional
+	Items *[]BannerItem `json:"items,omitempty"`
+}
+
+// PublicInfo contains configurable parameters for info.json
+type PublicInfo struct {
+	// Environment is the environment type (development, production, staging)
+	// +kubebuilder:validation:Enum=development;production;staging
+	// +kubebuilder:default=development
+	Environment string `json:"environment"`
+
+	// Visibility is the visibility level (public, private)
+	// +kubebuilder:validation:Enum=public;private
+	// +kubebuilder:default=public
+	Visibility string `json:"visibility"`
+
+	// Integrations contains integration configuration
+	// +optional
+	Integrations *IntegrationsConfig `json:"integrations,omitempty"`
+
+	// StatusPageUrl is the URL to the status page
+	// +optional
+	StatusPageUrl string `json:"statusPageUrl,omitempty"`
+
+	// RBAC contains RBAC role definitions
+	// +optional
+	RBAC []RBACRole `json:"rbac,omitempty"`
+}
+
+// IntegrationsConfig contains integration configuration
+type IntegrationsConfig struct {
+	// GitHub contains GitHub integration configuration
+	// +optional
+	GitHub *GitHubIntegration `json:"github,omitempty"`
+
+	// SBOMServer contains SBOM server configuration
+	// +optional
+	SBOMServer *SBOMServerConfig `json:"sbom_server,omitempty"`
+
+	// ImageController contains image controller configuration
+	// +optional
+	ImageController *InfoImageControllerConfig `json:"image_controller,omitempty"`
+}
+
+// GitHubIntegration contains GitHub integration configuration
+type GitHubIntegration struct {
+	// ApplicationURL is the GitHub App installation URL
+	ApplicationURL string `json:"application_url"`
+}
+
+// SBOMServerConfig contains SBOM server configuration
+type SBOMServerConfig struct {
+	// URL is the SBOM content URL
+	URL string `json:"url"`
+
+	// SBOMSha is the SBOM SHA URL
+	SBOMSha string `json:"sbom_sha"`
+}
+
+// InfoImageControllerConfig contains image controller configuration for info.json
+type InfoImageControllerConfig struct {
+	// Enabled indicates if image controller is enabled
+	Enabled bool `json:"enabled"`
+
+	// Notifications contains notification configurations
+	// +optional
+	Notifications []InfoNotificationConfig `json:"notifications,omitempty"`
+}
+
+// InfoNotificationConfig contains notification configuration for info.json
+type InfoNotificationConfig struct {
+	// Title is the notification title
+	Title string `json:"title"`
+
+	// Event is the event type (e.g., "repo_push", "build_complete")
+	Event string `json:"event"`
+
+	// Method is the notification method (e.g., "webhook", "email")
+	Method string `json:"method"`
+
+	// Config contains method-specific configuration (as JSON).
+	// For webhook method, use: {"url": "https://webhook.example.com/endpoint"}
+	// For email method, use: {"email": "notifications@example.com"}
+	// Example webhook config:
+	//   config:
+	//     url: "https://webhook.example.com/build"
+	// Example email config:
+	//   config:
+	//     email: "team@example.com"
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Config apiextensionsv1.JSON `json:"config"`
+}
+
+// RBACRole contains RBAC role definition
+type RBACRole struct {
+	// Name is the ClusterRole name (e.g., "konflux-admin-user-actions")
+	Name string `json:"name"`
+
+	// Description is the role description
+	Description string `json:"description"`
+
+	// DisplayName is the human-readable name displayed in the UI.
+	// If not specified, defaults to the Name field.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// BannerItem contains individual banner configuration
+type BannerItem struct {
+	// Summary is the banner text (5-500 chars, supports Markdown)
+	// +kubebuilder:validation:MinLength=5
+	// +kubebuilder:validation:MaxLength=500
+	Summary string `json:"summary"`
+
+	// Type is the banner type (info, warning, danger)
+	// +kubebuilder:validation:Enum=info;warning;danger
+	Type string `json:"type"`
+
+	// StartTime is the start time in HH:mm format (required if date fields are set)
+	// +optional
+	StartTime string `json:"startTime,omitempty"`
+
+	// EndTime is the end time in HH:mm format (required if date fields are set)
+	// +optional
+	EndTime string `json:"endTime,omitempty"`
+
+	// TimeZone is the IANA timezone (optional, defaults to UTC)
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Year is the year for one-time banners
+	// +optional
+	Year *int `json:"year,omitempty"`
+
+	// Month is the month (1-12)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=12
+	// +optional
+	Month *int `json:"month,omitempty"`
+
+	// DayOfWeek is the day of week (0-6, 0=Sunday)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=6
+	// +optional
+	DayOfWeek *int `json:"dayOfWeek,omitempty"`
+
+	// DayOfMonth is the day of month (1-31)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31
+	// +optional
+	DayOfMonth *int `json:"dayOfMonth,omitempty"`
+}
+
+// ClusterConfig contains cluster-wide key-value configuration.
+type ClusterConfig struct {
+	// Data contains structured cluster-wide configuration values.
+	// These values will be stored in the "cluster-config" ConfigMap in the "konflux-info" namespace.
+	// The ConfigMap keys are stable and part of the public API consumed by PipelineRuns.
+	// WARNING: Changing field names or JSON tags is a BREAKING CHANGE that will affect
+	// all PipelineRuns reading from the ConfigMap. Field names must remain stable.
+	// +optional
+	Data *ClusterConfigData `json:"data,omitempty"`
+}
+
+// ClusterConfigData contains the structured fields for cluster configuration.
+// The field names (and their JSON tags) directly map to ConfigMap keys that are
+// read by PipelineRuns. These keys are part of the stable API and must not change
+// without a major version release.
+type ClusterConfigData struct {
+	// DefaultOIDCIssuer is the default OIDC issuer URL.
+	// +optional
+	DefaultOIDCIssuer string `json:"defaultOIDCIssuer,omitempty"`
+
+	// EnableKeylessSigning determines if pipelines should perform/validate keyless signing.
+	// When nil, the key is omitted from the ConfigMap (unset).
+	// +optional
+	EnableKeylessSigning *bool `json:"enableKeylessSigning,omitempty"`
+
+	// FulcioInternalUrl is the internal Fulcio URL.
+	// +optional
+	FulcioInternalUrl string `json:"fulcioInternalUrl,omitempty"`
+
+	// FulcioExternalUrl is the external Fulcio URL.
+	// +optional
+	FulcioExternalUrl string `json:"fulcioExternalUrl,omitempty"`
+
+	// RekorInternalUrl is the internal Rekor URL.
+	// +optional
+	RekorInternalUrl string `json:"rekorInternalUrl,omitempty"`
+
+	// RekorExternalUrl is the external Rekor URL.
+	// +optional
+	RekorExternalUrl string `json:"rekorExternalUrl,omitempty"`
+
+	// TufInternalUrl is the internal TUF URL.
+	// +optional
+	TufInternalUrl string `json:"tufInternalUrl,omitempty"`
+
+	// TufExternalUrl is the external TUF URL.
+	// +optional
+	TufExternalUrl string `json:"tufExternalUrl,omitempty"`
+
+	// TrustifyServerInternalUrl is the internal URL for the Trustify server.
+	// +optional
+	TrustifyServerInternalUrl string `json:"trustifyServerInternalUrl,omitempty"`
+
+	// TrustifyServerExternalUrl is the external URL for the Trustify server.
+	// +optional
+	TrustifyServerExternalUrl string `json:"trustifyServerExternalUrl,omitempty"`
+
+	// BuildIdentityRegexp is a regex pattern used for matching the identity
+	// that signed artifacts as part of the build pipeline.
+	// +optional
+	BuildIdentityRegexp string `json:"buildIdentityRegexp,omitempty"`
+
+	// TrustifyOIDCIssuerUrl is the URL of the OIDC issuer
+	// used by Trustification clients.
+	// +optional
+	TrustifyOIDCIssuerUrl string `json:"trustifyOIDCIssuerUrl,omitempty"`
+
+	// TektonChainsIdentity is the identity of Tekton Chains used when verifying
+	// the signature of attestations produced by Tekton Chains.
+	// +optional
+	TektonChainsIdentity string `json:"tektonChainsIdentity,omitempty"`
+
+	// AllowCacheProxy enables the HTTP caching proxy for builds.
+	// When true, the "allow-cache-proxy" key is set to "true" in the cluster-config ConfigMap.
+	// When false, the key is set to "false". When nil (omitted), the key is absent.
+	// +optional
+	AllowCacheProxy *bool `json:"allowCacheProxy,omitempty"`
+
+	// HTTPProxy is the HTTP proxy URL for builds (e.g., "squid.caching.svc.cluster.local:3128").
+	// Written as "http-proxy" in the cluster-config ConfigMap.
+	// Do not embed credentials in this URL — the ConfigMap is readable by all authenticated users.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!self.contains('@')",message="URL must not contain credentials (userinfo). Use a Secret for proxy authentication."
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of hosts that should bypass the proxy.
+	// Written as "no-proxy" in the cluster-config ConfigMap.
+	// An empty string is a valid value meaning no hosts are excluded from proxying.
+	// When nil (omitted), the key is absent from the ConfigMap.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+
+	// AllowPackageRegistryProxy enables the package registry proxy for builds.
+	// When true, the "allow-package-registry-proxy" key is set to "true" in the cluster-config ConfigMap.
+	// When false, the key is set to "false". When nil (omitted), the key is absent.
+	// +optional
+	AllowPackageRegistryProxy *bool `json:"allowPackageRegistryProxy,omitempty"`
+
+	// PackageRegistryProxyNpmURL is the URL of the npm package registry proxy.
+	// Written as "package-registry-proxy-npm-url" in the cluster-config ConfigMap.
+	// Do not embed credentials in this URL — the ConfigMap is readable by all authenticated users.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!self.contains('@')",message="URL must not contain credentials (userinfo). Use a Secret for proxy authentication."
+	PackageRegistryProxyNpmURL string `json:"packageRegistryProxyNpmUrl,omitempty"`
+
+	// PackageRegistryProxyYarnURL is the URL of the yarn package registry proxy.
+	// Written as "package-registry-proxy-yarn-url" in the cluster-config ConfigMap.
+	// Do not embed credentials in this URL — the ConfigMap is readable by all authenticated users.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!self.contains('@')",message="URL must not contain credentials (userinfo). Use a Secret for proxy authentication."
+	PackageRegistryProxyYarnURL string `json:"packageRegistryProxyYarnUrl,omitempty"`
+
+	// PackageRegistryProxyGomodURL is the URL of the Go module proxy.
+	// Written as "package-registry-proxy-gomod-url" in the cluster-config ConfigMap.
+	// Do not embed credentials in this URL — the ConfigMap is readable by all authenticated users.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!self.contains('@')",message="URL must not contain credentials (userinfo). Use a Secret for proxy authentication."
+	PackageRegistryProxyGomodURL string `json:"packageRegistryProxyGomodUrl,omitempty"`
+
+	// PackageRegistryProxyPipURL is the URL of the pip (Python) package registry proxy.
+	// Written as "package-registry-proxy-pip-url" in the cluster-config ConfigMap.
+	// Do not embed credentials in this URL — the ConfigMap is readable by all authenticated users.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!self.contains('@')",message="URL must not contain credentials (userinfo). Use a Secret for proxy authentication."
+	PackageRegistryProxyPipURL string `json:"packageRegistryProxyPipUrl,omitempty"`
+
+	// PackageRegistryProxyPnpmURL is the URL of the pnpm package registry proxy.
+	// Written as "package-registry-proxy-pnpm-url" in the cluster-config ConfigMap.
+	// Do not embed credentials in this URL — the ConfigMap is readable by all authenticated users.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!self.contains('@')",message="URL must not contain credentials (userinfo). Use a Secret for proxy authentication."
+	PackageRegistryProxyPnpmURL string `json:"packageRegistryProxyPnpmUrl,omitempty"`
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// ConvertTo converts this KonfluxIntegrationService to the Hub version (v1alpha1).
+func (src *KonfluxIntegrationService) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*konfluxv1alpha1.KonfluxIntegrationService)
+	dst.ObjectMeta = src.ObjectMeta
+	convertIntegrationServiceConfigSpecToHub(&src.Spec.KonfluxIntegrationServiceConfigSpec, &dst.Spec.KonfluxIntegrationServiceConfigSpec)
+	dst.Spec.ComponentMetrics = componentMetricsToHub(src.Spec.ComponentMetrics)
+	dst.Spec.ImageRegistryMirrors = convertSlice(src.Spec.ImageRegistryMirrors, imageRegistryMirrorToHub)
+	dst.Spec.ImagePull = imagePullConfigToHub(src.Spec.ImagePull)
+	dst.Spec.EgressProxy = egressProxySpecToHub(src.Spec.EgressProxy)
+	dst.Status = *konfluxIntegrationServiceStatusToHub(&src.Status)
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version.
+func (dst *KonfluxIntegrationService) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*konfluxv1alpha1.KonfluxIntegrationService)
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertIntegrationServiceConfigSpecFromHub(&src.Spec.KonfluxIntegrationServiceConfigSpec, &dst.Spec.KonfluxIntegrationServiceConfigSpec); err != nil {
+		return err
+	}
+	dst.Spec.ComponentMetrics = componentMetricsFromHub(src.Spec.ComponentMetrics)
+	dst.Spec.ImageRegistryMirrors = convertSlice(src.Spec.ImageRegistryMirrors, imageRegistryMirrorFromHub)
+	dst.Spec.ImagePull = imagePullConfigFromHub(src.Spec.ImagePull)
+	dst.Spec.EgressProxy = egressProxySpecFromHub(src.Spec.EgressProxy)
+	dst.Status = *konfluxIntegrationServiceStatusFromHub(&src.Status)
+	return nil
+}
+
+func convertIntegrationServiceConfigSpecToHub(src *KonfluxIntegrationServiceConfigSpec, dst *konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec) {
+	dst.IntegrationControllerManager = controllerManagerDeploymentSpecToHub(src.IntegrationControllerManager)
+	dst.PipelineTimeout = durationToHub(src.PipelineTimeout)
+	dst.TasksTimeout = durationToHub(src.TasksTimeout)
+	dst.FinallyTimeout = durationToHub(src.FinallyTimeout)
+	dst.SnapshotGarbageCollector = containerSpecToHub(src.SnapshotGarbageCollector)
+	dst.PRSnapshotsToKeep = countToHub(src.PRSnapshotsToKeep)
+	dst.NonPRSnapshotsToKeep = countToHub(src.NonPRSnapshotsToKeep)
+	dst.MinSnapshotsToKeepPerComponent = countToHub(src.MinSnapshotsToKeepPerComponent)
+	dst.PodPlacement = podPlacementSpecToHub(src.PodPlacement)
+	dst.Patches = convertSlice(src.Patches, objectPatchToHub)
+	dst.Logging = loggingSpecToHub(src.Logging)
+}
+
+func convertIntegrationServiceConfigSpecFromHub(src *konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec, dst *KonfluxIntegrationServiceConfigSpec) error {
+	var errs []error
+	var err error
+	dst.IntegrationControllerManager = controllerManagerDeploymentSpecFromHub(src.IntegrationControllerManager)
+	dst.PipelineTimeout, err = durationFromHub("pipelineTimeout", src.PipelineTimeout)
+	errs = append(errs, err)
+	dst.TasksTimeout, err = durationFromHub("tasksTimeout", src.TasksTimeout)
+	errs = append(errs, err)
+	dst.FinallyTimeout, err = durationFromHub("finallyTimeout", src.FinallyTimeout)
+	errs = append(errs, err)
+	dst.SnapshotGarbageCollector = containerSpecFromHub(src.SnapshotGarbageCollector)
+	dst.PRSnapshotsToKeep, err = countFromHub("prSnapshotsToKeep", src.PRSnapshotsToKeep)
+	errs = append(errs, err)
+	dst.NonPRSnapshotsToKeep, err = countFromHub("nonPRSnapshotsToKeep", src.NonPRSnapshotsToKeep)
+	errs = append(errs, err)
+	dst.MinSnapshotsToKeepPerComponent, err = countFromHub("minSnapshotsToKeepPerComponent", src.MinSnapshotsToKeepPerComponent)
+	errs = append(errs, err)
+	dst.PodPlacement = podPlacementSpecFromHub(src.PodPlacement)
+	dst.Patches = convertSlice(src.Patches, objectPatchFromHub)
+	dst.Logging = loggingSpecFromHub(src.Logging)
+	return errors.Join(errs...)
+}
+
+func componentMetricsToHub(src *ComponentMetricsConfig) *konfluxv1alpha1.ComponentMetricsConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ComponentMetricsConfig{Enabled: src.Enabled}
+}
+
+func componentMetricsFromHub(src *konfluxv1alpha1.ComponentMetricsConfig) *ComponentMetricsConfig {
+	if src == nil {
+		return nil
+	}
+	return &ComponentMetricsConfig{Enabled: src.Enabled}
+}
+
+func konfluxIntegrationServiceStatusToHub(src *KonfluxIntegrationServiceStatus) *konfluxv1alpha1.KonfluxIntegrationServiceStatus {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxIntegrationServiceStatus{
+		Conditions:         src.Conditions,
+		DriftedResources:   convertSlice(src.DriftedResources, driftedResourceToHub),
+		OperatorVersion:    src.OperatorVersion,
+		ManifestProvenance: manifestProvenanceToHub(src.ManifestProvenance),
+	}
+}
+
+func konfluxIntegrationServiceStatusFromHub(src *konfluxv1alpha1.KonfluxIntegrationServiceStatus) *KonfluxIntegrationServiceStatus {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxIntegrationServiceStatus{
+		Conditions:         src.Conditions,
+		DriftedResources:   convertSlice(src.DriftedResources, driftedResourceFromHub),
+		OperatorVersion:    src.OperatorVersion,
+		ManifestProvenance: manifestProvenanceFromHub(src.ManifestProvenance),
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KonfluxIntegrationServiceConfigSpec defines user-configurable integration-service settings on the Konflux CR.
+type KonfluxIntegrationServiceConfigSpec struct {
+	// IntegrationControllerManager defines customizations for the controller-manager deployment.
+	// +optional
+	IntegrationControllerManager *ControllerManagerDeploymentSpec `json:"integrationControllerManager,omitempty"`
+
+	// PipelineTimeout is the overall pipeline run timeout (e.g. "6h", "1h30m", "90m").
+	// Maps to the PIPELINE_TIMEOUT env var on the controller-manager container.
+	// When omitted, the upstream integration-service default applies.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="pipelineTimeout must be greater than zero"
+	PipelineTimeout *metav1.Duration `json:"pipelineTimeout,omitempty"`
+
+	// TasksTimeout is the timeout for tasks within a pipeline run (e.g. "4h", "90m").
+	// Maps to the TASKS_TIMEOUT env var on the controller-manager container.
+	// When omitted, the upstream integration-service default applies.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="tasksTimeout must be greater than zero"
+	TasksTimeout *metav1.Duration `json:"tasksTimeout,omitempty"`
+
+	// FinallyTimeout is the timeout for finally tasks (e.g. "2h", "30m").
+	// Maps to the FINALLY_TIMEOUT env var on the controller-manager container.
+	// When omitted, the upstream integration-service default applies.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="finallyTimeout must be greater than zero"
+	FinallyTimeout *metav1.Duration `json:"finallyTimeout,omitempty"`
+
+	// SnapshotGarbageCollector defines customizations for the snapshot GC CronJob container
+	// (resources, env vars).
+	// +optional
+	SnapshotGarbageCollector *ContainerSpec `json:"snapshotGarbageCollector,omitempty"`
+
+	// PRSnapshotsToKeep is the number of snapshots to retain per component for PR-triggered
+	// pipeline runs. Maps to the PR_SNAPSHOTS_TO_KEEP env var on the GC container.
+	// When omitted, the upstream integration-service default applies.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PRSnapshotsToKeep *int32 `json:"prSnapshotsToKeep,omitempty"`
+
+	// NonPRSnapshotsToKeep is the number of snapshots to retain per component for non-PR
+	// pipeline runs. Maps to the NON_PR_SNAPSHOTS_TO_KEEP env var on the GC container.
+	// When omitted, the upstream integration-service default applies.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	NonPRSnapshotsToKeep *int32 `json:"nonPRSnapshotsToKeep,omitempty"`
+
+	// MinSnapshotsToKeepPerComponent is the minimum number of snapshots to retain per component,
+	// regardless of PR/non-PR classification. Maps to the MIN_SNAPSHOTS_TO_KEEP_PER_COMPONENT
+	// env var on the GC container.
+	// When omitted, the upstream integration-service default applies.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinSnapshotsToKeepPerComponent *int32 `json:"minSnapshotsToKeepPerComponent,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+}
+
+// KonfluxIntegrationServiceSpec defines the desired state of KonfluxIntegrationService.
+type KonfluxIntegrationServiceSpec struct {
+	KonfluxIntegrationServiceConfigSpec `json:",inline"`
+
+	// ComponentMetrics controls Prometheus scrape resources for this component.
+	// Set by the Konflux reconciler from spec.componentMetrics on the Konflux CR.
+	// +optional
+	ComponentMetrics *ComponentMetricsConfig `json:"componentMetrics,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:unservedversion
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'konflux-integration-service'",message="KonfluxIntegrationService CR must be named 'konflux-integration-service'. Only one instance is allowed per cluster."
+
+// KonfluxIntegrationService is the Schema for the konfluxintegrationservices API
+type KonfluxIntegrationService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:default:={}
+	Spec   KonfluxIntegrationServiceSpec   `json:"spec"`
+	Status KonfluxIntegrationServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KonfluxIntegrationServiceList contains a list of KonfluxIntegrationService
+type KonfluxIntegrationServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KonfluxIntegrationService `json:"items"`
+}
+
+// KonfluxIntegrationServiceStatus defines the observed state of KonfluxIntegrationService
+type KonfluxIntegrationServiceStatus struct {
+	// Conditions represent the latest available observations of the KonfluxIntegrationService state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
+}
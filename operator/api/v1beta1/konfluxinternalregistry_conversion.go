@@ -0,0 +1,47 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func konfluxInternalRegistrySpecToHub(src *KonfluxInternalRegistrySpec) *konfluxv1alpha1.KonfluxInternalRegistrySpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxInternalRegistrySpec{
+		PodPlacement:         podPlacementSpecToHub(src.PodPlacement),
+		Patches:              convertSlice(src.Patches, objectPatchToHub),
+		ImageRegistryMirrors: convertSlice(src.ImageRegistryMirrors, imageRegistryMirrorToHub),
+		ImagePull:            imagePullConfigToHub(src.ImagePull),
+		EgressProxy:          egressProxySpecToHub(src.EgressProxy),
+	}
+}
+
+func konfluxInternalRegistrySpecFromHub(src *konfluxv1alpha1.KonfluxInternalRegistrySpec) *KonfluxInternalRegistrySpec {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxInternalRegistrySpec{
+		PodPlacement:         podPlacementSpecFromHub(src.PodPlacement),
+		Patches:              convertSlice(src.Patches, objectPatchFromHub),
+		ImageRegistryMirrors: convertSlice(src.ImageRegistryMirrors, imageRegistryMirrorFromHub),
+		ImagePull:            imagePullConfigFromHub(src.ImagePull),
+		EgressProxy:          egressProxySpecFromHub(src.EgressProxy),
+	}
+}
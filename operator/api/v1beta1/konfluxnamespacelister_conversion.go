@@ -0,0 +1,117 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// ConvertTo converts this KonfluxNamespaceLister to the Hub version (v1alpha1).
+func (src *KonfluxNamespaceLister) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*konfluxv1alpha1.KonfluxNamespaceLister)
+	dst.ObjectMeta = src.ObjectMeta
+	convertNamespaceListerSpecToHub(&src.Spec, &dst.Spec)
+	dst.Status = *konfluxNamespaceListerStatusToHub(&src.Status)
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version.
+func (dst *KonfluxNamespaceLister) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*konfluxv1alpha1.KonfluxNamespaceLister)
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertNamespaceListerSpecFromHub(&src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+	dst.Status = *konfluxNamespaceListerStatusFromHub(&src.Status)
+	return nil
+}
+
+func convertNamespaceListerSpecToHub(src *KonfluxNamespaceListerSpec, dst *konfluxv1alpha1.KonfluxNamespaceListerSpec) {
+	dst.NamespaceLister = namespaceListerDeploymentSpecToHub(src.NamespaceLister)
+	dst.CacheResyncPeriod = durationToHub(src.CacheResyncPeriod)
+	dst.LogLevel = konfluxv1alpha1.LogLevel(src.LogLevel)
+	dst.PodPlacement = podPlacementSpecToHub(src.PodPlacement)
+	dst.Patches = convertSlice(src.Patches, objectPatchToHub)
+	dst.Logging = loggingSpecToHub(src.Logging)
+	dst.ImageRegistryMirrors = convertSlice(src.ImageRegistryMirrors, imageRegistryMirrorToHub)
+	dst.ImagePull = imagePullConfigToHub(src.ImagePull)
+	dst.EgressProxy = egressProxySpecToHub(src.EgressProxy)
+}
+
+func convertNamespaceListerSpecFromHub(src *konfluxv1alpha1.KonfluxNamespaceListerSpec, dst *KonfluxNamespaceListerSpec) error {
+	var err error
+	dst.NamespaceLister = namespaceListerDeploymentSpecFromHub(src.NamespaceLister)
+	dst.CacheResyncPeriod, err = durationFromHub("cacheResyncPeriod", src.CacheResyncPeriod)
+	dst.LogLevel = LogLevel(src.LogLevel)
+	dst.PodPlacement = podPlacementSpecFromHub(src.PodPlacement)
+	dst.Patches = convertSlice(src.Patches, objectPatchFromHub)
+	dst.Logging = loggingSpecFromHub(src.Logging)
+	dst.ImageRegistryMirrors = convertSlice(src.ImageRegistryMirrors, imageRegistryMirrorFromHub)
+	dst.ImagePull = imagePullConfigFromHub(src.ImagePull)
+	dst.EgressProxy = egressProxySpecFromHub(src.EgressProxy)
+	return err
+}
+
+func namespaceListerDeploymentSpecToHub(src *NamespaceListerDeploymentSpec) *konfluxv1alpha1.NamespaceListerDeploymentSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.NamespaceListerDeploymentSpec{
+		Replicas:            src.Replicas,
+		NamespaceLister:     containerSpecToHub(src.NamespaceLister),
+		PodDisruptionBudget: podDisruptionBudgetSpecToHub(src.PodDisruptionBudget),
+		Autoscaling:         autoscalingSpecToHub(src.Autoscaling),
+	}
+}
+
+func namespaceListerDeploymentSpecFromHub(src *konfluxv1alpha1.NamespaceListerDeploymentSpec) *NamespaceListerDeploymentSpec {
+	if src == nil {
+		return nil
+	}
+	return &NamespaceListerDeploymentSpec{
+		Replicas:            src.Replicas,
+		NamespaceLister:     containerSpecFromHub(src.NamespaceLister),
+		PodDisruptionBudget: podDisruptionBudgetSpecFromHub(src.PodDisruptionBudget),
+		Autoscaling:         autoscalingSpecFromHub(src.Autoscaling),
+	}
+}
+
+func konfluxNamespaceListerStatusToHub(src *KonfluxNamespaceListerStatus) *konfluxv1alpha1.KonfluxNamespaceListerStatus {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxNamespaceListerStatus{
+		Conditions:         src.Conditions,
+		DriftedResources:   convertSlice(src.DriftedResources, driftedResourceToHub),
+		OperatorVersion:    src.OperatorVersion,
+		ManifestProvenance: manifestProvenanceToHub(src.ManifestProvenance),
+	}
+}
+
+func konfluxNamespaceListerStatusFromHub(src *konfluxv1alpha1.KonfluxNamespaceListerStatus) *KonfluxNamespaceListerStatus {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxNamespaceListerStatus{
+		Conditions:         src.Conditions,
+		DriftedResources:   convertSlice(src.DriftedResources, driftedResourceFromHub),
+		OperatorVersion:    src.OperatorVersion,
+		ManifestProvenance: manifestProvenanceFromHub(src.ManifestProvenance),
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KonfluxNamespaceListerSpec defines the desired state of KonfluxNamespaceLister.
+type KonfluxNamespaceListerSpec struct {
+	// NamespaceLister defines customizations for the namespace-lister deployment.
+	// +optional
+	NamespaceLister *NamespaceListerDeploymentSpec `json:"namespaceLister,omitempty"`
+
+	// CacheResyncPeriod controls how often the namespace-lister's access cache
+	// fully re-evaluates RBAC permissions as a safety net (e.g. "5s", "10m", "1h").
+	// When omitted, the namespace-lister relies solely on watch events
+	// with no periodic resync.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(s|m|h))+$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="cacheResyncPeriod must be greater than zero"
+	CacheResyncPeriod *metav1.Duration `json:"cacheResyncPeriod,omitempty"`
+
+	// LogLevel sets the minimum log severity for the namespace-lister.
+	// Accepted values: debug, info, warn, error.
+	// When omitted, the namespace-lister defaults to error level.
+	// Deprecated: use logging.level, which takes precedence.
+	// +optional
+	LogLevel LogLevel `json:"logLevel,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:unservedversion
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'konflux-namespace-lister'",message="KonfluxNamespaceLister CR must be named 'konflux-namespace-lister'. Only one instance is allowed per cluster."
+
+// KonfluxNamespaceLister is the Schema for the konfluxnamespacelisters API.
+type KonfluxNamespaceLister struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:default:={}
+	Spec   KonfluxNamespaceListerSpec   `json:"spec"`
+	Status KonfluxNamespaceListerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KonfluxNamespaceListerList contains a list of KonfluxNamespaceLister.
+type KonfluxNamespaceListerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KonfluxNamespaceLister `json:"items"`
+}
+
+// NamespaceListerDeploymentSpec defines customizations for the namespace-lister deployment.
+type NamespaceListerDeploymentSpec struct {
+	// Replicas is the number of replicas for the namespace-lister deployment.
+	// When omitted, the replicas of spec.profile are used, or 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas,omitempty"`
+	// NamespaceLister defines customizations for the namespace-lister container.
+	// +optional
+	NamespaceLister *ContainerSpec `json:"namespaceLister,omitempty"`
+	// PodDisruptionBudget creates a PodDisruptionBudget for the namespace-lister pods.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// Autoscaling creates a HorizontalPodAutoscaler for the namespace-lister deployment.
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+}
+
+// KonfluxNamespaceListerStatus defines the observed state of KonfluxNamespaceLister.
+type KonfluxNamespaceListerStatus struct {
+	// Conditions represent the latest available observations of the KonfluxNamespaceLister state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftedResources lists managed resources that were changed outside the operator during
+	// the last hour and restored on reconcile, most recent first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// OperatorVersion is the version of the operator that last applied the component's manifests.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// ManifestProvenance describes what the manifests the operator last applied were built from.
+	// +optional
+	ManifestProvenance *ManifestProvenance `json:"manifestProvenance,omitempty"`
+}
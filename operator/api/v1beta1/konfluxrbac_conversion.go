@@ -0,0 +1,72 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// ConvertTo converts this KonfluxRBAC to the Hub version (v1alpha1).
+func (src *KonfluxRBAC) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*konfluxv1alpha1.KonfluxRBAC)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec.Patches = convertSlice(src.Spec.Patches, objectPatchToHub)
+	dst.Spec.ImageRegistryMirrors = convertSlice(src.Spec.ImageRegistryMirrors, imageRegistryMirrorToHub)
+	dst.Spec.ImagePull = imagePullConfigToHub(src.Spec.ImagePull)
+	dst.Spec.EgressProxy = egressProxySpecToHub(src.Spec.EgressProxy)
+	dst.Status = *konfluxRBACStatusToHub(&src.Status)
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version.
+// The v1alpha1 spec.foo scaffolding field is not read by the operator and is dropped.
+func (dst *KonfluxRBAC) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*konfluxv1alpha1.KonfluxRBAC)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec.Patches = convertSlice(src.Spec.Patches, objectPatchFromHub)
+	dst.Spec.ImageRegistryMirrors = convertSlice(src.Spec.ImageRegistryMirrors, imageRegistryMirrorFromHub)
+	dst.Spec.ImagePull = imagePullConfigFromHub(src.Spec.ImagePull)
+	dst.Spec.EgressProxy = egressProxySpecFromHub(src.Spec.EgressProxy)
+	dst.Status = *konfluxRBACStatusFromHub(&src.Status)
+	return nil
+}
+
+func konfluxRBACStatusToHub(src *KonfluxRBACStatus) *konfluxv1alpha1.KonfluxRBACStatus {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxRBACStatus{
+		Conditions:         src.Conditions,
+		DriftedResources:   convertSlice(src.DriftedResources, driftedResourceToHub),
+		OperatorVersion:    src.OperatorVersion,
+		ManifestProvenance: manifestProvenanceToHub(src.ManifestProvenance),
+	}
+}
+
+func konfluxRBACStatusFromHub(src *konfluxv1alpha1.KonfluxRBACStatus) *KonfluxRBACStatus {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxRBACStatus{
+		Conditions:         src.Conditions,
+		DriftedResources:   convertSlice(src.DriftedResources, driftedResourceFromHub),
+		OperatorVersion:    src.OperatorVersion,
+		ManifestProvenance: manifestProvenanceFromHub(src.ManifestProvenance),
+	}
+}
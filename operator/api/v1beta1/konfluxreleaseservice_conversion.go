@@ -0,0 +1,71 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func konfluxReleaseServiceConfigSpecToHub(src *KonfluxReleaseServiceConfigSpec) *konfluxv1alpha1.KonfluxReleaseServiceConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxReleaseServiceConfigSpec{
+		Debug:                    src.Debug,
+		EmptyDirOverrides:        convertSlice(src.EmptyDirOverrides, emptyDirOverrideToHub),
+		ReleaseControllerManager: controllerManagerDeploymentSpecToHub(src.ReleaseControllerManager),
+		PodPlacement:             podPlacementSpecToHub(src.PodPlacement),
+		Patches:                  convertSlice(src.Patches, objectPatchToHub),
+		Logging:                  loggingSpecToHub(src.Logging),
+	}
+}
+
+func konfluxReleaseServiceConfigSpecFromHub(src *konfluxv1alpha1.KonfluxReleaseServiceConfigSpec) *KonfluxReleaseServiceConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxReleaseServiceConfigSpec{
+		Debug:                    src.Debug,
+		EmptyDirOverrides:        convertSlice(src.EmptyDirOverrides, emptyDirOverrideFromHub),
+		ReleaseControllerManager: controllerManagerDeploymentSpecFromHub(src.ReleaseControllerManager),
+		PodPlacement:             podPlacementSpecFromHub(src.PodPlacement),
+		Patches:                  convertSlice(src.Patches, objectPatchFromHub),
+		Logging:                  loggingSpecFromHub(src.Logging),
+	}
+}
+
+func emptyDirOverrideToHub(src *EmptyDirOverride) *konfluxv1alpha1.EmptyDirOverride {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.EmptyDirOverride{
+		URL:        src.URL,
+		Revision:   src.Revision,
+		PathInRepo: src.PathInRepo,
+	}
+}
+
+func emptyDirOverrideFromHub(src *konfluxv1alpha1.EmptyDirOverride) *EmptyDirOverride {
+	if src == nil {
+		return nil
+	}
+	return &EmptyDirOverride{
+		URL:        src.URL,
+		Revision:   src.Revision,
+		PathInRepo: src.PathInRepo,
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// KonfluxReleaseServiceConfigSpec defines user-configurable release-service settings on the Konflux CR.
+type KonfluxReleaseServiceConfigSpec struct {
+	// Debug enables debug mode in the ReleaseServiceConfig.
+	// When true, the release-service operates in debug mode.
+	// +optional
+	Debug bool `json:"debug,omitempty"`
+
+	// EmptyDirOverrides defines pipeline patterns that should use emptyDir volumes
+	// instead of PVCs. Applied to the ReleaseServiceConfig CR managed by the operator.
+	// +optional
+	EmptyDirOverrides []EmptyDirOverride `json:"emptyDirOverrides,omitempty"`
+
+	// ReleaseControllerManager defines customizations for the controller-manager deployment.
+	// +optional
+	ReleaseControllerManager *ControllerManagerDeploymentSpec `json:"releaseControllerManager,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+}
+
+// EmptyDirOverride defines a pipeline pattern that should use emptyDir volumes.
+type EmptyDirOverride struct {
+	// URL is a regex pattern matching the pipeline repository URL.
+	URL string `json:"url"`
+	// Revision is a regex pattern matching the pipeline revision.
+	Revision string `json:"revision"`
+	// PathInRepo is the path to the pipeline file within the repository.
+	PathInRepo string `json:"pathInRepo"`
+}
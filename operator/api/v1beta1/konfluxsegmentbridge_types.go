@@ -0,0 +1,94 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// KonfluxSegmentBridgeSpec defines the desired state of KonfluxSegmentBridge.
+type KonfluxSegmentBridgeSpec struct {
+	// SegmentKey is the write key used to authenticate with the Segment API.
+	// When not specified, no telemetry data is sent unless SegmentKeySecretRef
+	// is set. Provide your own Segment write key to enable telemetry.
+	// SegmentKey takes precedence over SegmentKeySecretRef when both are set,
+	// which is intended for local/self-deployed Konflux instances where an
+	// inline override is convenient. SegmentKeySecretRef is intended for
+	// Vault-backed (or otherwise externally-managed) staging/production
+	// environments where the key should not be stored in the CR itself.
+	// +optional
+	SegmentKey string `json:"segmentKey,omitempty"`
+
+	// SegmentKeySecretRef references a Secret key holding the Segment write
+	// key. Used when the write key is managed externally (e.g. Vault-backed
+	// secret injection) instead of being set inline via SegmentKey. Ignored
+	// when SegmentKey is set. The referenced Secret must exist in the
+	// segment-bridge namespace.
+	// +optional
+	SegmentKeySecretRef *corev1.SecretKeySelector `json:"segmentKeySecretRef,omitempty"`
+
+	// SegmentAPIURL is the base URL of the Segment API endpoint, without "/batch".
+	// The operator appends "/batch" to produce the SEGMENT_BATCH_API env var.
+	// Example: "https://console.redhat.com/connections/api/v1"
+	// When not specified, defaults to "https://api.segment.io/v1".
+	// Only plain HTTPS base URLs are supported (no query strings or fragments).
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https://[^?#]+$`
+	SegmentAPIURL string `json:"segmentAPIURL,omitempty"`
+
+	// TektonLimit sets the maximum number of PipelineRun records fetched per CronJob run.
+	// Defaults to 1000 (covers ~4.7h at avg production throughput of ~214 PipelineRuns/hour).
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TektonLimit *int `json:"tektonLimit,omitempty"`
+
+	// CronJob defines customizations (resources, env vars) for the segment-bridge CronJob
+	// container. Env vars are applied as overrides on top of the container's existing env,
+	// and do not replace the envFrom Secret reference that supplies SEGMENT_WRITE_KEY,
+	// SEGMENT_BATCH_API, TEKTON_RESULTS_API_ADDR, and TEKTON_LIMIT. When not set, the upstream defaults apply.
+	// +optional
+	CronJob *ContainerSpec `json:"cronJob,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// ImageRegistryMirrors rewrites the images of this component to their mirrors.
+	// Set by the Konflux reconciler from spec.imageRegistryMirrors on the Konflux CR.
+	// +optional
+	// +listType=atomic
+	ImageRegistryMirrors []ImageRegistryMirror `json:"imageRegistryMirrors,omitempty"`
+
+	// ImagePull holds the image pull secrets and policy of this component.
+	// Set by the Konflux reconciler from spec.imagePullSecrets and spec.imagePullPolicy on the Konflux CR.
+	// +optional
+	ImagePull *ImagePullConfig `json:"imagePull,omitempty"`
+
+	// EgressProxy is the egress proxy of the containers of this component.
+	// Set by the Konflux reconciler from spec.proxy or the cluster-wide Proxy of OpenShift.
+	// +optional
+	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func nodePortServiceSpecToHub(src *NodePortServiceSpec) *konfluxv1alpha1.NodePortServiceSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.NodePortServiceSpec{
+		HTTPSPort: src.HTTPSPort,
+	}
+}
+
+func nodePortServiceSpecFromHub(src *konfluxv1alpha1.NodePortServiceSpec) *NodePortServiceSpec {
+	if src == nil {
+		return nil
+	}
+	return &NodePortServiceSpec{
+		HTTPSPort: src.HTTPSPort,
+	}
+}
+
+func ingressSpecToHub(src *IngressSpec) *konfluxv1alpha1.IngressSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.IngressSpec{
+		Enabled:          src.Enabled,
+		IngressClassName: src.IngressClassName,
+		FQDN:             src.FQDN,
+		Hostname:         src.Hostname,
+		Annotations:      src.Annotations,
+		TLSSecretName:    src.TLSSecretName,
+		NodePortService:  nodePortServiceSpecToHub(src.NodePortService),
+	}
+}
+
+func ingressSpecFromHub(src *konfluxv1alpha1.IngressSpec) *IngressSpec {
+	if src == nil {
+		return nil
+	}
+	return &IngressSpec{
+		Enabled:          src.Enabled,
+		IngressClassName: src.IngressClassName,
+		FQDN:             src.FQDN,
+		Hostname:         src.Hostname,
+		Annotations:      src.Annotations,
+		TLSSecretName:    src.TLSSecretName,
+		NodePortService:  nodePortServiceSpecFromHub(src.NodePortService),
+	}
+}
+
+func proxyDeploymentSpecToHub(src *ProxyDeploymentSpec) *konfluxv1alpha1.ProxyDeploymentSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ProxyDeploymentSpec{
+		Replicas:            src.Replicas,
+		ReverseProxy:        containerSpecToHub(src.ReverseProxy),
+		OAuth2Proxy:         containerSpecToHub(src.OAuth2Proxy),
+		Endpoints:           proxyEndpointsSpecToHub(src.Endpoints),
+		PodDisruptionBudget: podDisruptionBudgetSpecToHub(src.PodDisruptionBudget),
+		Autoscaling:         autoscalingSpecToHub(src.Autoscaling),
+	}
+}
+
+func proxyDeploymentSpecFromHub(src *konfluxv1alpha1.ProxyDeploymentSpec) *ProxyDeploymentSpec {
+	if src == nil {
+		return nil
+	}
+	return &ProxyDeploymentSpec{
+		Replicas:            src.Replicas,
+		ReverseProxy:        containerSpecFromHub(src.ReverseProxy),
+		OAuth2Proxy:         containerSpecFromHub(src.OAuth2Proxy),
+		Endpoints:           proxyEndpointsSpecFromHub(src.Endpoints),
+		PodDisruptionBudget: podDisruptionBudgetSpecFromHub(src.PodDisruptionBudget),
+		Autoscaling:         autoscalingSpecFromHub(src.Autoscaling),
+	}
+}
+
+func proxyEndpointsSpecToHub(src *ProxyEndpointsSpec) *konfluxv1alpha1.ProxyEndpointsSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ProxyEndpointsSpec{
+		Kite:        endpointSpecToHub(src.Kite),
+		KubeArchive: endpointSpecToHub(src.KubeArchive),
+		Watson:      watsonEndpointSpecToHub(src.Watson),
+	}
+}
+
+func proxyEndpointsSpecFromHub(src *konfluxv1alpha1.ProxyEndpointsSpec) *ProxyEndpointsSpec {
+	if src == nil {
+		return nil
+	}
+	return &ProxyEndpointsSpec{
+		Kite:        endpointSpecFromHub(src.Kite),
+		KubeArchive: endpointSpecFromHub(src.KubeArchive),
+		Watson:      watsonEndpointSpecFromHub(src.Watson),
+	}
+}
+
+func endpointSpecToHub(src *EndpointSpec) *konfluxv1alpha1.EndpointSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.EndpointSpec{
+		Enabled:  src.Enabled,
+		Hostname: src.Hostname,
+	}
+}
+
+func endpointSpecFromHub(src *konfluxv1alpha1.EndpointSpec) *EndpointSpec {
+	if src == nil {
+		return nil
+	}
+	return &EndpointSpec{
+		Enabled:  src.Enabled,
+		Hostname: src.Hostname,
+	}
+}
+
+func watsonEndpointSpecToHub(src *WatsonEndpointSpec) *konfluxv1alpha1.WatsonEndpointSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.WatsonEndpointSpec{
+		Enabled:    src.Enabled,
+		Hostname:   src.Hostname,
+		SecretName: src.SecretName,
+	}
+}
+
+func watsonEndpointSpecFromHub(src *konfluxv1alpha1.WatsonEndpointSpec) *WatsonEndpointSpec {
+	if src == nil {
+		return nil
+	}
+	return &WatsonEndpointSpec{
+		Enabled:    src.Enabled,
+		Hostname:   src.Hostname,
+		SecretName: src.SecretName,
+	}
+}
+
+func dexDeploymentSpecToHub(src *DexDeploymentSpec) *konfluxv1alpha1.DexDeploymentSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.DexDeploymentSpec{
+		Replicas:            src.Replicas,
+		Dex:                 containerSpecToHub(src.Dex),
+		PodDisruptionBudget: podDisruptionBudgetSpecToHub(src.PodDisruptionBudget),
+		Config:              src.Config,
+	}
+}
+
+func dexDeploymentSpecFromHub(src *konfluxv1alpha1.DexDeploymentSpec) *DexDeploymentSpec {
+	if src == nil {
+		return nil
+	}
+	return &DexDeploymentSpec{
+		Replicas:            src.Replicas,
+		Dex:                 containerSpecFromHub(src.Dex),
+		PodDisruptionBudget: podDisruptionBudgetSpecFromHub(src.PodDisruptionBudget),
+		Config:              src.Config,
+	}
+}
+
+func runtimeConfigSpecToHub(src *RuntimeConfigSpec) *konfluxv1alpha1.RuntimeConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.RuntimeConfigSpec{
+		ChatBot:    chatBotConfigToHub(src.ChatBot),
+		Monitoring: monitoringConfigToHub(src.Monitoring),
+	}
+}
+
+func runtimeConfigSpecFromHub(src *konfluxv1alpha1.RuntimeConfigSpec) *RuntimeConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &RuntimeConfigSpec{
+		ChatBot:    chatBotConfigFromHub(src.ChatBot),
+		Monitoring: monitoringConfigFromHub(src.Monitoring),
+	}
+}
+
+func chatBotConfigToHub(src *ChatBotConfig) *konfluxv1alpha1.ChatBotConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.ChatBotConfig{
+		Enabled: src.Enabled,
+	}
+}
+
+func chatBotConfigFromHub(src *konfluxv1alpha1.ChatBotConfig) *ChatBotConfig {
+	if src == nil {
+		return nil
+	}
+	return &ChatBotConfig{
+		Enabled: src.Enabled,
+	}
+}
+
+func monitoringConfigToHub(src *MonitoringConfig) *konfluxv1alpha1.MonitoringConfig {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.MonitoringConfig{
+		Enabled:          src.Enabled,
+		DSN:              src.DSN,
+		Environment:      src.Environment,
+		Cluster:          src.Cluster,
+		SampleRateErrors: src.SampleRateErrors,
+	}
+}
+
+func monitoringConfigFromHub(src *konfluxv1alpha1.MonitoringConfig) *MonitoringConfig {
+	if src == nil {
+		return nil
+	}
+	return &MonitoringConfig{
+		Enabled:          src.Enabled,
+		DSN:              src.DSN,
+		Environment:      src.Environment,
+		Cluster:          src.Cluster,
+		SampleRateErrors: src.SampleRateErrors,
+	}
+}
+
+func konfluxUIConfigSpecToHub(src *KonfluxUIConfigSpec) *konfluxv1alpha1.KonfluxUIConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &konfluxv1alpha1.KonfluxUIConfigSpec{
+		Ingress:       ingressSpecToHub(src.Ingress),
+		Proxy:         proxyDeploymentSpecToHub(src.Proxy),
+		Dex:           dexDeploymentSpecToHub(src.Dex),
+		RuntimeConfig: runtimeConfigSpecToHub(src.RuntimeConfig),
+		PodPlacement:  podPlacementSpecToHub(src.PodPlacement),
+		Patches:       convertSlice(src.Patches, objectPatchToHub),
+		Logging:       loggingSpecToHub(src.Logging),
+	}
+}
+
+func konfluxUIConfigSpecFromHub(src *konfluxv1alpha1.KonfluxUIConfigSpec) *KonfluxUIConfigSpec {
+	if src == nil {
+		return nil
+	}
+	return &KonfluxUIConfigSpec{
+		Ingress:       ingressSpecFromHub(src.Ingress),
+		Proxy:         proxyDeploymentSpecFromHub(src.Proxy),
+		Dex:           dexDeploymentSpecFromHub(src.Dex),
+		RuntimeConfig: runtimeConfigSpecFromHub(src.RuntimeConfig),
+		PodPlacement:  podPlacementSpecFromHub(src.PodPlacement),
+		Patches:       convertSlice(src.Patches, objectPatchFromHub),
+		Logging:       loggingSpecFromHub(src.Logging),
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/konflux-ci/konflux-ci/operator/pkg/dex"
+)
+
+// NodePortServiceSpec defines the NodePort service configuration for the proxy.
+type NodePortServiceSpec struct {
+	// HTTPSPort is the NodePort to use for the HTTPS port.
+	// If not specified, Kubernetes will allocate a port automatically.
+	// This is useful for exposing Konflux UI to the outside world without an Ingress controller.
+	// +optional
+	// +kubebuilder:validation:Minimum=30000
+	// +kubebuilder:validation:Maximum=32767
+	HTTPSPort *int32 `json:"httpsPort,omitempty"`
+}
+
+// IngressSpec defines the ingress configuration for KonfluxUI.
+type IngressSpec struct {
+	// Enabled controls whether an Ingress resource should be created.
+	// When nil (unset), defaults to true on OpenShift, false otherwise.
+	// +optional
+	// +nullable
+	Enabled *bool `json:"enabled,omitempty"`
+	// IngressClassName specifies which IngressClass to use for the ingress.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// FQDN is the full public DNS name used as the UI endpoint for configuring oauth2-proxy,
+	// dex, and related components.
+	// An optional :port suffix (e.g. "ui.example.com:8443") is supported only for
+	// endpoint-only / user-managed routing when ingress is not effectively enabled.
+	// Including a port while ingress is enabled (explicit true, or unset on OpenShift)
+	// fails reconcile with Ready=False (reason InvalidIngressFQDN), because Ingress host
+	// rules omit ports while auth redirect URLs and ConsoleLink would keep the port.
+	// When set, this value is always used regardless of whether ingress is enabled,
+	// allowing users who manage their own external routing (e.g., Gateway API, hardware LB)
+	// to configure the endpoint without the operator managing an Ingress resource.
+	// Takes precedence over Hostname when both are set.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(:[0-9]{1,5})?$`
+	FQDN string `json:"fqdn,omitempty"`
+	// Hostname is a short DNS label composed with the cluster ingress domain on OpenShift
+	// as "{hostname}.{cluster-ingress-domain}" (no namespace infix).
+	// Ignored when FQDN is set. Off OpenShift, Hostname is ignored and a warning is logged.
+	// When both FQDN and Hostname are empty on OpenShift with ingress enabled, the operator
+	// falls back to "konflux-ui-{namespace}.{domain}".
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Hostname string `json:"hostname,omitempty"`
+	// Annotations to add to the ingress resource.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// TLSSecretName is the name of the Kubernetes TLS secret to use for the ingress.
+	// If not specified, TLS will not be configured on the ingress.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+	// NodePortService configures the proxy Service as a NodePort type.
+	// When set, the proxy Service will be exposed via NodePort instead of ClusterIP.
+	// This is useful for accessing Konflux UI from outside the cluster without an Ingress controller.
+	// +optional
+	NodePortService *NodePortServiceSpec `json:"nodePortService,omitempty"`
+}
+
+// ProxyDeploymentSpec defines customizations for the proxy deployment.
+type ProxyDeploymentSpec struct {
+	// Replicas is the number of replicas for the proxy deployment.
+	// When omitted, the replicas of spec.profile are used, or 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas,omitempty"`
+	// ReverseProxy defines customizations for the reverse proxy container.
+	// +optional
+	ReverseProxy *ContainerSpec `json:"reverseProxy,omitempty"`
+	// OAuth2Proxy defines customizations for the oauth2-proxy container.
+	// +optional
+	OAuth2Proxy *ContainerSpec `json:"oauth2Proxy,omitempty"`
+	// Endpoints configures optional backend services that the proxy routes to.
+	// Each endpoint can be independently enabled and customized.
+	// +optional
+	Endpoints *ProxyEndpointsSpec `json:"endpoints,omitempty"`
+	// PodDisruptionBudget creates a PodDisruptionBudget for the proxy pods.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// Autoscaling creates a HorizontalPodAutoscaler for the proxy deployment.
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+}
+
+// ProxyEndpointsSpec configures optional backend endpoints proxied by the UI reverse proxy.
+type ProxyEndpointsSpec struct {
+	// Kite enables the Kite plugin endpoint.
+	// When enabled, requests to /api/k8s/plugins/kite/ are proxied to the Kite backend.
+	// +optional
+	Kite *EndpointSpec `json:"kite,omitempty"`
+	// KubeArchive enables the KubeArchive plugin endpoint.
+	// When enabled, requests to /api/k8s/plugins/kubearchive/ are proxied to the KubeArchive backend.
+	// +optional
+	KubeArchive *EndpointSpec `json:"kubearchive,omitempty"`
+	// Watson enables the Watson chatbot endpoint.
+	// When enabled, requests to /api/chatbot/ are proxied to the IBM Watson Assistant API.
+	// +optional
+	Watson *WatsonEndpointSpec `json:"watson,omitempty"`
+}
+
+// EndpointSpec configures an optional in-cluster backend endpoint.
+type EndpointSpec struct {
+	// Enabled controls whether this endpoint is active.
+	// +optional
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+	// Hostname overrides the default backend service address.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+}
+
+// WatsonEndpointSpec configures the Watson chatbot endpoint.
+type WatsonEndpointSpec struct {
+	// Enabled controls whether the Watson chatbot endpoint is active.
+	// +optional
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+	// Hostname overrides the Watson API host.
+	// Defaults to api.us-east.assistant.watson.cloud.ibm.com.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+	// SecretName is the name of the Secret containing the Watson API key.
+	// The Secret must have a key named API_KEY with the pre-encoded Basic auth
+	// value (e.g. base64("apikey:<your-api-key>")).
+	// +kubebuilder:default="watson-api-key"
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// DexDeploymentSpec defines customizations for the dex deployment.
+type DexDeploymentSpec struct {
+	// Replicas is the number of replicas for the dex deployment.
+	// When omitted, the replicas of spec.profile are used, or 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas,omitempty"`
+	// Dex defines customizations for the dex container.
+	// +optional
+	Dex *ContainerSpec `json:"dex,omitempty"`
+	// PodDisruptionBudget creates a PodDisruptionBudget for the dex pods.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// Config defines the Dex IdP configuration parameters.
+	// +optional
+	Config *dex.DexParams `json:"config,omitempty"`
+}
+
+// RuntimeConfigSpec defines frontend runtime configuration for the Konflux UI.
+// Each field maps to a window.KONFLUX_RUNTIME property injected into the SPA
+// via runtime-config.js. The All() iterator provides the canonical mapping
+// from typed fields to environment variable names.
+type RuntimeConfigSpec struct {
+	// ChatBot configures the AI chatbot feature in the UI.
+	// +optional
+	ChatBot *ChatBotConfig `json:"chatBot,omitempty"`
+	// Monitoring configures error monitoring (e.g. Sentry) for the UI frontend.
+	// +optional
+	Monitoring *MonitoringConfig `json:"monitoring,omitempty"`
+}
+
+// ChatBotConfig configures the AI chatbot feature in the UI.
+type ChatBotConfig struct {
+	// Enabled controls whether the chatbot UI is visible to users.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// MonitoringConfig configures error monitoring (e.g. Sentry) for the UI frontend.
+type MonitoringConfig struct {
+	// Enabled controls whether error monitoring is active.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// DSN is the data source name (e.g. Sentry DSN) for error reporting.
+	// +optional
+	DSN string `json:"dsn,omitempty"`
+	// Environment identifies the deployment environment (e.g. "staging", "production").
+	// +optional
+	Environment string `json:"environment,omitempty"`
+	// Cluster identifies the cluster name for error reports.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+	// SampleRateErrors controls the error event sample rate (0.0 to 1.0).
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0(\.\d+)?|1(\.0+)?)$`
+	SampleRateErrors string `json:"sampleRateErrors,omitempty"`
+}
+
+// KonfluxUIConfigSpec defines user-configurable UI settings on the Konflux CR.
+type KonfluxUIConfigSpec struct {
+	// Ingress defines the ingress configuration for KonfluxUI.
+	// This affects the proxy, oauth2-proxy, and dex components.
+	// +optional
+	// +nullable
+	Ingress *IngressSpec `json:"ingress,omitempty"`
+	// Proxy defines customizations for the proxy deployment.
+	// +optional
+	Proxy *ProxyDeploymentSpec `json:"proxy,omitempty"`
+	// Dex defines customizations for the dex deployment.
+	// +optional
+	Dex *DexDeploymentSpec `json:"dex,omitempty"`
+	// RuntimeConfig defines frontend runtime configuration for the Konflux UI.
+	// These settings are injected as window.KONFLUX_RUNTIME properties in the SPA.
+	// +optional
+	RuntimeConfig *RuntimeConfigSpec `json:"runtimeConfig,omitempty"`
+
+	// PodPlacement overrides spec.podPlacement of the Konflux CR for the pods of this component;
+	// each field that is set replaces the Konflux-wide value. On the component CR it holds the
+	// placement in effect, as set by the Konflux reconciler.
+	// +optional
+	PodPlacement *PodPlacementSpec `json:"podPlacement,omitempty"`
+
+	// Patches are applied to the objects of this component after the spec.patches of the
+	// Konflux CR that target them. On the component CR it holds all patches in effect, as
+	// set by the Konflux reconciler.
+	// +optional
+	// +listType=atomic
+	Patches []ObjectPatch `json:"patches,omitempty"`
+
+	// Logging overrides spec.logging of the Konflux CR for this component; each field that is
+	// set replaces the Konflux-wide value. On the component CR it holds the settings in effect,
+	// as set by the Konflux reconciler.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+}
@@ -0,0 +1,2512 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"github.com/konflux-ci/konflux-ci/operator/pkg/dex"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingSpec) DeepCopyInto(out *AutoscalingSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingSpec.
+func (in *AutoscalingSpec) DeepCopy() *AutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Banner) DeepCopyInto(out *Banner) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = new([]BannerItem)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]BannerItem, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Banner.
+func (in *Banner) DeepCopy() *Banner {
+	if in == nil {
+		return nil
+	}
+	out := new(Banner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BannerItem) DeepCopyInto(out *BannerItem) {
+	*out = *in
+	if in.Year != nil {
+		in, out := &in.Year, &out.Year
+		*out = new(int)
+		**out = **in
+	}
+	if in.Month != nil {
+		in, out := &in.Month, &out.Month
+		*out = new(int)
+		**out = **in
+	}
+	if in.DayOfWeek != nil {
+		in, out := &in.DayOfWeek, &out.DayOfWeek
+		*out = new(int)
+		**out = **in
+	}
+	if in.DayOfMonth != nil {
+		in, out := &in.DayOfMonth, &out.DayOfMonth
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BannerItem.
+func (in *BannerItem) DeepCopy() *BannerItem {
+	if in == nil {
+		return nil
+	}
+	out := new(BannerItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockedComponent) DeepCopyInto(out *BlockedComponent) {
+	*out = *in
+	if in.WaitingFor != nil {
+		in, out := &in.WaitingFor, &out.WaitingFor
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockedComponent.
+func (in *BlockedComponent) DeepCopy() *BlockedComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockedComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildServiceConfig) DeepCopyInto(out *BuildServiceConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxBuildServiceConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildServiceConfig.
+func (in *BuildServiceConfig) DeepCopy() *BuildServiceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildServiceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CLIConfig) DeepCopyInto(out *CLIConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CLIConfig.
+func (in *CLIConfig) DeepCopy() *CLIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CLIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerConfig) DeepCopyInto(out *CertManagerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(CertManagerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerConfig.
+func (in *CertManagerConfig) DeepCopy() *CertManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerSpec) DeepCopyInto(out *CertManagerSpec) {
+	*out = *in
+	if in.CreateClusterIssuer != nil {
+		in, out := &in.CreateClusterIssuer, &out.CreateClusterIssuer
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerSpec.
+func (in *CertManagerSpec) DeepCopy() *CertManagerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChatBotConfig) DeepCopyInto(out *ChatBotConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChatBotConfig.
+func (in *ChatBotConfig) DeepCopy() *ChatBotConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ChatBotConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(ClusterConfigData)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfig.
+func (in *ClusterConfig) DeepCopy() *ClusterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigData) DeepCopyInto(out *ClusterConfigData) {
+	*out = *in
+	if in.EnableKeylessSigning != nil {
+		in, out := &in.EnableKeylessSigning, &out.EnableKeylessSigning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowCacheProxy != nil {
+		in, out := &in.AllowCacheProxy, &out.AllowCacheProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowPackageRegistryProxy != nil {
+		in, out := &in.AllowPackageRegistryProxy, &out.AllowPackageRegistryProxy
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigData.
+func (in *ClusterConfigData) DeepCopy() *ClusterConfigData {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentMetricsConfig) DeepCopyInto(out *ComponentMetricsConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentMetricsConfig.
+func (in *ComponentMetricsConfig) DeepCopy() *ComponentMetricsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentMetricsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpstreamRevisions != nil {
+		in, out := &in.UpstreamRevisions, &out.UpstreamRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManifestSource != nil {
+		in, out := &in.ManifestSource, &out.ManifestSource
+		*out = new(ManifestSourceStatus)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(ReplicaStatus)
+		**out = **in
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]RunningImage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSpec) DeepCopyInto(out *ContainerSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSpec.
+func (in *ContainerSpec) DeepCopy() *ContainerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerManagerDeploymentSpec) DeepCopyInto(out *ControllerManagerDeploymentSpec) {
+	*out = *in
+	if in.Manager != nil {
+		in, out := &in.Manager, &out.Manager
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerManagerDeploymentSpec.
+func (in *ControllerManagerDeploymentSpec) DeepCopy() *ControllerManagerDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerManagerDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultTenantConfig) DeepCopyInto(out *DefaultTenantConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultTenantConfig.
+func (in *DefaultTenantConfig) DeepCopy() *DefaultTenantConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultTenantConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DexDeploymentSpec) DeepCopyInto(out *DexDeploymentSpec) {
+	*out = *in
+	if in.Dex != nil {
+		in, out := &in.Dex, &out.Dex
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(dex.DexParams)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentSpec.
+func (in *DexDeploymentSpec) DeepCopy() *DexDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DexDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedResource) DeepCopyInto(out *DriftedResource) {
+	*out = *in
+	if in.Managers != nil {
+		in, out := &in.Managers, &out.Managers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastDetected.DeepCopyInto(&out.LastDetected)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedResource.
+func (in *DriftedResource) DeepCopy() *DriftedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressProxySpec) DeepCopyInto(out *EgressProxySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressProxySpec.
+func (in *EgressProxySpec) DeepCopy() *EgressProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmptyDirOverride) DeepCopyInto(out *EmptyDirOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmptyDirOverride.
+func (in *EmptyDirOverride) DeepCopy() *EmptyDirOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(EmptyDirOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSpec) DeepCopyInto(out *EndpointSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSpec.
+func (in *EndpointSpec) DeepCopy() *EndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnterpriseContractConfig) DeepCopyInto(out *EnterpriseContractConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(EnterpriseContractSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseContractConfig.
+func (in *EnterpriseContractConfig) DeepCopy() *EnterpriseContractConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EnterpriseContractConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnterpriseContractSpec) DeepCopyInto(out *EnterpriseContractSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseContractSpec.
+func (in *EnterpriseContractSpec) DeepCopy() *EnterpriseContractSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnterpriseContractSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubIntegration) DeepCopyInto(out *GitHubIntegration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubIntegration.
+func (in *GitHubIntegration) DeepCopy() *GitHubIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageControllerConfig) DeepCopyInto(out *ImageControllerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxImageControllerConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageControllerConfig.
+func (in *ImageControllerConfig) DeepCopy() *ImageControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullConfig) DeepCopyInto(out *ImagePullConfig) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullConfig.
+func (in *ImagePullConfig) DeepCopy() *ImagePullConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullSecretReference) DeepCopyInto(out *ImagePullSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecretReference.
+func (in *ImagePullSecretReference) DeepCopy() *ImagePullSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistryMirror) DeepCopyInto(out *ImageRegistryMirror) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryMirror.
+func (in *ImageRegistryMirror) DeepCopy() *ImageRegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfoConfig) DeepCopyInto(out *InfoConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxInfoSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfoConfig.
+func (in *InfoConfig) DeepCopy() *InfoConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfoConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfoImageControllerConfig) DeepCopyInto(out *InfoImageControllerConfig) {
+	*out = *in
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]InfoNotificationConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfoImageControllerConfig.
+func (in *InfoImageControllerConfig) DeepCopy() *InfoImageControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfoImageControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfoNotificationConfig) DeepCopyInto(out *InfoNotificationConfig) {
+	*out = *in
+	in.Config.DeepCopyInto(&out.Config)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfoNotificationConfig.
+func (in *InfoNotificationConfig) DeepCopy() *InfoNotificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfoNotificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodePortService != nil {
+		in, out := &in.NodePortService, &out.NodePortService
+		*out = new(NodePortServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSpec.
+func (in *IngressSpec) DeepCopy() *IngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationServiceConfig) DeepCopyInto(out *IntegrationServiceConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxIntegrationServiceConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationServiceConfig.
+func (in *IntegrationServiceConfig) DeepCopy() *IntegrationServiceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationServiceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationsConfig) DeepCopyInto(out *IntegrationsConfig) {
+	*out = *in
+	if in.GitHub != nil {
+		in, out := &in.GitHub, &out.GitHub
+		*out = new(GitHubIntegration)
+		**out = **in
+	}
+	if in.SBOMServer != nil {
+		in, out := &in.SBOMServer, &out.SBOMServer
+		*out = new(SBOMServerConfig)
+		**out = **in
+	}
+	if in.ImageController != nil {
+		in, out := &in.ImageController, &out.ImageController
+		*out = new(InfoImageControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationsConfig.
+func (in *IntegrationsConfig) DeepCopy() *IntegrationsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalRegistryConfig) DeepCopyInto(out *InternalRegistryConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxInternalRegistrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalRegistryConfig.
+func (in *InternalRegistryConfig) DeepCopy() *InternalRegistryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalRegistryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Konflux) DeepCopyInto(out *Konflux) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Konflux.
+func (in *Konflux) DeepCopy() *Konflux {
+	if in == nil {
+		return nil
+	}
+	out := new(Konflux)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Konflux) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxBuildServiceConfigSpec) DeepCopyInto(out *KonfluxBuildServiceConfigSpec) {
+	*out = *in
+	if in.BuildControllerManager != nil {
+		in, out := &in.BuildControllerManager, &out.BuildControllerManager
+		*out = new(ControllerManagerDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PACWebhookInsecureSSL != nil {
+		in, out := &in.PACWebhookInsecureSSL, &out.PACWebhookInsecureSSL
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WebhookURLs != nil {
+		in, out := &in.WebhookURLs, &out.WebhookURLs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PipelineConfig != nil {
+		in, out := &in.PipelineConfig, &out.PipelineConfig
+		*out = new(PipelineConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxBuildServiceConfigSpec.
+func (in *KonfluxBuildServiceConfigSpec) DeepCopy() *KonfluxBuildServiceConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxBuildServiceConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxImageControllerConfigSpec) DeepCopyInto(out *KonfluxImageControllerConfigSpec) {
+	*out = *in
+	if in.ImageControllerManager != nil {
+		in, out := &in.ImageControllerManager, &out.ImageControllerManager
+		*out = new(ControllerManagerDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePruner != nil {
+		in, out := &in.ImagePruner, &out.ImagePruner
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotificationResetter != nil {
+		in, out := &in.NotificationResetter, &out.NotificationResetter
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuayCABundle != nil {
+		in, out := &in.QuayCABundle, &out.QuayCABundle
+		*out = new(QuayCABundleSpec)
+		**out = **in
+	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxImageControllerConfigSpec.
+func (in *KonfluxImageControllerConfigSpec) DeepCopy() *KonfluxImageControllerConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxImageControllerConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxInfoSpec) DeepCopyInto(out *KonfluxInfoSpec) {
+	*out = *in
+	if in.PublicInfo != nil {
+		in, out := &in.PublicInfo, &out.PublicInfo
+		*out = new(PublicInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Banner != nil {
+		in, out := &in.Banner, &out.Banner
+		*out = new(Banner)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterConfig != nil {
+		in, out := &in.ClusterConfig, &out.ClusterConfig
+		*out = new(ClusterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxInfoSpec.
+func (in *KonfluxInfoSpec) DeepCopy() *KonfluxInfoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxInfoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxIntegrationService) DeepCopyInto(out *KonfluxIntegrationService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxIntegrationService.
+func (in *KonfluxIntegrationService) DeepCopy() *KonfluxIntegrationService {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxIntegrationService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonfluxIntegrationService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxIntegrationServiceConfigSpec) DeepCopyInto(out *KonfluxIntegrationServiceConfigSpec) {
+	*out = *in
+	if in.IntegrationControllerManager != nil {
+		in, out := &in.IntegrationControllerManager, &out.IntegrationControllerManager
+		*out = new(ControllerManagerDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PipelineTimeout != nil {
+		in, out := &in.PipelineTimeout, &out.PipelineTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TasksTimeout != nil {
+		in, out := &in.TasksTimeout, &out.TasksTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FinallyTimeout != nil {
+		in, out := &in.FinallyTimeout, &out.FinallyTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SnapshotGarbageCollector != nil {
+		in, out := &in.SnapshotGarbageCollector, &out.SnapshotGarbageCollector
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PRSnapshotsToKeep != nil {
+		in, out := &in.PRSnapshotsToKeep, &out.PRSnapshotsToKeep
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NonPRSnapshotsToKeep != nil {
+		in, out := &in.NonPRSnapshotsToKeep, &out.NonPRSnapshotsToKeep
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinSnapshotsToKeepPerComponent != nil {
+		in, out := &in.MinSnapshotsToKeepPerComponent, &out.MinSnapshotsToKeepPerComponent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxIntegrationServiceConfigSpec.
+func (in *KonfluxIntegrationServiceConfigSpec) DeepCopy() *KonfluxIntegrationServiceConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxIntegrationServiceConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxIntegrationServiceList) DeepCopyInto(out *KonfluxIntegrationServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KonfluxIntegrationService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxIntegrationServiceList.
+func (in *KonfluxIntegrationServiceList) DeepCopy() *KonfluxIntegrationServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxIntegrationServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonfluxIntegrationServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxIntegrationServiceSpec) DeepCopyInto(out *KonfluxIntegrationServiceSpec) {
+	*out = *in
+	in.KonfluxIntegrationServiceConfigSpec.DeepCopyInto(&out.KonfluxIntegrationServiceConfigSpec)
+	if in.ComponentMetrics != nil {
+		in, out := &in.ComponentMetrics, &out.ComponentMetrics
+		*out = new(ComponentMetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxIntegrationServiceSpec.
+func (in *KonfluxIntegrationServiceSpec) DeepCopy() *KonfluxIntegrationServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxIntegrationServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxIntegrationServiceStatus) DeepCopyInto(out *KonfluxIntegrationServiceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxIntegrationServiceStatus.
+func (in *KonfluxIntegrationServiceStatus) DeepCopy() *KonfluxIntegrationServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxIntegrationServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxInternalRegistrySpec) DeepCopyInto(out *KonfluxInternalRegistrySpec) {
+	*out = *in
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxInternalRegistrySpec.
+func (in *KonfluxInternalRegistrySpec) DeepCopy() *KonfluxInternalRegistrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxInternalRegistrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxList) DeepCopyInto(out *KonfluxList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Konflux, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxList.
+func (in *KonfluxList) DeepCopy() *KonfluxList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonfluxList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxNamespaceLister) DeepCopyInto(out *KonfluxNamespaceLister) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxNamespaceLister.
+func (in *KonfluxNamespaceLister) DeepCopy() *KonfluxNamespaceLister {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxNamespaceLister)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonfluxNamespaceLister) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxNamespaceListerList) DeepCopyInto(out *KonfluxNamespaceListerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KonfluxNamespaceLister, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxNamespaceListerList.
+func (in *KonfluxNamespaceListerList) DeepCopy() *KonfluxNamespaceListerList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxNamespaceListerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonfluxNamespaceListerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxNamespaceListerSpec) DeepCopyInto(out *KonfluxNamespaceListerSpec) {
+	*out = *in
+	if in.NamespaceLister != nil {
+		in, out := &in.NamespaceLister, &out.NamespaceLister
+		*out = new(NamespaceListerDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheResyncPeriod != nil {
+		in, out := &in.CacheResyncPeriod, &out.CacheResyncPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxNamespaceListerSpec.
+func (in *KonfluxNamespaceListerSpec) DeepCopy() *KonfluxNamespaceListerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxNamespaceListerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxNamespaceListerStatus) DeepCopyInto(out *KonfluxNamespaceListerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxNamespaceListerStatus.
+func (in *KonfluxNamespaceListerStatus) DeepCopy() *KonfluxNamespaceListerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxNamespaceListerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxRBAC) DeepCopyInto(out *KonfluxRBAC) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxRBAC.
+func (in *KonfluxRBAC) DeepCopy() *KonfluxRBAC {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxRBAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonfluxRBAC) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxRBACList) DeepCopyInto(out *KonfluxRBACList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KonfluxRBAC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxRBACList.
+func (in *KonfluxRBACList) DeepCopy() *KonfluxRBACList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxRBACList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonfluxRBACList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxRBACSpec) DeepCopyInto(out *KonfluxRBACSpec) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxRBACSpec.
+func (in *KonfluxRBACSpec) DeepCopy() *KonfluxRBACSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxRBACSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxRBACStatus) DeepCopyInto(out *KonfluxRBACStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestProvenance != nil {
+		in, out := &in.ManifestProvenance, &out.ManifestProvenance
+		*out = new(ManifestProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxRBACStatus.
+func (in *KonfluxRBACStatus) DeepCopy() *KonfluxRBACStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxRBACStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxReleaseServiceConfigSpec) DeepCopyInto(out *KonfluxReleaseServiceConfigSpec) {
+	*out = *in
+	if in.EmptyDirOverrides != nil {
+		in, out := &in.EmptyDirOverrides, &out.EmptyDirOverrides
+		*out = make([]EmptyDirOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReleaseControllerManager != nil {
+		in, out := &in.ReleaseControllerManager, &out.ReleaseControllerManager
+		*out = new(ControllerManagerDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxReleaseServiceConfigSpec.
+func (in *KonfluxReleaseServiceConfigSpec) DeepCopy() *KonfluxReleaseServiceConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxReleaseServiceConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxSegmentBridgeSpec) DeepCopyInto(out *KonfluxSegmentBridgeSpec) {
+	*out = *in
+	if in.SegmentKeySecretRef != nil {
+		in, out := &in.SegmentKeySecretRef, &out.SegmentKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TektonLimit != nil {
+		in, out := &in.TektonLimit, &out.TektonLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.CronJob != nil {
+		in, out := &in.CronJob, &out.CronJob
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePull != nil {
+		in, out := &in.ImagePull, &out.ImagePull
+		*out = new(ImagePullConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxSegmentBridgeSpec.
+func (in *KonfluxSegmentBridgeSpec) DeepCopy() *KonfluxSegmentBridgeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxSegmentBridgeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxSpec) DeepCopyInto(out *KonfluxSpec) {
+	*out = *in
+	if in.ImageController != nil {
+		in, out := &in.ImageController, &out.ImageController
+		*out = new(ImageControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UI != nil {
+		in, out := &in.UI, &out.UI
+		*out = new(UIConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IntegrationService != nil {
+		in, out := &in.IntegrationService, &out.IntegrationService
+		*out = new(IntegrationServiceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReleaseService != nil {
+		in, out := &in.ReleaseService, &out.ReleaseService
+		*out = new(ReleaseServiceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildService != nil {
+		in, out := &in.BuildService, &out.BuildService
+		*out = new(BuildServiceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceLister != nil {
+		in, out := &in.NamespaceLister, &out.NamespaceLister
+		*out = new(NamespaceListerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Info != nil {
+		in, out := &in.Info, &out.Info
+		*out = new(InfoConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnterpriseContract != nil {
+		in, out := &in.EnterpriseContract, &out.EnterpriseContract
+		*out = new(EnterpriseContractConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InternalRegistry != nil {
+		in, out := &in.InternalRegistry, &out.InternalRegistry
+		*out = new(InternalRegistryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultTenant != nil {
+		in, out := &in.DefaultTenant, &out.DefaultTenant
+		*out = new(DefaultTenantConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Telemetry != nil {
+		in, out := &in.Telemetry, &out.Telemetry
+		*out = new(TelemetryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CLI != nil {
+		in, out := &in.CLI, &out.CLI
+		*out = new(CLIConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComponentMetrics != nil {
+		in, out := &in.ComponentMetrics, &out.ComponentMetrics
+		*out = new(ComponentMetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryMirrors != nil {
+		in, out := &in.ImageRegistryMirrors, &out.ImageRegistryMirrors
+		*out = make([]ImageRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]ImagePullSecretReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxSpec.
+func (in *KonfluxSpec) DeepCopy() *KonfluxSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxStatus) DeepCopyInto(out *KonfluxStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompletedUpgradeSteps != nil {
+		in, out := &in.CompletedUpgradeSteps, &out.CompletedUpgradeSteps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnmirroredImages != nil {
+		in, out := &in.UnmirroredImages, &out.UnmirroredImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(EgressProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxStatus.
+func (in *KonfluxStatus) DeepCopy() *KonfluxStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonfluxUIConfigSpec) DeepCopyInto(out *KonfluxUIConfigSpec) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dex != nil {
+		in, out := &in.Dex, &out.Dex
+		*out = new(DexDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeConfig != nil {
+		in, out := &in.RuntimeConfig, &out.RuntimeConfig
+		*out = new(RuntimeConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodPlacement != nil {
+		in, out := &in.PodPlacement, &out.PodPlacement
+		*out = new(PodPlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ObjectPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonfluxUIConfigSpec.
+func (in *KonfluxUIConfigSpec) DeepCopy() *KonfluxUIConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonfluxUIConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSpec) DeepCopyInto(out *LoggingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSpec.
+func (in *LoggingSpec) DeepCopy() *LoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestProvenance) DeepCopyInto(out *ManifestProvenance) {
+	*out = *in
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]ManifestUpstream, len(*in))
+		copy(*out, *in)
+	}
+	if in.BuildTime != nil {
+		in, out := &in.BuildTime, &out.BuildTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestProvenance.
+func (in *ManifestProvenance) DeepCopy() *ManifestProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestSourceStatus) DeepCopyInto(out *ManifestSourceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestSourceStatus.
+func (in *ManifestSourceStatus) DeepCopy() *ManifestSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestUpstream) DeepCopyInto(out *ManifestUpstream) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestUpstream.
+func (in *ManifestUpstream) DeepCopy() *ManifestUpstream {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestUpstream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringConfig.
+func (in *MonitoringConfig) DeepCopy() *MonitoringConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceListerConfig) DeepCopyInto(out *NamespaceListerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxNamespaceListerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceListerConfig.
+func (in *NamespaceListerConfig) DeepCopy() *NamespaceListerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceListerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceListerDeploymentSpec) DeepCopyInto(out *NamespaceListerDeploymentSpec) {
+	*out = *in
+	if in.NamespaceLister != nil {
+		in, out := &in.NamespaceLister, &out.NamespaceLister
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceListerDeploymentSpec.
+func (in *NamespaceListerDeploymentSpec) DeepCopy() *NamespaceListerDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceListerDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePortServiceSpec) DeepCopyInto(out *NodePortServiceSpec) {
+	*out = *in
+	if in.HTTPSPort != nil {
+		in, out := &in.HTTPSPort, &out.HTTPSPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortServiceSpec.
+func (in *NodePortServiceSpec) DeepCopy() *NodePortServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePortServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectPatch) DeepCopyInto(out *ObjectPatch) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectPatch.
+func (in *ObjectPatch) DeepCopy() *ObjectPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchTarget) DeepCopyInto(out *PatchTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchTarget.
+func (in *PatchTarget) DeepCopy() *PatchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineConfigSpec) DeepCopyInto(out *PipelineConfigSpec) {
+	*out = *in
+	if in.Pipelines != nil {
+		in, out := &in.Pipelines, &out.Pipelines
+		*out = make([]PipelineSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineConfigSpec.
+func (in *PipelineConfigSpec) DeepCopy() *PipelineConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSpec.
+func (in *PipelineSpec) DeepCopy() *PipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
+func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPlacementSpec) DeepCopyInto(out *PodPlacementSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlacementSpec.
+func (in *PodPlacementSpec) DeepCopy() *PodPlacementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPlacementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyDeploymentSpec) DeepCopyInto(out *ProxyDeploymentSpec) {
+	*out = *in
+	if in.ReverseProxy != nil {
+		in, out := &in.ReverseProxy, &out.ReverseProxy
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2Proxy != nil {
+		in, out := &in.OAuth2Proxy, &out.OAuth2Proxy
+		*out = new(ContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(ProxyEndpointsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyDeploymentSpec.
+func (in *ProxyDeploymentSpec) DeepCopy() *ProxyDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyEndpointsSpec) DeepCopyInto(out *ProxyEndpointsSpec) {
+	*out = *in
+	if in.Kite != nil {
+		in, out := &in.Kite, &out.Kite
+		*out = new(EndpointSpec)
+		**out = **in
+	}
+	if in.KubeArchive != nil {
+		in, out := &in.KubeArchive, &out.KubeArchive
+		*out = new(EndpointSpec)
+		**out = **in
+	}
+	if in.Watson != nil {
+		in, out := &in.Watson, &out.Watson
+		*out = new(WatsonEndpointSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyEndpointsSpec.
+func (in *ProxyEndpointsSpec) DeepCopy() *ProxyEndpointsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyEndpointsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicInfo) DeepCopyInto(out *PublicInfo) {
+	*out = *in
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = new(IntegrationsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RBAC != nil {
+		in, out := &in.RBAC, &out.RBAC
+		*out = make([]RBACRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicInfo.
+func (in *PublicInfo) DeepCopy() *PublicInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayCABundleSpec) DeepCopyInto(out *QuayCABundleSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayCABundleSpec.
+func (in *QuayCABundleSpec) DeepCopy() *QuayCABundleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayCABundleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBACRole) DeepCopyInto(out *RBACRole) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBACRole.
+func (in *RBACRole) DeepCopy() *RBACRole {
+	if in == nil {
+		return nil
+	}
+	out := new(RBACRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseServiceConfig) DeepCopyInto(out *ReleaseServiceConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxReleaseServiceConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseServiceConfig.
+func (in *ReleaseServiceConfig) DeepCopy() *ReleaseServiceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseServiceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaStatus) DeepCopyInto(out *ReplicaStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaStatus.
+func (in *ReplicaStatus) DeepCopy() *ReplicaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.Blocked != nil {
+		in, out := &in.Blocked, &out.Blocked
+		*out = make([]BlockedComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunningImage) DeepCopyInto(out *RunningImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunningImage.
+func (in *RunningImage) DeepCopy() *RunningImage {
+	if in == nil {
+		return nil
+	}
+	out := new(RunningImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeConfigSpec) DeepCopyInto(out *RuntimeConfigSpec) {
+	*out = *in
+	if in.ChatBot != nil {
+		in, out := &in.ChatBot, &out.ChatBot
+		*out = new(ChatBotConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeConfigSpec.
+func (in *RuntimeConfigSpec) DeepCopy() *RuntimeConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SBOMServerConfig) DeepCopyInto(out *SBOMServerConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOMServerConfig.
+func (in *SBOMServerConfig) DeepCopy() *SBOMServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SBOMServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelemetryConfig) DeepCopyInto(out *TelemetryConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxSegmentBridgeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetryConfig.
+func (in *TelemetryConfig) DeepCopy() *TelemetryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TelemetryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UIConfig) DeepCopyInto(out *UIConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KonfluxUIConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UIConfig.
+func (in *UIConfig) DeepCopy() *UIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatsonEndpointSpec) DeepCopyInto(out *WatsonEndpointSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatsonEndpointSpec.
+func (in *WatsonEndpointSpec) DeepCopy() *WatsonEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WatsonEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -21,21 +21,26 @@ import (
 	"crypto/tls"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -47,7 +52,9 @@ import (
 	securityv1 "github.com/openshift/api/security/v1"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	konfluxv1beta1 "github.com/konflux-ci/konflux-ci/operator/api/v1beta1"
 	"github.com/konflux-ci/konflux-ci/operator/internal/common"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/applicationapi"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/buildservice"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/certmanager"
@@ -65,9 +72,15 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/segmentbridge"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/ui"
 	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/internal/render"
+	"github.com/konflux-ci/konflux-ci/operator/internal/upgrade"
+	"github.com/konflux-ci/konflux-ci/operator/internal/validate"
+	webhookv1alpha1 "github.com/konflux-ci/konflux-ci/operator/internal/webhook/v1alpha1"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
 	// +kubebuilder:scaffold:imports
 )
@@ -86,10 +99,11 @@ func init() {
 	utilruntime.Must(securityv1.Install(scheme))
 
 	utilruntime.Must(konfluxv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(konfluxv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
-// runDumpManifests dumps all embedded manifests to stdout.
+// runDumpManifests dumps all embedded manifests to stdout, each preceded by its provenance.
 func runDumpManifests() {
 	allManifests, err := manifests.GetAllManifests()
 	if err != nil {
@@ -97,14 +111,194 @@ func runDumpManifests() {
 		os.Exit(1)
 	}
 
-	for component, content := range allManifests {
+	for _, component := range manifests.AllComponents() {
+		content := allManifests[component]
+		provenance, err := manifests.GetProvenance(component)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting provenance of %s: %v\n", component, err)
+			os.Exit(1)
+		}
 		fmt.Printf("# Component: %s\n", component)
+		fmt.Printf("# Kustomize path: %s\n", provenance.KustomizePath)
+		for _, upstream := range provenance.Upstreams {
+			fmt.Printf("# Upstream: github.com/%s/%s@%s\n", upstream.Repository, upstream.Path, upstream.Revision)
+		}
+		fmt.Printf("# Build time: %s\n", provenance.BuildTime.UTC().Format(time.RFC3339))
+		fmt.Printf("# Content hash: %s\n", provenance.ContentHash)
 		fmt.Printf("---\n")
 		fmt.Print(string(content))
 		fmt.Printf("\n")
 	}
 }
 
+// runRender prints the objects the operator would apply for a Konflux CR, computed by running
+// the reconcilers against a simulated cluster.
+func runRender(args []string) {
+	flags := flag.NewFlagSet("render", flag.ExitOnError)
+	file := flags.String("f", "", "The file with the Konflux CR to render, or - for stdin.")
+	platform := flags.String("platform", string(clusterinfo.Default),
+		"The platform of the simulated cluster: default or openshift.")
+	ingressDomain := flags.String("ingress-domain", render.DefaultIngressDomain,
+		"The ingress domain of the simulated cluster when the platform is openshift.")
+	manifestSources := manifests.Sources{}
+	flags.Var(manifestSources, "manifest-source",
+		"Render a component's manifests from <component>=dir:<path>@sha256:<digest> or "+
+			"<component>=oci:<registry>/<repository>@sha256:<digest>, as the operator flag of the same name does.")
+	opts := zap.Options{}
+	opts.BindFlags(flags)
+	// Only errors are logged unless asked for: the reconcilers log every object they apply.
+	_ = flags.Set("zap-log-level", "error")
+	_ = flags.Set("zap-stacktrace-level", "panic")
+	_ = flags.Parse(args)
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+
+	fail := func(format string, a ...any) {
+		fmt.Fprintf(os.Stderr, "Error: "+format+"\n", a...)
+		os.Exit(1)
+	}
+	if *file == "" {
+		fail("-f is required")
+	}
+	targetPlatform, err := clusterinfo.ParsePlatform(*platform)
+	if err != nil {
+		fail("%v", err)
+	}
+	var content []byte
+	if *file == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(*file)
+	}
+	if err != nil {
+		fail("reading %s: %v", *file, err)
+	}
+	konfluxCR, err := render.DecodeKonflux(scheme, content)
+	if err != nil {
+		fail("%s: %v", *file, err)
+	}
+
+	ctx := logf.IntoContext(context.Background(), setupLog)
+	objectStore, err := manifests.NewObjectStoreWithSources(ctx, scheme, manifestSources, nil)
+	if err != nil {
+		fail("parsing manifests: %v", err)
+	}
+	// Unlike the operator, do not fall back silently: the output would not be what was asked for.
+	for component := range manifestSources {
+		if reason := objectStore.Source(component).FallbackReason; reason != "" {
+			fail("manifest source of %s: %s", component, reason)
+		}
+	}
+
+	objects, err := render.Render(ctx, scheme, konfluxCR, render.Options{
+		Platform:      targetPlatform,
+		IngressDomain: *ingressDomain,
+		ObjectStore:   objectStore,
+	})
+	if err != nil {
+		fail("rendering %s: %v", konfluxCR.Name, err)
+	}
+	if err := render.WriteYAML(os.Stdout, objects); err != nil {
+		fail("%v", err)
+	}
+}
+
+// runValidate validates the Konflux CRs in the given files without a cluster, and exits
+// non-zero if any is rejected.
+func runValidate(args []string) {
+	flags := flag.NewFlagSet("validate", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s validate [flags] <file>... (- for stdin)\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	output := flags.String("o", "text", "The output format: text, a line per violation, or json, a result per document.")
+	platform := flags.String("platform", string(clusterinfo.Default),
+		"The platform of the target cluster, for the checks that depend on it: default or openshift.")
+	withWebhooks := flags.Bool("with-webhooks", false,
+		"Accept the versions that the operator's conversion webhook serves, such as v1beta1, for installs with the webhooks.")
+	_ = flags.Parse(args)
+
+	fail := func(format string, a ...any) {
+		fmt.Fprintf(os.Stderr, "Error: "+format+"\n", a...)
+		os.Exit(2)
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	write := validate.WriteText
+	switch *output {
+	case "text":
+	case "json":
+		write = validate.WriteJSON
+	default:
+		fail("unknown output format %q, expected text or json", *output)
+	}
+	targetPlatform, err := clusterinfo.ParsePlatform(*platform)
+	if err != nil {
+		fail("%v", err)
+	}
+	objectStore, err := manifests.NewObjectStore(scheme)
+	if err != nil {
+		fail("parsing manifests: %v", err)
+	}
+	validator, err := validate.New(scheme, validate.Options{
+		Platform:          targetPlatform,
+		ObjectStore:       objectStore,
+		ConversionWebhook: *withWebhooks,
+	})
+	if err != nil {
+		fail("loading CRDs: %v", err)
+	}
+
+	var results []validate.Result
+	for _, file := range flags.Args() {
+		var content []byte
+		if file == "-" {
+			content, err = io.ReadAll(os.Stdin)
+		} else {
+			content, err = os.ReadFile(file)
+		}
+		if err != nil {
+			fail("reading %s: %v", file, err)
+		}
+		results = append(results, validator.Validate(context.Background(), file, content)...)
+	}
+	if err := write(os.Stdout, results); err != nil {
+		fail("%v", err)
+	}
+	for _, result := range results {
+		if len(result.Violations) > 0 {
+			os.Exit(1)
+		}
+	}
+}
+
+// setupWebhooks registers the admission and conversion webhooks for the Konflux CRs with the manager.
+func setupWebhooks(mgr ctrl.Manager, clusterInfo *clusterinfo.Info, objectStore *manifests.ObjectStore) error {
+	if err := webhookv1alpha1.SetupKonfluxWebhookWithManager(mgr, clusterInfo, objectStore); err != nil {
+		return fmt.Errorf("webhook Konflux: %w", err)
+	}
+	if err := webhookv1alpha1.SetupKonfluxUIWebhookWithManager(mgr, clusterInfo); err != nil {
+		return fmt.Errorf("webhook KonfluxUI: %w", err)
+	}
+	if err := webhookv1alpha1.SetupKonfluxBuildServiceWebhookWithManager(mgr, objectStore); err != nil {
+		return fmt.Errorf("webhook KonfluxBuildService: %w", err)
+	}
+	if err := webhookv1alpha1.SetupKonfluxIntegrationServiceWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook KonfluxIntegrationService: %w", err)
+	}
+	if err := webhookv1alpha1.SetupKonfluxNamespaceListerWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook KonfluxNamespaceLister: %w", err)
+	}
+	if err := webhookv1alpha1.SetupKonfluxInternalRegistryWebhookWithManager(mgr, clusterInfo); err != nil {
+		return fmt.Errorf("webhook KonfluxInternalRegistry: %w", err)
+	}
+	if err := webhookv1alpha1.SetupKonfluxRBACWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook KonfluxRBAC: %w", err)
+	}
+	return nil
+}
+
 // nolint:gocyclo
 func main() {
 	// Handle subcommands
@@ -113,6 +307,12 @@ func main() {
 		case "dump-manifests":
 			runDumpManifests()
 			return
+		case "render":
+			runRender(os.Args[2:])
+			return
+		case "validate":
+			runValidate(os.Args[2:])
+			return
 		}
 	}
 
@@ -124,6 +324,7 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var tlsOpts []func(*tls.Config)
+	manifestSources := manifests.Sources{}
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -141,6 +342,10 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.Var(manifestSources, "manifest-source",
+		"Load a component's manifests from <component>=dir:<path>@sha256:<digest> or "+
+			"<component>=oci:<registry>/<repository>@sha256:<digest> instead of the embedded manifests. "+
+			"Repeat the flag for each component.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -270,10 +475,11 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Parse all embedded manifests into an ObjectStore
-	objectStore, err := manifests.NewObjectStore(scheme)
+	// Parse all manifests into an ObjectStore, loading those with a --manifest-source from there
+	objectStore, err := manifests.NewObjectStoreWithSources(
+		logf.IntoContext(context.Background(), setupLog), scheme, manifestSources, nil)
 	if err != nil {
-		setupLog.Error(err, "unable to parse embedded manifests")
+		setupLog.Error(err, "unable to parse manifests")
 		os.Exit(1)
 	}
 	// Pre-install CRDs managed by controllers that also watch CRs of those CRDs.
@@ -343,10 +549,24 @@ func main() {
 		}
 	}
 
+	liveMetadata, err := newLiveMetadata(mgr)
+	if err != nil {
+		setupLog.Error(err, "unable to create live metadata cache")
+		os.Exit(1)
+	}
+
+	// Events are deduplicated so that a failure retried with backoff shows up once per window.
+	eventRecorder := recorder.NewDeduplicating(mgr.GetEventRecorder(recorder.Name), recorder.DefaultDedupWindow)
+
 	if err := (&konflux.KonfluxReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ClusterInfo: clusterInfo,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ClusterInfo:  clusterInfo,
+		SecretReader: mgr.GetAPIReader(),
+		PodReader:    mgr.GetAPIReader(),
+		UpgradeSteps: upgrade.Steps,
+		ObjectStore:  objectStore,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Konflux")
 		os.Exit(1)
@@ -354,11 +574,13 @@ func main() {
 	if err = (&buildservice.KonfluxBuildServiceReconciler{
 		Client:              mgr.GetClient(),
 		Scheme:              mgr.GetScheme(),
+		Recorder:            eventRecorder,
 		ObjectStore:         objectStore,
 		ClusterInfo:         clusterInfo,
 		TokenCreator:        tokenCreator,
 		TokenRotationEvents: buildServiceRotation,
 		SecretReader:        mgr.GetAPIReader(),
+		LiveMetadata:        liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxBuildService")
 		os.Exit(1)
@@ -366,11 +588,14 @@ func main() {
 	if err = (&integrationservice.KonfluxIntegrationServiceReconciler{
 		Client:              mgr.GetClient(),
 		Scheme:              mgr.GetScheme(),
+		Recorder:            eventRecorder,
 		ObjectStore:         objectStore,
 		ClusterInfo:         clusterInfo,
 		TokenCreator:        tokenCreator,
 		SecretReader:        mgr.GetAPIReader(),
 		TokenRotationEvents: integrationServiceRotation,
+		ApplyCache:          tracking.NewApplyCache(liveMetadata, tracking.DefaultApplyCacheMaxAge),
+		LiveMetadata:        liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxIntegrationService")
 		os.Exit(1)
@@ -378,43 +603,54 @@ func main() {
 	if err = (&releaseservice.KonfluxReleaseServiceReconciler{
 		Client:              mgr.GetClient(),
 		Scheme:              mgr.GetScheme(),
+		Recorder:            eventRecorder,
 		ObjectStore:         objectStore,
 		TokenCreator:        tokenCreator,
 		SecretReader:        mgr.GetAPIReader(),
 		TokenRotationEvents: releaseServiceRotation,
+		ApplyCache:          tracking.NewApplyCache(liveMetadata, tracking.DefaultApplyCacheMaxAge),
+		LiveMetadata:        liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxReleaseService")
 		os.Exit(1)
 	}
 	if err = (&ui.KonfluxUIReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
-		ClusterInfo: clusterInfo,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		ClusterInfo:  clusterInfo,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxUI")
 		os.Exit(1)
 	}
 	if err = (&rbac.KonfluxRBACReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxRBAC")
 		os.Exit(1)
 	}
 	if err = (&namespacelister.KonfluxNamespaceListerReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxNamespaceLister")
 		os.Exit(1)
 	}
 	if err = (&enterprisecontract.KonfluxEnterpriseContractReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxEnterpriseContract")
 		os.Exit(1)
@@ -422,73 +658,98 @@ func main() {
 	if err = (&imagecontroller.KonfluxImageControllerReconciler{
 		Client:              mgr.GetClient(),
 		Scheme:              mgr.GetScheme(),
+		Recorder:            eventRecorder,
 		ObjectStore:         objectStore,
 		ClusterInfo:         clusterInfo,
 		TokenCreator:        tokenCreator,
 		TokenRotationEvents: imageControllerRotation,
 		SecretReader:        mgr.GetAPIReader(),
+		LiveMetadata:        liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxImageController")
 		os.Exit(1)
 	}
 	if err = (&applicationapi.KonfluxApplicationAPIReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		ApplyCache:   tracking.NewApplyCache(liveMetadata, tracking.DefaultApplyCacheMaxAge),
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxApplicationAPI")
 		os.Exit(1)
 	}
 	if err = (&info.KonfluxInfoReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
-		ClusterInfo: clusterInfo,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		ClusterInfo:  clusterInfo,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxInfo")
 		os.Exit(1)
 	}
 	if err = (&certmanager.KonfluxCertManagerReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxCertManager")
 		os.Exit(1)
 	}
 	if err := (&internalregistry.KonfluxInternalRegistryReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxInternalRegistry")
 		os.Exit(1)
 	}
 	if err = (&defaulttenant.KonfluxDefaultTenantReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxDefaultTenant")
 		os.Exit(1)
 	}
 	if err = (&segmentbridge.KonfluxSegmentBridgeReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
-		ClusterInfo: clusterInfo,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		ClusterInfo:  clusterInfo,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxSegmentBridge")
 		os.Exit(1)
 	}
 	if err = (&cli.KonfluxCLIReconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		ObjectStore: objectStore,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     eventRecorder,
+		ObjectStore:  objectStore,
+		LiveMetadata: liveMetadata,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KonfluxCLI")
 		os.Exit(1)
 	}
+	// Webhooks are opt-in: they need a serving certificate, which the
+	// config/default/manager-webhook component provides and sets ENABLE_WEBHOOKS for.
+	if os.Getenv("ENABLE_WEBHOOKS") == "true" {
+		if err := setupWebhooks(mgr, clusterInfo, objectStore); err != nil {
+			setupLog.Error(err, "unable to create webhook")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
@@ -583,3 +844,26 @@ func preInstallCRDs(ctx context.Context, c client.Client, store *manifests.Objec
 	}
 	return nil
 }
+
+// newLiveMetadata returns the LiveMetadata the reconcilers read the metadata of the objects they
+// apply from. Its informers are separate from the manager's, are metadata-only and only hold
+// the objects that carry the owner label, which every applied object does.
+func newLiveMetadata(mgr ctrl.Manager) (*tracking.LiveMetadata, error) {
+	selector, err := labels.Parse(constant.KonfluxOwnerLabel)
+	if err != nil {
+		return nil, err
+	}
+	informers, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient:           mgr.GetHTTPClient(),
+		Scheme:               mgr.GetScheme(),
+		Mapper:               mgr.GetRESTMapper(),
+		DefaultLabelSelector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create live metadata cache: %w", err)
+	}
+	if err := mgr.Add(informers); err != nil {
+		return nil, fmt.Errorf("failed to add live metadata cache: %w", err)
+	}
+	return tracking.NewLiveMetadata(informers, mgr.GetAPIReader()), nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+const (
+	// defaultTargetCPUUtilizationPercentage is used when autoscaling does not set a CPU target.
+	defaultTargetCPUUtilizationPercentage int32 = 80
+)
+
+// AvailabilityCleanupGVKs lists the kinds created by ApplyAvailability. Reconcilers add them to
+// their cleanup GVKs so that a PodDisruptionBudget or HorizontalPodAutoscaler is deleted once it
+// is no longer configured.
+var AvailabilityCleanupGVKs = []schema.GroupVersionKind{
+	policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget"),
+	autoscalingv2.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler"),
+}
+
+// ApplyAvailability applies the PodDisruptionBudget and HorizontalPodAutoscaler configured for
+// deployment, both named after it. With autoscaling, the replicas are removed from deployment
+// so that the operator does not fight the HorizontalPodAutoscaler over them; the replicas the
+// operator applied before are handed off first, so that they are not reset to one. It must be
+// called with the rendered Deployment before it is applied.
+func ApplyAvailability(
+	ctx context.Context,
+	tc *tracking.Client,
+	deployment *appsv1.Deployment,
+	pdb *konfluxv1alpha1.PodDisruptionBudgetSpec,
+	autoscaling *konfluxv1alpha1.AutoscalingSpec,
+) error {
+	minReplicas := ptr.Deref(deployment.Spec.Replicas, 1)
+	if autoscaling != nil {
+		minReplicas = ptr.Deref(autoscaling.MinReplicas, 1)
+		if err := handOffReplicas(ctx, tc, deployment, minReplicas); err != nil {
+			return err
+		}
+		deployment.Spec.Replicas = nil
+		if err := tc.ApplyOwned(ctx, buildHorizontalPodAutoscaler(deployment, autoscaling)); err != nil {
+			return fmt.Errorf("failed to apply HorizontalPodAutoscaler %s/%s: %w", deployment.Namespace, deployment.Name, err)
+		}
+	}
+	if pdb != nil && minReplicas > 1 {
+		if err := tc.ApplyOwned(ctx, buildPodDisruptionBudget(deployment, pdb)); err != nil {
+			return fmt.Errorf("failed to apply PodDisruptionBudget %s/%s: %w", deployment.Namespace, deployment.Name, err)
+		}
+	}
+	return nil
+}
+
+// handOffReplicas keeps the live replicas of deployment, but at least minReplicas, once the
+// operator stops applying them, until the HorizontalPodAutoscaler scales the Deployment.
+func handOffReplicas(ctx context.Context, tc *tracking.Client, deployment *appsv1.Deployment, minReplicas int32) error {
+	live := &appsv1.Deployment{}
+	if err := tc.Get(ctx, client.ObjectKeyFromObject(deployment), live); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get Deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+	replicas := max(ptr.Deref(live.Spec.Replicas, 1), minReplicas)
+	return tc.HandOff(ctx, live, int64(replicas), "spec", "replicas")
+}
+
+func buildPodDisruptionBudget(
+	deployment *appsv1.Deployment,
+	spec *konfluxv1alpha1.PodDisruptionBudgetSpec,
+) *policyv1.PodDisruptionBudget {
+	spec = spec.DeepCopy()
+	pdb := &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyv1.SchemeGroupVersion.String(),
+			Kind:       "PodDisruptionBudget",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector:       deployment.Spec.Selector.DeepCopy(),
+			MinAvailable:   spec.MinAvailable,
+			MaxUnavailable: spec.MaxUnavailable,
+		},
+	}
+	if pdb.Spec.MinAvailable == nil && pdb.Spec.MaxUnavailable == nil {
+		pdb.Spec.MaxUnavailable = ptr.To(intstr.FromInt32(1))
+	}
+	return pdb
+}
+
+func buildHorizontalPodAutoscaler(
+	deployment *appsv1.Deployment,
+	spec *konfluxv1alpha1.AutoscalingSpec,
+) *autoscalingv2.HorizontalPodAutoscaler {
+	metrics := []autoscalingv2.MetricSpec{
+		resourceUtilizationMetric(corev1.ResourceCPU,
+			ptr.Deref(spec.TargetCPUUtilizationPercentage, defaultTargetCPUUtilizationPercentage)),
+	}
+	if spec.TargetMemoryUtilizationPercentage != nil {
+		metrics = append(metrics, resourceUtilizationMetric(corev1.ResourceMemory, *spec.TargetMemoryUtilizationPercentage))
+	}
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+			Kind:       "HorizontalPodAutoscaler",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+			},
+			MinReplicas: ptr.To(ptr.Deref(spec.MinReplicas, 1)),
+			MaxReplicas: spec.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
+
+func resourceUtilizationMetric(resource corev1.ResourceName, percentage int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: resource,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: ptr.To(percentage),
+			},
+		},
+	}
+}
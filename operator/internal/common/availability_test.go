@@ -0,0 +1,195 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+func availabilityTestDeployment(replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "proxy", Namespace: "test-namespace"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "proxy"}},
+		},
+	}
+}
+
+func TestApplyAvailability(t *testing.T) {
+	key := types.NamespacedName{Name: "proxy", Namespace: "test-namespace"}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	t.Run("creates a PodDisruptionBudget for multiple replicas", func(t *testing.T) {
+		fakeClient, tc := newTrackingClient(t, ns)
+		deployment := availabilityTestDeployment(2)
+
+		if err := ApplyAvailability(context.Background(), tc, deployment, &konfluxv1alpha1.PodDisruptionBudgetSpec{}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		pdb := &policyv1.PodDisruptionBudget{}
+		if err := fakeClient.Get(context.Background(), key, pdb); err != nil {
+			t.Fatalf("failed to get PodDisruptionBudget: %v", err)
+		}
+		if pdb.Spec.MaxUnavailable == nil || *pdb.Spec.MaxUnavailable != intstr.FromInt32(1) {
+			t.Errorf("expected maxUnavailable 1, got %v", pdb.Spec.MaxUnavailable)
+		}
+		if pdb.Spec.Selector.MatchLabels["app"] != "proxy" {
+			t.Errorf("expected the deployment selector, got %v", pdb.Spec.Selector)
+		}
+		if !tc.IsTracked(AvailabilityCleanupGVKs[0], "test-namespace", "proxy") {
+			t.Error("expected PodDisruptionBudget to be tracked")
+		}
+	})
+
+	t.Run("skips the PodDisruptionBudget for a single replica", func(t *testing.T) {
+		fakeClient, tc := newTrackingClient(t, ns)
+		minAvailable := intstr.FromInt32(1)
+
+		if err := ApplyAvailability(context.Background(), tc, availabilityTestDeployment(1),
+			&konfluxv1alpha1.PodDisruptionBudgetSpec{MinAvailable: &minAvailable}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		err := fakeClient.Get(context.Background(), key, &policyv1.PodDisruptionBudget{})
+		if !apierrors.IsNotFound(err) {
+			t.Errorf("expected no PodDisruptionBudget, got %v", err)
+		}
+	})
+
+	t.Run("autoscaling creates a HorizontalPodAutoscaler and drops the replicas", func(t *testing.T) {
+		fakeClient, tc := newTrackingClient(t, ns)
+		deployment := availabilityTestDeployment(1)
+		autoscaling := &konfluxv1alpha1.AutoscalingSpec{
+			MinReplicas:                       ptr.To[int32](2),
+			MaxReplicas:                       5,
+			TargetMemoryUtilizationPercentage: ptr.To[int32](70),
+		}
+
+		if err := ApplyAvailability(context.Background(), tc, deployment,
+			&konfluxv1alpha1.PodDisruptionBudgetSpec{}, autoscaling); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if deployment.Spec.Replicas != nil {
+			t.Errorf("expected replicas to be left to the autoscaler, got %d", *deployment.Spec.Replicas)
+		}
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+		if err := fakeClient.Get(context.Background(), key, hpa); err != nil {
+			t.Fatalf("failed to get HorizontalPodAutoscaler: %v", err)
+		}
+		if hpa.Spec.ScaleTargetRef.Kind != "Deployment" || hpa.Spec.ScaleTargetRef.Name != "proxy" {
+			t.Errorf("unexpected scale target %+v", hpa.Spec.ScaleTargetRef)
+		}
+		if *hpa.Spec.MinReplicas != 2 || hpa.Spec.MaxReplicas != 5 {
+			t.Errorf("expected 2-5 replicas, got %d-%d", *hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+		}
+		if len(hpa.Spec.Metrics) != 2 {
+			t.Fatalf("expected CPU and memory metrics, got %d", len(hpa.Spec.Metrics))
+		}
+		if cpu := hpa.Spec.Metrics[0].Resource; cpu.Name != corev1.ResourceCPU || *cpu.Target.AverageUtilization != 80 {
+			t.Errorf("expected the default CPU target of 80%%, got %+v", cpu)
+		}
+		// The autoscaler keeps at least two replicas, so the budget is created.
+		if err := fakeClient.Get(context.Background(), key, &policyv1.PodDisruptionBudget{}); err != nil {
+			t.Errorf("failed to get PodDisruptionBudget: %v", err)
+		}
+	})
+
+	t.Run("enabling autoscaling keeps the replicas the operator applied", func(t *testing.T) {
+		for _, tt := range []struct {
+			name         string
+			replicas     int32
+			wantReplicas int32
+		}{
+			{name: "above minReplicas", replicas: 3, wantReplicas: 3},
+			{name: "below minReplicas", replicas: 1, wantReplicas: 2},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx := context.Background()
+				fakeClient, tc := newManagedFieldsTrackingClient(t, ns)
+				if err := tc.ApplyOwned(ctx, availabilityTestDeployment(tt.replicas)); err != nil {
+					t.Fatalf("failed to apply Deployment: %v", err)
+				}
+
+				deployment := availabilityTestDeployment(tt.replicas)
+				autoscaling := &konfluxv1alpha1.AutoscalingSpec{MinReplicas: ptr.To[int32](2), MaxReplicas: 5}
+				if err := ApplyAvailability(ctx, tc, deployment, nil, autoscaling); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if err := tc.ApplyOwned(ctx, deployment); err != nil {
+					t.Fatalf("failed to apply Deployment: %v", err)
+				}
+
+				live := &appsv1.Deployment{}
+				if err := fakeClient.Get(ctx, key, live); err != nil {
+					t.Fatalf("failed to get Deployment: %v", err)
+				}
+				if live.Spec.Replicas == nil || *live.Spec.Replicas != tt.wantReplicas {
+					t.Errorf("expected %d replicas, got %v", tt.wantReplicas, live.Spec.Replicas)
+				}
+				if len(tc.Drifts()) != 0 {
+					t.Errorf("expected the hand-off not to be reported as drift, got %v", tc.Drifts())
+				}
+			})
+		}
+	})
+}
+
+// newManagedFieldsTrackingClient is newTrackingClient with a fake client that returns
+// managedFields, which the hand-off of replicas to the autoscaler relies on.
+func newManagedFieldsTrackingClient(t *testing.T, objs ...client.Object) (client.Client, *tracking.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithReturnManagedFields().
+		Build()
+	tc := tracking.NewClientWithOwnership(fakeClient, tracking.OwnershipConfig{
+		Owner:             &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-owner", Namespace: "test-namespace", UID: "test-owner-uid"}},
+		OwnerLabelKey:     "test.example.com/owner",
+		ComponentLabelKey: "test.example.com/component",
+		Component:         "test-component",
+		FieldManager:      "test-manager",
+		DetectDrift:       true,
+	})
+	return fakeClient, tc
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func TestForwardedLogging(t *testing.T) {
+	t.Parallel()
+
+	if got := ForwardedLogging(nil, nil); got != nil {
+		t.Fatalf("expected nil without logging settings, got %#v", got)
+	}
+
+	owner := &konfluxv1alpha1.Konflux{}
+	owner.Spec.Logging = &konfluxv1alpha1.LoggingSpec{
+		Level:   konfluxv1alpha1.LogLevelInfo,
+		Encoder: konfluxv1alpha1.LogEncoderJSON,
+	}
+
+	got := ForwardedLogging(owner, &konfluxv1alpha1.LoggingSpec{Level: konfluxv1alpha1.LogLevelDebug})
+	want := konfluxv1alpha1.LoggingSpec{Level: konfluxv1alpha1.LogLevelDebug, Encoder: konfluxv1alpha1.LogEncoderJSON}
+	if got == nil || *got != want {
+		t.Errorf("expected the override to replace the level only, got %#v", got)
+	}
+	if owner.Spec.Logging.Level != konfluxv1alpha1.LogLevelInfo {
+		t.Errorf("expected the Konflux CR to be unchanged, got %#v", owner.Spec.Logging)
+	}
+}
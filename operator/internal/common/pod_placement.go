@@ -0,0 +1,121 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"maps"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
+)
+
+// ForwardedPodPlacement returns the pod placement for a sub-CR spec: a deep copy of the
+// Konflux-wide placement in which every field set on the component override replaces the
+// Konflux-wide value.
+func ForwardedPodPlacement(
+	owner *konfluxv1alpha1.Konflux,
+	override *konfluxv1alpha1.PodPlacementSpec,
+) *konfluxv1alpha1.PodPlacementSpec {
+	var global *konfluxv1alpha1.PodPlacementSpec
+	if owner != nil {
+		global = owner.Spec.PodPlacement
+	}
+	if global == nil && override == nil {
+		return nil
+	}
+	if global == nil {
+		return override.DeepCopy()
+	}
+	placement := global.DeepCopy()
+	if override == nil {
+		return placement
+	}
+	override = override.DeepCopy()
+	if override.NodeSelector != nil {
+		placement.NodeSelector = override.NodeSelector
+	}
+	if override.Tolerations != nil {
+		placement.Tolerations = override.Tolerations
+	}
+	if override.Affinity != nil {
+		placement.Affinity = override.Affinity
+	}
+	if override.PriorityClassName != "" {
+		placement.PriorityClassName = override.PriorityClassName
+	}
+	if override.TopologySpreadConstraints != nil {
+		placement.TopologySpreadConstraints = override.TopologySpreadConstraints
+	}
+	return placement
+}
+
+// ApplyPodPlacement applies the pod placement to the pod template of a Deployment or CronJob.
+// Other objects, and a nil placement, are left unchanged.
+func ApplyPodPlacement(obj client.Object, placement *konfluxv1alpha1.PodPlacementSpec) error {
+	if placement == nil {
+		return nil
+	}
+	switch workload := obj.(type) {
+	case *appsv1.Deployment:
+		var podLabels map[string]string
+		if workload.Spec.Selector != nil {
+			podLabels = workload.Spec.Selector.MatchLabels
+		}
+		return podPlacementOverlay(placement, podLabels).ApplyToDeployment(workload)
+	case *batchv1.CronJob:
+		podLabels := workload.Spec.JobTemplate.Spec.Template.Labels
+		return podPlacementOverlay(placement, podLabels).ApplyToCronJob(workload)
+	default:
+		return nil
+	}
+}
+
+// podPlacementOverlay builds the pod overlay for a placement. Topology spread constraints
+// without a labelSelector select the workload's own pods.
+func podPlacementOverlay(placement *konfluxv1alpha1.PodPlacementSpec, podLabels map[string]string) *customization.PodOverlay {
+	var opts []customization.PodOverlayOption
+	if len(placement.NodeSelector) > 0 {
+		opts = append(opts, customization.WithNodeSelector(placement.NodeSelector))
+	}
+	if len(placement.Tolerations) > 0 {
+		opts = append(opts, customization.WithTolerations(placement.Tolerations...))
+	}
+	if placement.Affinity != nil {
+		opts = append(opts, customization.WithAffinity(placement.Affinity))
+	}
+	if placement.PriorityClassName != "" {
+		opts = append(opts, customization.WithPriorityClassName(placement.PriorityClassName))
+	}
+	if len(placement.TopologySpreadConstraints) > 0 {
+		constraints := make([]corev1.TopologySpreadConstraint, 0, len(placement.TopologySpreadConstraints))
+		for _, c := range placement.TopologySpreadConstraints {
+			c = *c.DeepCopy()
+			if c.LabelSelector == nil && len(podLabels) > 0 {
+				c.LabelSelector = &metav1.LabelSelector{MatchLabels: maps.Clone(podLabels)}
+			}
+			constraints = append(constraints, c)
+		}
+		opts = append(opts, customization.WithTopologySpreadConstraints(constraints...))
+	}
+	return customization.NewPodOverlay(opts...)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func TestForwardedPodPlacement(t *testing.T) {
+	t.Parallel()
+
+	if got := ForwardedPodPlacement(nil, nil); got != nil {
+		t.Fatalf("expected nil without placement, got %#v", got)
+	}
+
+	owner := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: "konflux"}}
+	owner.Spec.PodPlacement = &konfluxv1alpha1.PodPlacementSpec{
+		NodeSelector:      map[string]string{"node-role.kubernetes.io/infra": ""},
+		Tolerations:       []corev1.Toleration{{Key: "infra", Effect: corev1.TaintEffectNoSchedule}},
+		PriorityClassName: "konflux-critical",
+	}
+
+	got := ForwardedPodPlacement(owner, nil)
+	if got == nil || got == owner.Spec.PodPlacement {
+		t.Fatal("expected deep copy of the Konflux-wide placement")
+	}
+	got.NodeSelector["changed"] = "true"
+	if _, ok := owner.Spec.PodPlacement.NodeSelector["changed"]; ok {
+		t.Fatal("expected independent copy")
+	}
+
+	override := &konfluxv1alpha1.PodPlacementSpec{
+		Tolerations: []corev1.Toleration{{Key: "ui", Operator: corev1.TolerationOpExists}},
+	}
+	got = ForwardedPodPlacement(owner, override)
+	if len(got.Tolerations) != 1 || got.Tolerations[0].Key != "ui" {
+		t.Fatalf("expected override tolerations, got %#v", got.Tolerations)
+	}
+	if got.PriorityClassName != "konflux-critical" {
+		t.Fatalf("expected Konflux-wide priority class, got %q", got.PriorityClassName)
+	}
+	if _, ok := got.NodeSelector["node-role.kubernetes.io/infra"]; !ok {
+		t.Fatalf("expected Konflux-wide node selector, got %#v", got.NodeSelector)
+	}
+
+	got = ForwardedPodPlacement(nil, override)
+	if got == override || len(got.Tolerations) != 1 {
+		t.Fatalf("expected copy of the override, got %#v", got)
+	}
+}
+
+func TestApplyPodPlacement_Deployment(t *testing.T) {
+	t.Parallel()
+
+	deployment := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "ui"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "ui", "version": "v1"}},
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+					Tolerations:  []corev1.Toleration{{Key: "upstream"}},
+					Containers:   []corev1.Container{{Name: "ui", Image: "ui:latest"}},
+				},
+			},
+		},
+	}
+	placement := &konfluxv1alpha1.PodPlacementSpec{
+		NodeSelector:      map[string]string{"node-role.kubernetes.io/infra": ""},
+		Tolerations:       []corev1.Toleration{{Key: "infra", Effect: corev1.TaintEffectNoSchedule}},
+		PriorityClassName: "konflux-critical",
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+		}},
+	}
+
+	if err := ApplyPodPlacement(deployment, placement); err != nil {
+		t.Fatalf("ApplyPodPlacement: %v", err)
+	}
+
+	spec := deployment.Spec.Template.Spec
+	if spec.NodeSelector["kubernetes.io/os"] != "linux" || len(spec.NodeSelector) != 2 {
+		t.Fatalf("expected merged node selector, got %#v", spec.NodeSelector)
+	}
+	if len(spec.Tolerations) != 1 || spec.Tolerations[0].Key != "infra" {
+		t.Fatalf("expected tolerations to be replaced, got %#v", spec.Tolerations)
+	}
+	if spec.PriorityClassName != "konflux-critical" {
+		t.Fatalf("expected priority class, got %q", spec.PriorityClassName)
+	}
+	if len(spec.Containers) != 1 || spec.Containers[0].Image != "ui:latest" {
+		t.Fatalf("expected containers to be preserved, got %#v", spec.Containers)
+	}
+	if len(spec.TopologySpreadConstraints) != 1 {
+		t.Fatalf("expected one topology spread constraint, got %#v", spec.TopologySpreadConstraints)
+	}
+	selector := spec.TopologySpreadConstraints[0].LabelSelector
+	if selector == nil || len(selector.MatchLabels) != 1 || selector.MatchLabels["app"] != "ui" {
+		t.Fatalf("expected labelSelector from the Deployment selector, got %#v", selector)
+	}
+	if placement.TopologySpreadConstraints[0].LabelSelector != nil {
+		t.Fatal("expected placement to be left unchanged")
+	}
+}
+
+func TestApplyPodPlacement_CronJob(t *testing.T) {
+	t.Parallel()
+
+	cronJob := &batchv1.CronJob{}
+	cronJob.Spec.JobTemplate.Spec.Template = corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "segment-bridge"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "bridge"}}},
+	}
+	placement := &konfluxv1alpha1.PodPlacementSpec{
+		NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       "kubernetes.io/hostname",
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "infra"}},
+		}},
+	}
+
+	if err := ApplyPodPlacement(cronJob, placement); err != nil {
+		t.Fatalf("ApplyPodPlacement: %v", err)
+	}
+
+	spec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	if _, ok := spec.NodeSelector["node-role.kubernetes.io/infra"]; !ok {
+		t.Fatalf("expected node selector, got %#v", spec.NodeSelector)
+	}
+	selector := spec.TopologySpreadConstraints[0].LabelSelector
+	if selector == nil || selector.MatchLabels["tier"] != "infra" {
+		t.Fatalf("expected explicit labelSelector to be kept, got %#v", selector)
+	}
+}
+
+func TestApplyPodPlacement_IgnoresOtherObjects(t *testing.T) {
+	t.Parallel()
+
+	cm := &corev1.ConfigMap{}
+	placement := &konfluxv1alpha1.PodPlacementSpec{PriorityClassName: "konflux-critical"}
+	if err := ApplyPodPlacement(cm, placement); err != nil {
+		t.Fatalf("ApplyPodPlacement: %v", err)
+	}
+	if err := ApplyPodPlacement(&appsv1.Deployment{}, nil); err != nil {
+		t.Fatalf("ApplyPodPlacement with nil placement: %v", err)
+	}
+}
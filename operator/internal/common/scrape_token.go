@@ -21,17 +21,22 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
 
 var operandServiceMonitorGVK = schema.GroupVersionKind{
@@ -59,6 +64,10 @@ type ScrapeTokenReconcilerConfig struct {
 	// updates (and SM presence) are visible without waiting on the informer cache.
 	// When nil, Client is used.
 	SecretReader client.Reader
+	// Recorder emits a SecretRotated Event on Owner when an existing scrape token is replaced.
+	// No Event is emitted when either is nil.
+	Recorder events.EventRecorder
+	Owner    client.Object
 }
 
 // DeferredSMApplyResult captures operand ServiceMonitor state from deferred apply without
@@ -106,6 +115,10 @@ func ReconcilePrometheusScrapeToken(ctx context.Context, cfg ScrapeTokenReconcil
 	if err != nil {
 		return reconcile.Result{}, err
 	}
+	if tokenResult.TokenUpdated && tokenResult.SecretExisted && cfg.Owner != nil {
+		recorder.Normal(cfg.Recorder, cfg.Owner, recorder.ReasonSecretRotated, recorder.ActionRotate,
+			fmt.Sprintf("Rotated Secret %s/%s", cfg.OperandNamespace, kubernetes.ScrapeTokenSecretName))
+	}
 	if cfg.ServiceMonitorName == "" {
 		return reconcile.Result{RequeueAfter: tokenResult.RequeueAfter}, nil
 	}
@@ -384,3 +397,22 @@ func probeOperandServiceMonitor(ctx context.Context, c client.Client, smKey clie
 		return DeferredSMApplyResult{}
 	}
 }
+
+// KeepPrometheusScrapeObjects keeps the scrape token Secret and, when serviceMonitorName is set,
+// the operand ServiceMonitor of operandNamespace out of orphan cleanup without writing them.
+// Reconcilers call it instead of ReconcilePrometheusScrapeToken in plan mode, since minting a
+// token cannot be dry-run; the plan leaves both objects as they are.
+func KeepPrometheusScrapeObjects(tc *tracking.Client, operandNamespace, serviceMonitorName string) {
+	tc.Keep(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Namespace: operandNamespace,
+		Name:      kubernetes.ScrapeTokenSecretName,
+	}})
+	if serviceMonitorName == "" {
+		return
+	}
+	sm := &unstructured.Unstructured{}
+	sm.SetGroupVersionKind(operandServiceMonitorGVK)
+	sm.SetNamespace(operandNamespace)
+	sm.SetName(serviceMonitorName)
+	tc.Keep(sm)
+}
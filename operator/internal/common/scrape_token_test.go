@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -695,6 +696,7 @@ func TestReconcilePrometheusScrapeToken_TokenRefreshed(t *testing.T) {
 		token:     "new-token",
 		expiresAt: now.Add(time.Hour),
 	}
+	recorder := events.NewFakeRecorder(1)
 	result, err := ReconcilePrometheusScrapeToken(ctx, ScrapeTokenReconcilerConfig{
 		Client:             c,
 		Clock:              testclock.NewFakeClock(now),
@@ -711,6 +713,8 @@ func TestReconcilePrometheusScrapeToken_TokenRefreshed(t *testing.T) {
 			secret.SetResourceVersion(existing.ResourceVersion)
 			return c.Update(applyCtx, secret)
 		},
+		Recorder: recorder,
+		Owner:    &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner"}},
 	})
 	if err != nil {
 		t.Fatalf("refresh reconcile: %v", err)
@@ -718,6 +722,14 @@ func TestReconcilePrometheusScrapeToken_TokenRefreshed(t *testing.T) {
 	if result.RequeueAfter != kubernetes.DefaultServiceMonitorResyncSettleDelay {
 		t.Fatalf("requeue: got %v", result.RequeueAfter)
 	}
+	select {
+	case e := <-recorder.Events:
+		if !strings.HasPrefix(e, "Normal SecretRotated ") {
+			t.Fatalf("event: got %q", e)
+		}
+	default:
+		t.Fatal("expected a SecretRotated event")
+	}
 
 	updated := &unstructured.Unstructured{}
 	updated.SetGroupVersionKind(operandServiceMonitorGVK)
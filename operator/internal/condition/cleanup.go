@@ -0,0 +1,54 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+// RecordCleanup records the outcome of the orphan cleanup of cr's reconcile: it sets the
+// DeletionPending condition while orphaned resources wait for their deletion to be confirmed,
+// and removes it otherwise. Deleted and released resources are reported through Events.
+// Call it after UpdateComponentStatuses, which drops conditions other than Ready.
+func RecordCleanup(cr konfluxv1alpha1.ConditionAccessor, results []tracking.CleanupResult) {
+	var pending []string
+	for _, result := range results {
+		if result.Outcome == tracking.CleanupAwaitingConfirmation {
+			pending = append(pending, result.Key.String())
+		}
+	}
+	if len(pending) == 0 {
+		CleanupStaleConditions(cr, func(cond metav1.Condition) bool {
+			return cond.Type != TypeDeletionPending
+		})
+		return
+	}
+
+	SetCondition(cr, metav1.Condition{
+		Type:   TypeDeletionPending,
+		Status: metav1.ConditionTrue,
+		Reason: ReasonDeletionConfirmationRequired,
+		Message: fmt.Sprintf("%d orphaned resource(s) are kept until annotated with %s=true: %s",
+			len(pending), tracking.ConfirmDeletionAnnotation, strings.Join(pending, "; ")),
+	})
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+var _ = Describe("RecordCleanup", func() {
+	registry := tracking.ResourceKey{
+		GVK:  konfluxv1alpha1.GroupVersion.WithKind("KonfluxInternalRegistry"),
+		Name: "konflux-internal-registry",
+	}
+	ui := tracking.ResourceKey{
+		GVK:  konfluxv1alpha1.GroupVersion.WithKind("KonfluxUI"),
+		Name: "konflux-ui",
+	}
+
+	It("should list the resources awaiting confirmation", func() {
+		konflux := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: "konflux"}}
+		RecordCleanup(konflux, []tracking.CleanupResult{
+			{Key: registry, Policy: tracking.DeletionPolicyRequireConfirmation, Outcome: tracking.CleanupAwaitingConfirmation},
+			{Key: ui, Policy: tracking.DeletionPolicyDelete, Outcome: tracking.CleanupDeleted},
+		})
+
+		pending := apimeta.FindStatusCondition(konflux.GetConditions(), TypeDeletionPending)
+		Expect(pending).NotTo(BeNil())
+		Expect(pending.Status).To(Equal(metav1.ConditionTrue))
+		Expect(pending.Reason).To(Equal(ReasonDeletionConfirmationRequired))
+		Expect(pending.Message).To(Equal("1 orphaned resource(s) are kept until annotated with " +
+			"konflux.konflux-ci.dev/confirm-deletion=true: KonfluxInternalRegistry/konflux-internal-registry"))
+	})
+
+	It("should remove the condition once nothing awaits confirmation", func() {
+		konflux := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: "konflux"}}
+		SetCondition(konflux, metav1.Condition{Type: TypeReady, Status: metav1.ConditionTrue, Reason: ReasonAllComponentsReady})
+		RecordCleanup(konflux, []tracking.CleanupResult{
+			{Key: registry, Policy: tracking.DeletionPolicyRequireConfirmation, Outcome: tracking.CleanupAwaitingConfirmation},
+		})
+		Expect(apimeta.FindStatusCondition(konflux.GetConditions(), TypeDeletionPending)).NotTo(BeNil())
+
+		RecordCleanup(konflux, []tracking.CleanupResult{
+			{Key: registry, Policy: tracking.DeletionPolicyRequireConfirmation, Outcome: tracking.CleanupDeleted},
+		})
+		Expect(apimeta.FindStatusCondition(konflux.GetConditions(), TypeDeletionPending)).To(BeNil())
+		Expect(apimeta.FindStatusCondition(konflux.GetConditions(), TypeReady)).NotTo(BeNil())
+	})
+})
@@ -329,10 +329,11 @@ type DependencyOverride struct {
 // OverrideReadyIfDependencyFalse checks if any dependency conditions are explicitly False
 // and overrides Ready to False if so. Only checks conditions that are explicitly False,
 // not Unknown, to allow Ready to remain True when dependencies are uncertain.
+// Returns the override that was applied, or nil if Ready was left unchanged.
 func OverrideReadyIfDependencyFalse(
 	obj konfluxv1alpha1.ConditionAccessor,
 	dependencies []DependencyOverride,
-) {
+) *DependencyOverride {
 	for _, dep := range dependencies {
 		cond := apimeta.FindStatusCondition(obj.GetConditions(), dep.ConditionType)
 		if cond != nil && cond.Status == metav1.ConditionFalse {
@@ -345,7 +346,8 @@ func OverrideReadyIfDependencyFalse(
 			})
 			// Only override with the first False dependency found
 			// (in case multiple dependencies are False, use the first one's reason/message)
-			return
+			return &dep
 		}
 	}
+	return nil
 }
@@ -402,7 +402,7 @@ var _ = Describe("Conditions Helper Functions", func() {
 				Message: "cert-manager is not installed",
 			})
 
-			OverrideReadyIfDependencyFalse(testObject, []DependencyOverride{
+			override := OverrideReadyIfDependencyFalse(testObject, []DependencyOverride{
 				{
 					ConditionType: "CertManagerAvailable",
 					Reason:        ReasonCertManagerNotInstalled,
@@ -410,6 +410,8 @@ var _ = Describe("Conditions Helper Functions", func() {
 				},
 			})
 
+			Expect(override).NotTo(BeNil())
+			Expect(override.Reason).To(Equal(ReasonCertManagerNotInstalled))
 			readyCondition := apimeta.FindStatusCondition(testObject.GetConditions(), TypeReady)
 			Expect(readyCondition).NotTo(BeNil())
 			Expect(readyCondition.Status).To(Equal(metav1.ConditionFalse))
@@ -434,7 +436,7 @@ var _ = Describe("Conditions Helper Functions", func() {
 				Message: "Failed to check cert-manager availability",
 			})
 
-			OverrideReadyIfDependencyFalse(testObject, []DependencyOverride{
+			override := OverrideReadyIfDependencyFalse(testObject, []DependencyOverride{
 				{
 					ConditionType: "CertManagerAvailable",
 					Reason:        ReasonCertManagerNotInstalled,
@@ -443,6 +445,7 @@ var _ = Describe("Conditions Helper Functions", func() {
 			})
 
 			// Ready should remain True
+			Expect(override).To(BeNil())
 			readyCondition := apimeta.FindStatusCondition(testObject.GetConditions(), TypeReady)
 			Expect(readyCondition).NotTo(BeNil())
 			Expect(readyCondition.Status).To(Equal(metav1.ConditionTrue))
@@ -21,6 +21,29 @@ package condition
 const (
 	// TypeReady indicates the overall readiness of a resource.
 	TypeReady = "Ready"
+
+	// TypePaused indicates that reconciliation of a resource is paused.
+	TypePaused = "Paused"
+
+	// TypePlanned indicates that the last reconcile only computed a plan of its changes.
+	TypePlanned = "Planned"
+
+	// TypeDrifted indicates that managed resources were recently changed outside the operator.
+	TypeDrifted = "Drifted"
+
+	// TypeDeletionPending indicates that orphaned resources are kept until their deletion is confirmed.
+	TypeDeletionPending = "DeletionPending"
+
+	// TypeUpgrading indicates that the operator is moving an installation to a new version.
+	TypeUpgrading = "Upgrading"
+
+	// TypePatchesMatched indicates whether every patch of the Konflux CR targets an object
+	// of the embedded manifests.
+	TypePatchesMatched = "PatchesMatched"
+
+	// TypeImagesMirrored indicates whether every image of the enabled components is covered by
+	// spec.imageRegistryMirrors.
+	TypeImagesMirrored = "ImagesMirrored"
 )
 
 // Condition reason constants.
@@ -78,6 +101,45 @@ const (
 	// ReasonCertManagerInstallationCheckFailed indicates that checking cert-manager availability failed.
 	ReasonCertManagerInstallationCheckFailed = "CertManagerInstallationCheckFailed"
 
+	// ReasonReconciliationPaused indicates that reconciliation is paused by the paused annotation.
+	ReasonReconciliationPaused = "ReconciliationPaused"
+
+	// ReasonPlanComputed indicates that a plan was computed because of the plan annotation.
+	ReasonPlanComputed = "PlanComputed"
+
+	// ReasonDriftDetected indicates that managed resources were changed outside the operator and restored.
+	ReasonDriftDetected = "DriftDetected"
+
+	// ReasonDeletionConfirmationRequired indicates that orphaned resources with the
+	// RequireConfirmation deletion policy wait for the confirm-deletion annotation.
+	ReasonDeletionConfirmationRequired = "DeletionConfirmationRequired"
+
+	// ReasonUpgradeInProgress indicates that manifests of a new operator version are being rolled out.
+	ReasonUpgradeInProgress = "UpgradeInProgress"
+
+	// ReasonUpgradeSucceeded indicates that the last upgrade finished, including its migration steps.
+	ReasonUpgradeSucceeded = "UpgradeSucceeded"
+
+	// ReasonUpgradeStepFailed indicates that a pre- or post-upgrade migration step failed.
+	ReasonUpgradeStepFailed = "UpgradeStepFailed"
+
+	// ReasonDowngradeBlocked indicates that the operator is older than the installed version
+	// and the downgrade was not explicitly allowed.
+	ReasonDowngradeBlocked = "DowngradeBlocked"
+
 	// ReasonCertManagerInstalled indicates that cert-manager CRDs are installed.
 	ReasonCertManagerInstalled = "CertManagerInstalled"
+
+	// ReasonAllPatchesMatched indicates that every patch targets an object of the embedded manifests.
+	ReasonAllPatchesMatched = "AllPatchesMatched"
+
+	// ReasonPatchTargetNotFound indicates that a patch matches no object of the embedded manifests.
+	ReasonPatchTargetNotFound = "PatchTargetNotFound"
+
+	// ReasonAllImagesMirrored indicates that every image is rewritten to a mirror.
+	ReasonAllImagesMirrored = "AllImagesMirrored"
+
+	// ReasonImagesNotMirrored indicates that some images match no mirror and are pulled from
+	// their original registry.
+	ReasonImagesNotMirrored = "ImagesNotMirrored"
 )
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+const (
+	// driftRetention is how long a drifted resource stays in status after it was last detected.
+	driftRetention = time.Hour
+	// maxDriftedResources bounds status.driftedResources; it matches the CRD's MaxItems.
+	maxDriftedResources = 10
+)
+
+// RecordDrift records the drift the tracking client detected while applying cr's resources:
+// it counts it in the konflux_component_drifted_resources_total metric, merges it into
+// status.driftedResources and sets the Drifted condition while any drift is retained.
+// Call it after UpdateComponentStatuses, which drops conditions other than Ready.
+func RecordDrift(cr konfluxv1alpha1.DriftAccessor, drifts []tracking.Drift, component string) {
+	operatormetrics.AddComponentDrift(component, len(drifts))
+
+	resources := mergeDriftedResources(cr.GetDriftedResources(), drifts, metav1.Now())
+	cr.SetDriftedResources(resources)
+	if len(resources) == 0 {
+		CleanupStaleConditions(cr, func(cond metav1.Condition) bool {
+			return cond.Type != TypeDrifted
+		})
+		return
+	}
+
+	descriptions := make([]string, 0, len(resources))
+	for _, r := range resources {
+		descriptions = append(descriptions, describeDriftedResource(r))
+	}
+	SetCondition(cr, metav1.Condition{
+		Type:   TypeDrifted,
+		Status: metav1.ConditionTrue,
+		Reason: ReasonDriftDetected,
+		Message: fmt.Sprintf("%d resource(s) changed outside the operator in the last %s were restored: %s",
+			len(resources), driftRetention, strings.Join(descriptions, "; ")),
+	})
+}
+
+// mergeDriftedResources adds newly detected drift to the existing list, drops entries older
+// than driftRetention and keeps at most maxDriftedResources, most recently detected first.
+func mergeDriftedResources(
+	existing []konfluxv1alpha1.DriftedResource,
+	drifts []tracking.Drift,
+	now metav1.Time,
+) []konfluxv1alpha1.DriftedResource {
+	merged := make([]konfluxv1alpha1.DriftedResource, 0, len(drifts)+len(existing))
+	for _, d := range drifts {
+		merged = append(merged, konfluxv1alpha1.DriftedResource{
+			Kind:         d.Key.GVK.Kind,
+			Namespace:    d.Key.Namespace,
+			Name:         d.Key.Name,
+			Managers:     d.Managers,
+			LastDetected: now,
+		})
+	}
+	for _, r := range existing {
+		if now.Sub(r.LastDetected.Time) > driftRetention {
+			continue
+		}
+		if slices.ContainsFunc(merged, func(m konfluxv1alpha1.DriftedResource) bool {
+			return m.Kind == r.Kind && m.Namespace == r.Namespace && m.Name == r.Name
+		}) {
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	slices.SortStableFunc(merged, func(a, b konfluxv1alpha1.DriftedResource) int {
+		return b.LastDetected.Compare(a.LastDetected.Time)
+	})
+	if len(merged) > maxDriftedResources {
+		merged = merged[:maxDriftedResources]
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+func describeDriftedResource(r konfluxv1alpha1.DriftedResource) string {
+	name := r.Kind + "/" + r.Name
+	if r.Namespace != "" {
+		name = r.Kind + "/" + r.Namespace + "/" + r.Name
+	}
+	if len(r.Managers) == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s by %s", name, strings.Join(r.Managers, ", "))
+}
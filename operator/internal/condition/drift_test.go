@@ -0,0 +1,108 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	prometheustestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+var _ = Describe("Drift reporting", func() {
+	deploymentDrift := func(name string, managers ...string) tracking.Drift {
+		return tracking.Drift{
+			Key: tracking.ResourceKey{
+				GVK:       schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+				Namespace: "release-service",
+				Name:      name,
+			},
+			Managers: managers,
+		}
+	}
+
+	Describe("mergeDriftedResources", func() {
+		now := metav1.NewTime(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+		It("should put new drift first and replace older entries for the same resource", func() {
+			existing := []konfluxv1alpha1.DriftedResource{
+				{Kind: "Deployment", Namespace: "release-service", Name: "controller",
+					Managers: []string{"kubectl-edit"}, LastDetected: metav1.NewTime(now.Add(-10 * time.Minute))},
+				{Kind: "ConfigMap", Namespace: "release-service", Name: "config",
+					LastDetected: metav1.NewTime(now.Add(-20 * time.Minute))},
+			}
+
+			merged := mergeDriftedResources(existing, []tracking.Drift{deploymentDrift("controller", "kubectl-patch")}, now)
+			Expect(merged).To(HaveLen(2))
+			Expect(merged[0].Name).To(Equal("controller"))
+			Expect(merged[0].Managers).To(Equal([]string{"kubectl-patch"}))
+			Expect(merged[0].LastDetected).To(Equal(now))
+			Expect(merged[1].Name).To(Equal("config"))
+		})
+
+		It("should drop entries older than the retention period", func() {
+			existing := []konfluxv1alpha1.DriftedResource{
+				{Kind: "Deployment", Namespace: "release-service", Name: "controller",
+					LastDetected: metav1.NewTime(now.Add(-2 * time.Hour))},
+			}
+			Expect(mergeDriftedResources(existing, nil, now)).To(BeNil())
+		})
+
+		It("should keep at most maxDriftedResources entries", func() {
+			var drifts []tracking.Drift
+			for i := range maxDriftedResources + 5 {
+				drifts = append(drifts, deploymentDrift(string(rune('a'+i))))
+			}
+			Expect(mergeDriftedResources(nil, drifts, now)).To(HaveLen(maxDriftedResources))
+		})
+	})
+
+	Describe("RecordDrift", func() {
+		It("should set the Drifted condition and count the drift", func() {
+			release := &konfluxv1alpha1.KonfluxReleaseService{ObjectMeta: metav1.ObjectMeta{Name: "konflux-release-service"}}
+			before := prometheustestutil.ToFloat64(operatormetrics.ComponentDriftCounter("drift-test"))
+
+			RecordDrift(release, []tracking.Drift{deploymentDrift("controller", "kubectl-edit")}, "drift-test")
+
+			Expect(release.Status.DriftedResources).To(HaveLen(1))
+			cond := apimeta.FindStatusCondition(release.GetConditions(), TypeDrifted)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(ReasonDriftDetected))
+			Expect(cond.Message).To(ContainSubstring("Deployment/release-service/controller by kubectl-edit"))
+			Expect(prometheustestutil.ToFloat64(operatormetrics.ComponentDriftCounter("drift-test"))).To(Equal(before + 1))
+		})
+
+		It("should remove the Drifted condition once no drift is retained", func() {
+			release := &konfluxv1alpha1.KonfluxReleaseService{ObjectMeta: metav1.ObjectMeta{Name: "konflux-release-service"}}
+			SetCondition(release, metav1.Condition{Type: TypeDrifted, Status: metav1.ConditionTrue, Reason: ReasonDriftDetected})
+
+			RecordDrift(release, nil, "drift-test")
+
+			Expect(release.Status.DriftedResources).To(BeEmpty())
+			Expect(apimeta.FindStatusCondition(release.GetConditions(), TypeDrifted)).To(BeNil())
+		})
+	})
+})
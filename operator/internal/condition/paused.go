@@ -0,0 +1,95 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
+	"github.com/konflux-ci/konflux-ci/operator/internal/upgrade"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
+)
+
+// IsPaused reports whether reconciliation of obj is paused via the paused annotation.
+func IsPaused(obj client.Object) bool {
+	return obj.GetAnnotations()[constant.KonfluxPausedAnnotation] == "true"
+}
+
+// SetPausedCondition sets the Paused condition on obj.
+// Controllers that rebuild their conditions through UpdateComponentStatuses drop it again
+// on the first reconcile after the annotation is removed.
+func SetPausedCondition(obj konfluxv1alpha1.ConditionAccessor) {
+	SetCondition(obj, metav1.Condition{
+		Type:   TypePaused,
+		Status: metav1.ConditionTrue,
+		Reason: ReasonReconciliationPaused,
+		Message: fmt.Sprintf("Reconciliation is paused by the %s annotation; operands are not updated",
+			constant.KonfluxPausedAnnotation),
+	})
+}
+
+// HandlePaused records whether cr is paused in the konflux_component_paused metric and, if it is,
+// refreshes its deployment conditions and Paused condition without applying anything.
+// Callers return from Reconcile when paused is true, leaving operands exactly as they are so that
+// manual changes made during an incident are not reverted by server-side apply.
+//
+// A component is also paused while the operator refuses to downgrade the installation, so that
+// older manifests are not applied before the Konflux CR allows it.
+func HandlePaused(
+	ctx context.Context,
+	k8sClient client.Client,
+	cr konfluxv1alpha1.ConditionAccessor,
+	component string,
+) (paused bool, err error) {
+	konflux := &konfluxv1alpha1.Konflux{}
+	err = k8sClient.Get(ctx, client.ObjectKey{Name: constant.KonfluxSingletonName}, konflux)
+	if client.IgnoreNotFound(err) != nil {
+		return false, fmt.Errorf("failed to get Konflux CR: %w", err)
+	}
+	downgradeBlocked := upgrade.DowngradeBlocked(konflux, version.Version)
+
+	paused = IsPaused(cr) || downgradeBlocked
+	operatormetrics.SetComponentPaused(component, paused)
+	if !paused {
+		return false, nil
+	}
+
+	if err := UpdateComponentStatuses(ctx, k8sClient, cr); err != nil {
+		return true, err
+	}
+	if IsPaused(cr) {
+		logf.FromContext(ctx).Info("Reconciliation is paused, skipping apply",
+			"name", cr.GetName(), "annotation", constant.KonfluxPausedAnnotation)
+		SetPausedCondition(cr)
+	} else {
+		logf.FromContext(ctx).Info("Downgrade is blocked, skipping apply",
+			"name", cr.GetName(), "installedVersion", konflux.Status.InstalledVersion, "version", version.Version)
+		SetDowngradeBlockedCondition(cr, konflux.Status.InstalledVersion, version.Version)
+	}
+
+	if err := k8sClient.Status().Update(ctx, cr); err != nil {
+		return true, fmt.Errorf("failed to update status: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	prometheustestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
+)
+
+var _ = Describe("Paused reconciliation", func() {
+	const component = "paused-test"
+
+	newFakeClient := func(objs ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		Expect(konfluxv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		return fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(objs...).
+			Build()
+	}
+
+	newRBAC := func(annotations map[string]string) *konfluxv1alpha1.KonfluxRBAC {
+		return &konfluxv1alpha1.KonfluxRBAC{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "konflux-rbac",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	Describe("IsPaused", func() {
+		It("should only treat the annotation value true as paused", func() {
+			Expect(IsPaused(newRBAC(nil))).To(BeFalse())
+			Expect(IsPaused(newRBAC(map[string]string{constant.KonfluxPausedAnnotation: "false"}))).To(BeFalse())
+			Expect(IsPaused(newRBAC(map[string]string{constant.KonfluxPausedAnnotation: "true"}))).To(BeTrue())
+		})
+	})
+
+	Describe("HandlePaused", func() {
+		It("should report status and the metric for a paused CR", func() {
+			ctx := context.Background()
+			rbac := newRBAC(map[string]string{constant.KonfluxPausedAnnotation: "true"})
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rbac-controller",
+					Namespace: "konflux-rbac",
+					Labels:    map[string]string{constant.KonfluxOwnerLabel: rbac.Name},
+				},
+			}
+			k8sClient := newFakeClient(rbac, deployment)
+
+			paused, err := HandlePaused(ctx, k8sClient, rbac, component)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(paused).To(BeTrue())
+			Expect(prometheustestutil.ToFloat64(operatormetrics.ComponentPausedGauge(component))).To(Equal(float64(1)))
+
+			stored := &konfluxv1alpha1.KonfluxRBAC{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rbac), stored)).To(Succeed())
+			pausedCond := apimeta.FindStatusCondition(stored.GetConditions(), TypePaused)
+			Expect(pausedCond).NotTo(BeNil())
+			Expect(pausedCond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(pausedCond.Reason).To(Equal(ReasonReconciliationPaused))
+			Expect(apimeta.FindStatusCondition(stored.GetConditions(), "konflux-rbac/rbac-controller")).NotTo(BeNil())
+			Expect(apimeta.FindStatusCondition(stored.GetConditions(), TypeReady)).NotTo(BeNil())
+		})
+
+		It("should leave an unpaused CR to the caller", func() {
+			ctx := context.Background()
+			rbac := newRBAC(nil)
+			k8sClient := newFakeClient(rbac)
+
+			paused, err := HandlePaused(ctx, k8sClient, rbac, component)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(paused).To(BeFalse())
+			Expect(rbac.GetConditions()).To(BeEmpty())
+			Expect(prometheustestutil.ToFloat64(operatormetrics.ComponentPausedGauge(component))).To(Equal(float64(0)))
+		})
+
+		Context("when the operator is older than the installed version", func() {
+			newKonflux := func(annotations map[string]string) *konfluxv1alpha1.Konflux {
+				return &konfluxv1alpha1.Konflux{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        constant.KonfluxSingletonName,
+						Annotations: annotations,
+					},
+					Status: konfluxv1alpha1.KonfluxStatus{InstalledVersion: "v0.3.0"},
+				}
+			}
+
+			BeforeEach(func() {
+				previous := version.Version
+				version.Version = "v0.2.0"
+				DeferCleanup(func() { version.Version = previous })
+			})
+
+			It("should pause the component until the downgrade is allowed", func() {
+				ctx := context.Background()
+				rbac := newRBAC(nil)
+				k8sClient := newFakeClient(rbac, newKonflux(nil))
+
+				paused, err := HandlePaused(ctx, k8sClient, rbac, component)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(paused).To(BeTrue())
+
+				stored := &konfluxv1alpha1.KonfluxRBAC{}
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rbac), stored)).To(Succeed())
+				pausedCond := apimeta.FindStatusCondition(stored.GetConditions(), TypePaused)
+				Expect(pausedCond).NotTo(BeNil())
+				Expect(pausedCond.Reason).To(Equal(ReasonDowngradeBlocked))
+				Expect(pausedCond.Message).To(ContainSubstring("v0.3.0"))
+			})
+
+			It("should not pause the component once the downgrade is allowed", func() {
+				ctx := context.Background()
+				rbac := newRBAC(nil)
+				k8sClient := newFakeClient(rbac, newKonflux(map[string]string{
+					constant.KonfluxAllowDowngradeAnnotation: "true",
+				}))
+
+				paused, err := HandlePaused(ctx, k8sClient, rbac, component)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(paused).To(BeFalse())
+			})
+		})
+	})
+})
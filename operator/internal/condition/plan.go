@@ -0,0 +1,128 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
+)
+
+const (
+	// planFieldManager is the field manager of the plan ConfigMaps.
+	planFieldManager = "konflux-operator-plan"
+
+	// PlanConfigMapKey is the key of the plan ConfigMap that holds the rendered plan.
+	PlanConfigMapKey = "plan"
+	// PlanSummaryConfigMapKey is the key of the plan ConfigMap that holds the plan summary.
+	PlanSummaryConfigMapKey = "summary"
+	// PlanVersionConfigMapKey is the key of the plan ConfigMap that holds the version of the
+	// operator that computed the plan.
+	PlanVersionConfigMapKey = "operatorVersion"
+)
+
+// IsPlanRequested reports whether the plan annotation asks for a plan of obj instead of a reconcile.
+func IsPlanRequested(obj client.Object) bool {
+	return obj.GetAnnotations()[constant.KonfluxPlanAnnotation] == "true"
+}
+
+// PlanConfigMapName returns the name of the ConfigMap that holds the plan of the CR named name.
+// Every Konflux CR is a singleton with a distinct name, so the names do not collide.
+func PlanConfigMapName(name string) string {
+	return name + "-plan"
+}
+
+// HandlePlan publishes the plan that a reconcile of cr in plan mode computed: it writes it into
+// the plan ConfigMap in the operator namespace and sets the Planned condition of cr to the plan
+// summary. Controllers call it once their tracking client has applied and cleaned up, when
+// tracking.Client.Planning reports plan mode: nothing was applied or deleted then, so the plan
+// is published in place of the usual status update. Callers return from Reconcile afterwards.
+//
+// The rest of the status is left as it was stored, so that whatever the reconcile computed in
+// memory before it got here is not published either. Controllers that rebuild their conditions
+// through UpdateComponentStatuses drop the Planned condition again on the first reconcile after
+// the annotation is removed.
+func HandlePlan(
+	ctx context.Context,
+	k8sClient client.Client,
+	cr konfluxv1alpha1.ConditionAccessor,
+	plan tracking.Plan,
+) error {
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PlanConfigMapName(cr.GetName()),
+			Namespace: operatormetrics.OperatorNamespace,
+		},
+		Data: map[string]string{
+			PlanConfigMapKey:        plan.String(),
+			PlanSummaryConfigMapKey: plan.Summary(),
+			PlanVersionConfigMapKey: version.Version,
+		},
+	}
+	// Not a controller reference: the ConfigMap is garbage collected with cr, but cr does not
+	// manage it as an operand.
+	if err := controllerutil.SetOwnerReference(cr, configMap, k8sClient.Scheme()); err != nil {
+		return fmt.Errorf("failed to set owner reference on plan ConfigMap: %w", err)
+	}
+	if err := k8sClient.Patch(ctx, configMap, kubernetes.SSAPatch,
+		client.FieldOwner(planFieldManager), client.ForceOwnership); err != nil {
+		return fmt.Errorf("failed to apply plan ConfigMap %s: %w", configMap.Name, err)
+	}
+	logf.FromContext(ctx).Info("Computed plan, skipping apply",
+		"name", cr.GetName(), "summary", plan.Summary(), "configMap", configMap.Name)
+
+	stored, ok := cr.DeepCopyObject().(konfluxv1alpha1.ConditionAccessor)
+	if !ok {
+		return fmt.Errorf("failed to copy %s", cr.GetName())
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(cr), stored); err != nil {
+		return fmt.Errorf("failed to get %s: %w", cr.GetName(), err)
+	}
+	SetPlannedCondition(stored, plan)
+	if err := k8sClient.Status().Update(ctx, stored); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+	return nil
+}
+
+// SetPlannedCondition sets the Planned condition on obj to the summary of plan.
+func SetPlannedCondition(obj konfluxv1alpha1.ConditionAccessor, plan tracking.Plan) {
+	SetCondition(obj, metav1.Condition{
+		Type:   TypePlanned,
+		Status: metav1.ConditionTrue,
+		Reason: ReasonPlanComputed,
+		Message: fmt.Sprintf("Planned by the %s annotation, nothing was applied: %s; see ConfigMap %s/%s",
+			constant.KonfluxPlanAnnotation, plan.Summary(),
+			operatormetrics.OperatorNamespace, PlanConfigMapName(obj.GetName())),
+	})
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+var _ = Describe("Plan", func() {
+	newRBAC := func(annotations map[string]string) *konfluxv1alpha1.KonfluxRBAC {
+		return &konfluxv1alpha1.KonfluxRBAC{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "konflux-rbac",
+				UID:         "rbac-uid",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	Describe("IsPlanRequested", func() {
+		It("should only treat the annotation value true as a plan request", func() {
+			Expect(IsPlanRequested(newRBAC(nil))).To(BeFalse())
+			Expect(IsPlanRequested(newRBAC(map[string]string{constant.KonfluxPlanAnnotation: "false"}))).To(BeFalse())
+			Expect(IsPlanRequested(newRBAC(map[string]string{constant.KonfluxPlanAnnotation: "true"}))).To(BeTrue())
+		})
+	})
+
+	Describe("HandlePlan", func() {
+		It("should publish the plan without the status computed in memory", func() {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			Expect(konfluxv1alpha1.AddToScheme(scheme)).To(Succeed())
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			rbac := newRBAC(map[string]string{constant.KonfluxPlanAnnotation: "true"})
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(rbac).
+				WithStatusSubresource(rbac).
+				Build()
+
+			// A condition that the reconcile computed but must not publish in plan mode.
+			SetCondition(rbac, metav1.Condition{Type: TypeReady, Status: metav1.ConditionFalse, Reason: ReasonApplyFailed})
+			plan := tracking.Plan{{
+				Key: tracking.ResourceKey{
+					GVK:  schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+					Name: "konflux-viewer",
+				},
+				Action: tracking.ActionCreate,
+			}}
+			Expect(HandlePlan(ctx, k8sClient, rbac, plan)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{
+				Namespace: operatormetrics.OperatorNamespace,
+				Name:      "konflux-rbac-plan",
+			}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue(PlanConfigMapKey, plan.String()))
+			Expect(configMap.Data).To(HaveKeyWithValue(PlanSummaryConfigMapKey, "1 to create, 0 to update, 0 to delete, 0 unchanged"))
+			Expect(configMap.OwnerReferences).To(HaveLen(1))
+			Expect(configMap.OwnerReferences[0].UID).To(Equal(rbac.UID))
+			Expect(configMap.OwnerReferences[0].Controller).To(BeNil())
+
+			stored := &konfluxv1alpha1.KonfluxRBAC{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rbac), stored)).To(Succeed())
+			plannedCond := apimeta.FindStatusCondition(stored.GetConditions(), TypePlanned)
+			Expect(plannedCond).NotTo(BeNil())
+			Expect(plannedCond.Reason).To(Equal(ReasonPlanComputed))
+			Expect(plannedCond.Message).To(ContainSubstring("1 to create"))
+			Expect(plannedCond.Message).To(ContainSubstring("konflux-operator/konflux-rbac-plan"))
+			Expect(apimeta.FindStatusCondition(stored.GetConditions(), TypeReady)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,43 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+// RecordManifestProvenance records on cr the provenance of the manifests its reconcile applied.
+func RecordManifestProvenance(cr konfluxv1alpha1.ManifestProvenanceAccessor, provenance manifests.Provenance) {
+	status := &konfluxv1alpha1.ManifestProvenance{
+		KustomizePath: provenance.KustomizePath,
+		ContentHash:   provenance.ContentHash,
+	}
+	for _, upstream := range provenance.Upstreams {
+		status.Upstreams = append(status.Upstreams, konfluxv1alpha1.ManifestUpstream{
+			Repository: upstream.Repository,
+			Path:       upstream.Path,
+			Revision:   upstream.Revision,
+		})
+	}
+	if !provenance.BuildTime.IsZero() {
+		status.BuildTime = &metav1.Time{Time: provenance.BuildTime}
+	}
+	cr.SetManifestProvenance(status)
+}
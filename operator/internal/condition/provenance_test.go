@@ -0,0 +1,59 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+var _ = Describe("RecordManifestProvenance", func() {
+	It("should record the provenance of embedded manifests", func() {
+		buildTime := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		cli := &konfluxv1alpha1.KonfluxCLI{ObjectMeta: metav1.ObjectMeta{Name: "konflux-cli"}}
+		RecordManifestProvenance(cli, manifests.Provenance{
+			KustomizePath: "operator/upstream-kustomizations/cli",
+			Upstreams: []manifests.Upstream{
+				{Repository: "konflux-ci/cli", Path: "config/default", Revision: "abc"},
+			},
+			BuildTime:   buildTime,
+			ContentHash: "sha256:0123",
+		})
+
+		Expect(cli.Status.ManifestProvenance).To(Equal(&konfluxv1alpha1.ManifestProvenance{
+			KustomizePath: "operator/upstream-kustomizations/cli",
+			Upstreams: []konfluxv1alpha1.ManifestUpstream{
+				{Repository: "konflux-ci/cli", Path: "config/default", Revision: "abc"},
+			},
+			BuildTime:   &metav1.Time{Time: buildTime},
+			ContentHash: "sha256:0123",
+		}))
+	})
+
+	It("should only record the content hash of manifests loaded from a source", func() {
+		cli := &konfluxv1alpha1.KonfluxCLI{ObjectMeta: metav1.ObjectMeta{Name: "konflux-cli"}}
+		RecordManifestProvenance(cli, manifests.Provenance{ContentHash: "sha256:0123"})
+
+		Expect(cli.Status.ManifestProvenance).To(Equal(&konfluxv1alpha1.ManifestProvenance{ContentHash: "sha256:0123"}))
+	})
+})
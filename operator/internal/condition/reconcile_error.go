@@ -21,10 +21,12 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 )
 
 // ReconcileErrorHandler handles error reporting for reconcilers.
@@ -33,21 +35,25 @@ import (
 type ReconcileErrorHandler struct {
 	log          logr.Logger
 	statusClient client.StatusWriter
+	recorder     events.EventRecorder
 	cr           konfluxv1alpha1.ConditionAccessor
 	crKind       string
 }
 
 // NewReconcileErrorHandler creates a new ReconcileErrorHandler for a specific CR.
 // crKind is used in error messages to identify which CR type failed (e.g., "KonfluxBuildService").
+// Failures are also reported as Warning Events on the CR through recorder, which may be nil.
 func NewReconcileErrorHandler(
 	log logr.Logger,
 	statusClient client.StatusWriter,
+	recorder events.EventRecorder,
 	cr konfluxv1alpha1.ConditionAccessor,
 	crKind string,
 ) *ReconcileErrorHandler {
 	return &ReconcileErrorHandler{
 		log:          log,
 		statusClient: statusClient,
+		recorder:     recorder,
 		cr:           cr,
 		crKind:       crKind,
 	}
@@ -63,6 +69,7 @@ func (h *ReconcileErrorHandler) Handle(
 	operation string,
 ) (ctrl.Result, error) {
 	h.log.Error(err, fmt.Sprintf("Failed to %s", operation))
+	recorder.Warning(h.recorder, h.cr, reason, recorder.ActionReconcile, fmt.Sprintf("Failed to %s: %v", operation, err))
 
 	// Set the failed condition with a descriptive message
 	SetFailedCondition(h.cr, TypeReady, reason, fmt.Errorf("%s: %w", operation, err))
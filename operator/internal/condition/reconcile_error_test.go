@@ -26,6 +26,7 @@ import (
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
@@ -61,6 +62,7 @@ var _ = Describe("ReconcileErrorHandler", func() {
 		ctx        context.Context
 		testObject *konfluxv1alpha1.KonfluxRBAC
 		mockStatus *mockStatusWriter
+		recorder   *events.FakeRecorder
 		handler    *ReconcileErrorHandler
 		testErr    error
 	)
@@ -78,9 +80,11 @@ var _ = Describe("ReconcileErrorHandler", func() {
 		}
 
 		mockStatus = &mockStatusWriter{}
+		recorder = events.NewFakeRecorder(10)
 		handler = NewReconcileErrorHandler(
 			logr.Discard(), // Use a discarding logger for tests
 			mockStatus,
+			recorder,
 			testObject,
 			"KonfluxRBAC",
 		)
@@ -116,6 +120,21 @@ var _ = Describe("ReconcileErrorHandler", func() {
 			Expect(mockStatus.lastObject).To(Equal(testObject))
 		})
 
+		It("should emit a warning event on the CR", func() {
+			_, _ = handler.Handle(ctx, testErr, "TestReason", "test operation")
+
+			Expect(recorder.Events).To(Receive(Equal("Warning TestReason Failed to test operation: test error")))
+		})
+
+		It("should not fail without an event recorder", func() {
+			handler = NewReconcileErrorHandler(logr.Discard(), mockStatus, nil, testObject, "KonfluxRBAC")
+
+			_, returnedErr := handler.Handle(ctx, testErr, "TestReason", "test operation")
+
+			Expect(returnedErr).To(Equal(testErr))
+			Expect(mockStatus.updateCalled).To(BeTrue())
+		})
+
 		It("should return empty result and original error", func() {
 			result, returnedErr := handler.Handle(ctx, testErr, "TestReason", "test operation")
 
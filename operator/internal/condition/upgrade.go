@@ -0,0 +1,51 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
+)
+
+// RecordOperatorVersion records on cr that the running operator has applied its manifests.
+// The Konflux controller finishes an upgrade only once every component reports the new version.
+func RecordOperatorVersion(cr konfluxv1alpha1.OperatorVersionAccessor) {
+	cr.SetOperatorVersion(version.Version)
+}
+
+// SetDowngradeBlockedCondition sets the Paused condition on obj for an operator that is older
+// than the installed Konflux version.
+func SetDowngradeBlockedCondition(obj konfluxv1alpha1.ConditionAccessor, installed, running string) {
+	SetCondition(obj, metav1.Condition{
+		Type:    TypePaused,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonDowngradeBlocked,
+		Message: DowngradeBlockedMessage(installed, running),
+	})
+}
+
+// DowngradeBlockedMessage explains why an older operator does not apply its manifests.
+func DowngradeBlockedMessage(installed, running string) string {
+	return fmt.Sprintf("Operator version %s is older than the installed version %s; "+
+		"set the %s annotation on the Konflux CR to \"true\" to downgrade",
+		running, installed, constant.KonfluxAllowDowngradeAnnotation)
+}
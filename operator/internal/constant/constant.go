@@ -22,6 +22,18 @@ const (
 	KonfluxOwnerLabel = "konflux.konflux-ci.dev/owner"
 	// KonfluxComponentLabel is the label used to identify which component a resource belongs to.
 	KonfluxComponentLabel = "konflux.konflux-ci.dev/component"
+	// KonfluxPausedAnnotation pauses reconciliation of the annotated Konflux CR or sub-CR when set to "true".
+	// Status is still reported while paused, but nothing is applied or cleaned up.
+	KonfluxPausedAnnotation = "konflux.konflux-ci.dev/paused"
+	// KonfluxPlanAnnotation makes the reconcile of the annotated Konflux CR or sub-CR compute a
+	// plan of its changes with server-side dry runs instead of applying them when set to "true".
+	KonfluxPlanAnnotation = "konflux.konflux-ci.dev/plan"
+	// KonfluxAllowDowngradeAnnotation lets the operator roll out its manifests over a Konflux
+	// installation recorded with a newer version when set to "true" on the Konflux CR.
+	KonfluxAllowDowngradeAnnotation = "konflux.konflux-ci.dev/allow-downgrade"
+	// KonfluxOperatorVersionAnnotation records on each component CR the version of the operator
+	// that applied it, so that a new operator version triggers every component reconciler.
+	KonfluxOperatorVersionAnnotation = "konflux.konflux-ci.dev/operator-version"
 	// KonfluxSingletonName is the required name of the cluster Konflux CR.
 	KonfluxSingletonName = "konflux"
 	// ConditionTypeReady is the condition type for overall readiness
@@ -23,6 +23,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -32,6 +33,8 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	crdhandler "github.com/konflux-ci/konflux-ci/operator/internal/controller/handler"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
@@ -54,7 +57,12 @@ var ApplicationAPICleanupGVKs = []schema.GroupVersionKind{}
 type KonfluxApplicationAPIReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
+	// ApplyCache, if set, skips applying manifests that are unchanged since the last reconcile.
+	ApplyCache *tracking.ApplyCache
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxapplicationapis,verbs=get;list;watch;create;update;patch;delete
@@ -78,9 +86,14 @@ func (r *KonfluxApplicationAPIReconciler) Reconcile(ctx context.Context, req ctr
 	}
 
 	log.Info("Reconciling KonfluxApplicationAPI", "name", applicationAPI.Name)
+	previousReady := recorder.ReadyCondition(applicationAPI)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, applicationAPI, string(manifests.ApplicationAPI)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), applicationAPI, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, applicationAPI, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -89,10 +102,15 @@ func (r *KonfluxApplicationAPIReconciler) Reconcile(ctx context.Context, req ctr
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.ApplicationAPI),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(applicationAPI),
+		Recorder:          r.Recorder,
+		ApplyCache:        r.ApplyCache,
 	})
 
 	// Apply all embedded manifests
-	if err := r.applyManifests(ctx, tc); err != nil {
+	if err := r.applyManifests(ctx, tc, applicationAPI); err != nil {
 		return errHandler.HandleApplyError(ctx, err)
 	}
 
@@ -101,10 +119,19 @@ func (r *KonfluxApplicationAPIReconciler) Reconcile(ctx context.Context, req ctr
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, applicationAPI, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxApplicationAPI status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, applicationAPI); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(applicationAPI, tc.Drifts(), string(manifests.ApplicationAPI))
+	condition.RecordCleanup(applicationAPI, tc.CleanupResults())
+	condition.RecordOperatorVersion(applicationAPI)
+	condition.RecordManifestProvenance(applicationAPI, r.ObjectStore.Provenance(manifests.ApplicationAPI))
+	recorder.RecordReadyTransition(r.Recorder, applicationAPI, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, applicationAPI); err != nil {
@@ -118,18 +145,27 @@ func (r *KonfluxApplicationAPIReconciler) Reconcile(ctx context.Context, req ctr
 
 // applyManifests loads and applies all embedded manifests to the cluster using the tracking client.
 // Manifests are parsed once and cached; deep copies are used during reconciliation.
-func (r *KonfluxApplicationAPIReconciler) applyManifests(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxApplicationAPIReconciler) applyManifests(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.KonfluxApplicationAPI) error {
 	objects, err := r.ObjectStore.GetForComponent(manifests.ApplicationAPI)
 	if err != nil {
 		return fmt.Errorf("failed to get parsed manifests for ApplicationAPI: %w", err)
 	}
 
+	toApply := make([]client.Object, 0, len(objects))
 	for _, obj := range objects {
-		// Apply with ownership using the tracking client
-		if err := tc.ApplyOwned(ctx, obj); err != nil {
-			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
-				obj.GetNamespace(), obj.GetName(), tracking.GetKind(obj), manifests.ApplicationAPI, err)
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
 		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
+		toApply = append(toApply, obj)
+	}
+
+	// Apply with ownership using the tracking client, several objects at a time.
+	if err := tc.ApplyOwnedBatch(ctx, toApply); err != nil {
+		return fmt.Errorf("%w from %s", err, manifests.ApplicationAPI)
 	}
 	return nil
 }
@@ -20,17 +20,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	securityv1 "github.com/openshift/api/security/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,6 +48,7 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/hashedconfigmap"
@@ -94,8 +98,9 @@ const (
 
 // BuildServiceCleanupGVKs defines which resource types should be cleaned up when they are
 // no longer part of the desired state. Metrics scrape resources may be skipped during apply
-// (componentMetrics disabled) or removed across releases while metrics stay enabled.
-var BuildServiceCleanupGVKs = append([]schema.GroupVersionKind(nil), kubernetes.ComponentMetricsOrphanCleanupGVKs...)
+// (componentMetrics disabled) or removed across releases while metrics stay enabled, and the
+// PodDisruptionBudget is only applied while it is configured.
+var BuildServiceCleanupGVKs = slices.Concat(kubernetes.ComponentMetricsOrphanCleanupGVKs, common.AvailabilityCleanupGVKs)
 
 // BuildServiceClusterScopedAllowList restricts which cluster-scoped resources can be deleted
 // during orphan cleanup. Only metrics scrape ClusterRoles and ClusterRoleBindings are listed;
@@ -113,6 +118,7 @@ var BuildServiceClusterScopedAllowList = tracking.ClusterScopedAllowList{
 type KonfluxBuildServiceReconciler struct {
 	client.Client
 	Scheme              *runtime.Scheme
+	Recorder            events.EventRecorder
 	ObjectStore         *manifests.ObjectStore
 	ClusterInfo         *clusterinfo.Info
 	TokenCreator        kubernetes.TokenCreator
@@ -120,12 +126,15 @@ type KonfluxBuildServiceReconciler struct {
 	TokenRotationEvents <-chan event.TypedGenericEvent[client.Object]
 	// SecretReader loads metrics TLS Secrets; prefer mgr.GetAPIReader() to avoid stale cache.
 	SecretReader client.Reader
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxbuildservices,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxbuildservices/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxbuildservices/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services;serviceaccounts,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;patch;delete
@@ -162,9 +171,14 @@ func (r *KonfluxBuildServiceReconciler) Reconcile(ctx context.Context, req ctrl.
 	}
 
 	log.Info("Reconciling KonfluxBuildService", "name", buildService.Name)
+	previousReady := recorder.ReadyCondition(buildService)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, buildService, string(manifests.BuildService)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), buildService, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, buildService, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	// Resources applied through this client are automatically tracked and owned.
@@ -174,6 +188,10 @@ func (r *KonfluxBuildServiceReconciler) Reconcile(ctx context.Context, req ctrl.
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.BuildService),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(buildService),
+		Recorder:          r.Recorder,
 	})
 
 	// Ensure the build-service namespace exists before creating ConfigMaps in it.
@@ -189,7 +207,7 @@ func (r *KonfluxBuildServiceReconciler) Reconcile(ctx context.Context, req ctrl.
 
 	// Reconcile webhook config ConfigMap.
 	// Must happen before applyManifests so the hashed ConfigMap name is available for the volume reference.
-	webhookConfigMapName, err := r.reconcileWebhookConfig(ctx, buildService)
+	webhookConfigMapName, err := r.reconcileWebhookConfig(ctx, tc, buildService)
 	if err != nil {
 		return errHandler.HandleWithReason(ctx, err, condition.ReasonConfigMapFailed, "reconcile webhook config")
 	}
@@ -200,12 +218,17 @@ func (r *KonfluxBuildServiceReconciler) Reconcile(ctx context.Context, req ctrl.
 	}
 
 	scrapeResult := reconcile.Result{}
-	if buildService.Spec.ComponentMetrics.IsEnabled() && r.TokenCreator != nil {
+	scrapeMetrics := buildService.Spec.ComponentMetrics.IsEnabled() && r.TokenCreator != nil
+	if scrapeMetrics && tc.Planning() {
+		common.KeepPrometheusScrapeObjects(tc, webhookConfigNamespace, "build-service")
+	} else if scrapeMetrics {
 		// Deferred ServiceMonitor apply: mint scrape token, wait for metrics TLS, apply SM.
 		scraper := kubernetes.OperandMetricsScraperSA(webhookConfigNamespace)
 		var scrapeErr error
 		scrapeResult, scrapeErr = common.ReconcilePrometheusScrapeToken(ctx, common.ScrapeTokenReconcilerConfig{
 			Client:             r.Client,
+			Recorder:           r.Recorder,
+			Owner:              buildService,
 			SecretReader:       r.SecretReader,
 			Clock:              r.Clock,
 			TokenCreator:       r.TokenCreator,
@@ -243,10 +266,19 @@ func (r *KonfluxBuildServiceReconciler) Reconcile(ctx context.Context, req ctrl.
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, buildService, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxBuildService status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, buildService); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(buildService, tc.Drifts(), string(manifests.BuildService))
+	condition.RecordCleanup(buildService, tc.CleanupResults())
+	condition.RecordOperatorVersion(buildService)
+	condition.RecordManifestProvenance(buildService, r.ObjectStore.Provenance(manifests.BuildService))
+	recorder.RecordReadyTransition(r.Recorder, buildService, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, buildService); err != nil {
@@ -301,7 +333,7 @@ func (r *KonfluxBuildServiceReconciler) applyManifests(ctx context.Context, tc *
 
 		// Apply pipeline config merge logic to the build-pipeline-config ConfigMap
 		if configMap, ok := obj.(*corev1.ConfigMap); ok {
-			if configMap.Name == "build-pipeline-config" {
+			if configMap.Name == PipelineConfigMapName {
 				if err := applyPipelineConfigMerge(logf.FromContext(ctx), configMap, owner.Spec.PipelineConfig); err != nil {
 					return fmt.Errorf("failed to merge pipeline config: %w", err)
 				}
@@ -319,10 +351,26 @@ func (r *KonfluxBuildServiceReconciler) applyManifests(ctx context.Context, tc *
 			}
 		}
 
+		if err := common.ApplyPodPlacement(obj, owner.Spec.PodPlacement); err != nil {
+			return fmt.Errorf("failed to apply pod placement to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		if err := common.ApplyMetricsScraperBindingSubjects(webhookConfigNamespace, obj); err != nil {
 			return fmt.Errorf("apply metrics scraper binding subjects for %s: %w", obj.GetName(), err)
 		}
 
+		if deployment, ok := obj.(*appsv1.Deployment); ok && deployment.Name == buildControllerManagerDeploymentName {
+			if err := common.ApplyAvailability(ctx, tc, deployment, owner.Spec.BuildControllerManager.GetPodDisruptionBudget(), nil); err != nil {
+				return err
+			}
+		}
+
 		// Apply with ownership using the tracking client
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
@@ -338,7 +386,7 @@ func applyBuildServiceDeploymentCustomizations(deployment *appsv1.Deployment, sp
 	switch deployment.Name {
 	case buildControllerManagerDeploymentName:
 		if spec.BuildControllerManager != nil {
-			deployment.Spec.Replicas = &spec.BuildControllerManager.Replicas
+			deployment.Spec.Replicas = ptr.To(spec.BuildControllerManager.GetReplicas())
 		}
 		if err := buildBuildControllerManagerOverlay(spec, clusterInfo, webhookConfigMapName).ApplyToDeployment(deployment); err != nil {
 			return err
@@ -386,16 +434,13 @@ func buildBuildControllerManagerOverlay(spec konfluxv1alpha1.KonfluxBuildService
 		podOpts = append(podOpts, customization.WithConfigMapVolumeUpdate(webhookConfigVolName, webhookConfigMapName))
 	}
 
-	if spec.LogEncoder != "" {
-		podOpts = append(podOpts, customization.WithArgReplace(
-			buildManagerContainerName, konfluxv1alpha1.ZapEncoderArg+"="+string(spec.LogEncoder)))
-	}
+	podOpts = append(podOpts, customization.WithZapLogging(buildManagerContainerName, spec.GetLogging()))
 
 	var deployCtx customization.DeploymentContext
 	var managerSpec *konfluxv1alpha1.ContainerSpec
 	if spec.BuildControllerManager != nil {
 		managerSpec = spec.BuildControllerManager.Manager
-		deployCtx = customization.DeploymentContext{Replicas: spec.BuildControllerManager.Replicas}
+		deployCtx = customization.DeploymentContext{Replicas: spec.BuildControllerManager.GetReplicas()}
 	}
 
 	containerOpts = append(containerOpts,
@@ -421,7 +466,7 @@ func buildBuildControllerManagerOverlay(spec konfluxv1alpha1.KonfluxBuildService
 // The ConfigMap is always created: with the webhookURLs mapping when configured,
 // or with an empty JSON object when not configured. This guarantees the
 // -webhook-config-path flag (baked into the manifest) always points to a valid file.
-func (r *KonfluxBuildServiceReconciler) reconcileWebhookConfig(ctx context.Context, owner *konfluxv1alpha1.KonfluxBuildService) (string, error) {
+func (r *KonfluxBuildServiceReconciler) reconcileWebhookConfig(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.KonfluxBuildService) (string, error) {
 	log := logf.FromContext(ctx)
 
 	data := owner.Spec.WebhookURLs
@@ -435,7 +480,7 @@ func (r *KonfluxBuildServiceReconciler) reconcileWebhookConfig(ctx context.Conte
 	}
 
 	hcm := hashedconfigmap.New(
-		r.Client,
+		tc.Writer(r.Client),
 		r.Scheme,
 		webhookConfigBaseName,
 		webhookConfigNamespace,
@@ -461,6 +506,7 @@ func (r *KonfluxBuildServiceReconciler) SetupWithManager(mgr ctrl.Manager) error
 		// Use predicates to filter out unnecessary updates and prevent reconcile loops
 		// Deployments: watch spec changes AND readiness status changes
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(predicate.DeploymentReadinessPredicate)).
+		Owns(&policyv1.PodDisruptionBudget{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.ServiceAccount{}).
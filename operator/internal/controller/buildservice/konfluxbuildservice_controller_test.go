@@ -1506,6 +1506,35 @@ var _ = Describe("KonfluxBuildService Controller", func() {
 			Expect(k8sClient.Create(ctx, bs)).To(Succeed())
 			DeferCleanup(testutil.DeleteAndWait, k8sClient, bs)
 		})
+
+		It("Should reject manager args that set a flag managed by the operator", func(ctx context.Context) {
+			bs := &konfluxv1alpha1.KonfluxBuildService{
+				ObjectMeta: metav1.ObjectMeta{Name: CRName},
+				Spec: konfluxv1alpha1.NewKonfluxBuildServiceSpec(konfluxv1alpha1.KonfluxBuildServiceConfigSpec{
+					BuildControllerManager: &konfluxv1alpha1.ControllerManagerDeploymentSpec{
+						Replicas: 1,
+						Manager: &konfluxv1alpha1.ContainerSpec{
+							Args: []string{"--lease-duration=60s", "--metrics-bind-address=:9090"},
+						},
+					},
+				}, testutil.DefaultComponentMetricsConfig()),
+			}
+			err := k8sClient.Create(ctx, bs)
+			Expect(err).To(HaveOccurred(), "args overriding --metrics-bind-address should be rejected")
+			Expect(err.Error()).To(ContainSubstring("args must not set --metrics-bind-address"))
+		})
+
+		It("Should reject an egress proxy that is not an http or https URL", func(ctx context.Context) {
+			bs := &konfluxv1alpha1.KonfluxBuildService{
+				ObjectMeta: metav1.ObjectMeta{Name: CRName},
+				Spec: konfluxv1alpha1.NewKonfluxBuildServiceSpec(konfluxv1alpha1.KonfluxBuildServiceConfigSpec{},
+					testutil.DefaultComponentMetricsConfig()),
+			}
+			bs.Spec.EgressProxy = &konfluxv1alpha1.EgressProxySpec{HTTPSProxy: "socks5://proxy.example.com:1080"}
+			err := k8sClient.Create(ctx, bs)
+			Expect(err).To(HaveOccurred(), "a socks5 proxy URL should be rejected")
+			Expect(err.Error()).To(ContainSubstring("httpsProxy must be an http or https URL"))
+		})
 	})
 })
 
@@ -236,6 +236,31 @@ func TestBuildBuildControllerManagerOverlay(t *testing.T) {
 		g.Expect(managerContainer.Resources.Limits.Cpu().String()).To(gomega.Equal("500m"))
 	})
 
+	t.Run("logging takes precedence over logEncoder and sets zap-log-level", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		spec := konfluxv1alpha1.KonfluxBuildServiceConfigSpec{
+			LogEncoder: konfluxv1alpha1.LogEncoderJSON,
+			Logging: &konfluxv1alpha1.LoggingSpec{
+				Level:   konfluxv1alpha1.LogLevelDebug,
+				Encoder: konfluxv1alpha1.LogEncoderConsole,
+			},
+		}
+
+		deployment := getBuildServiceDeployment(t)
+		overlay := buildBuildControllerManagerOverlay(spec, nil, "")
+		err := overlay.ApplyToDeployment(deployment)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		managerContainer := testutil.FindContainer(deployment.Spec.Template.Spec.Containers, buildManagerContainerName)
+		g.Expect(managerContainer).NotTo(gomega.BeNil())
+		g.Expect(managerContainer.Args).To(gomega.ContainElements(
+			konfluxv1alpha1.ZapLogLevelArg+"=debug",
+			konfluxv1alpha1.ZapEncoderArg+"="+string(konfluxv1alpha1.LogEncoderConsole),
+		))
+		g.Expect(managerContainer.Args).NotTo(gomega.ContainElement(
+			konfluxv1alpha1.ZapEncoderArg + "=" + string(konfluxv1alpha1.LogEncoderJSON)))
+	})
+
 	t.Run("logEncoder replaces existing base zap-encoder arg", func(t *testing.T) {
 		g := gomega.NewWithT(t)
 		spec := konfluxv1alpha1.KonfluxBuildServiceConfigSpec{
@@ -24,8 +24,52 @@ import (
 	sigyaml "sigs.k8s.io/yaml"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 )
 
+// PipelineConfigMapName is the name of the ConfigMap holding the build pipeline configuration.
+const PipelineConfigMapName = "build-pipeline-config"
+
+// ValidatePipelineConfig merges pipelineConfig onto the embedded build-pipeline-config
+// defaults and returns an error if the effective default pipeline is no longer in the
+// merged list. The reconciler tolerates this case by promoting the first remaining
+// pipeline; the admission webhook uses this check to reject it up front instead.
+func ValidatePipelineConfig(store *manifests.ObjectStore, pipelineConfig *konfluxv1alpha1.PipelineConfigSpec) error {
+	if pipelineConfig == nil {
+		return nil
+	}
+
+	objects, err := store.GetForComponent(manifests.BuildService)
+	if err != nil {
+		return fmt.Errorf("failed to get parsed manifests for BuildService: %w", err)
+	}
+	for _, obj := range objects {
+		if configMap, ok := obj.(*corev1.ConfigMap); ok && configMap.Name == PipelineConfigMapName {
+			return validatePipelineConfigMerge(configMap, pipelineConfig)
+		}
+	}
+	return fmt.Errorf("%s ConfigMap not found in BuildService manifests", PipelineConfigMapName)
+}
+
+// validatePipelineConfigMerge performs the merge without mutating configMap and
+// reports a removed default pipeline as an error.
+func validatePipelineConfigMerge(configMap *corev1.ConfigMap, pipelineConfig *konfluxv1alpha1.PipelineConfigSpec) error {
+	cfg, err := mergePipelineConfig(configMap, pipelineConfig)
+	if err != nil {
+		return err
+	}
+	if cfg.DefaultPipelineName == "" || len(cfg.Pipelines) == 0 {
+		return nil
+	}
+	for _, p := range cfg.Pipelines {
+		if p.Name == cfg.DefaultPipelineName {
+			return nil
+		}
+	}
+	return fmt.Errorf("default pipeline %q is not in the merged pipeline list; "+
+		"set pipelineConfig.defaultPipelineName to one of the remaining pipelines", cfg.DefaultPipelineName)
+}
+
 // applyPipelineConfigMerge merges user-specified pipeline configuration into the
 // build-pipeline-config ConfigMap. When pipelineConfig is nil, the defaults are
 // used unchanged. If the effective default pipeline is missing from the merged
@@ -36,14 +80,38 @@ func applyPipelineConfigMerge(log logr.Logger, configMap *corev1.ConfigMap, pipe
 		return nil
 	}
 
+	cfg, err := mergePipelineConfig(configMap, pipelineConfig)
+	if err != nil {
+		return err
+	}
+
+	// If the effective default pipeline is no longer in the merged list,
+	// auto-select the first available pipeline. Most invalid configurations
+	// are caught by CRD CEL rules and the admission webhook, but this handles
+	// the case where the user removes the operator-provided default without
+	// setting a replacement and the webhook is not installed.
+	ensureDefaultPipeline(log, cfg)
+
+	out, err := sigyaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to serialize merged config.yaml: %w", err)
+	}
+
+	configMap.Data["config.yaml"] = string(out)
+	return nil
+}
+
+// mergePipelineConfig parses config.yaml from the build-pipeline-config ConfigMap and
+// merges the user-specified pipelines and default pipeline name into it.
+func mergePipelineConfig(configMap *corev1.ConfigMap, pipelineConfig *konfluxv1alpha1.PipelineConfigSpec) (*konfluxv1alpha1.PipelineConfigData, error) {
 	configData, ok := configMap.Data["config.yaml"]
 	if !ok {
-		return fmt.Errorf("build-pipeline-config ConfigMap missing config.yaml key")
+		return nil, fmt.Errorf("build-pipeline-config ConfigMap missing config.yaml key")
 	}
 
 	var cfg konfluxv1alpha1.PipelineConfigData
 	if err := sigyaml.Unmarshal([]byte(configData), &cfg); err != nil {
-		return fmt.Errorf("failed to parse config.yaml: %w", err)
+		return nil, fmt.Errorf("failed to parse config.yaml: %w", err)
 	}
 
 	cfg.Pipelines = mergePipelines(cfg.Pipelines, pipelineConfig)
@@ -51,20 +119,7 @@ func applyPipelineConfigMerge(log logr.Logger, configMap *corev1.ConfigMap, pipe
 	if pipelineConfig.DefaultPipelineName != "" {
 		cfg.DefaultPipelineName = pipelineConfig.DefaultPipelineName
 	}
-
-	// If the effective default pipeline is no longer in the merged list,
-	// auto-select the first available pipeline. Most invalid configurations
-	// are caught by CRD CEL rules, but this handles the case where the user
-	// removes the operator-provided default without setting a replacement.
-	ensureDefaultPipeline(log, &cfg)
-
-	out, err := sigyaml.Marshal(&cfg)
-	if err != nil {
-		return fmt.Errorf("failed to serialize merged config.yaml: %w", err)
-	}
-
-	configMap.Data["config.yaml"] = string(out)
-	return nil
+	return &cfg, nil
 }
 
 // mergePipelines applies the merge logic:
@@ -419,3 +419,45 @@ func TestEnsureDefaultPipeline(t *testing.T) {
 		g.Expect(cfg.DefaultPipelineName).To(gomega.Equal("some-pipeline"))
 	})
 }
+
+func TestValidatePipelineConfigMerge(t *testing.T) {
+	t.Run("accepts overrides that keep the default pipeline", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		err := validatePipelineConfigMerge(makeConfigMap(), &konfluxv1alpha1.PipelineConfigSpec{
+			Pipelines: []konfluxv1alpha1.PipelineSpec{
+				{Name: "fbc-builder", Removed: true},
+			},
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	t.Run("rejects removing the operator default without a replacement", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		err := validatePipelineConfigMerge(makeConfigMap(), &konfluxv1alpha1.PipelineConfigSpec{
+			Pipelines: []konfluxv1alpha1.PipelineSpec{
+				{Name: "docker-build-oci-ta", Removed: true},
+			},
+		})
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(err.Error()).To(gomega.ContainSubstring(`default pipeline "docker-build-oci-ta"`))
+	})
+
+	t.Run("accepts removing the operator default with a replacement", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		err := validatePipelineConfigMerge(makeConfigMap(), &konfluxv1alpha1.PipelineConfigSpec{
+			DefaultPipelineName: "fbc-builder",
+			Pipelines: []konfluxv1alpha1.PipelineSpec{
+				{Name: "docker-build-oci-ta", Removed: true},
+			},
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	t.Run("does not mutate the ConfigMap", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		cm := makeConfigMap()
+		err := validatePipelineConfigMerge(cm, &konfluxv1alpha1.PipelineConfigSpec{RemoveDefaults: true})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(cm.Data["config.yaml"]).To(gomega.Equal(defaultConfigYAML))
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +34,8 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
@@ -82,7 +85,10 @@ var CertManagerClusterScopedAllowList = tracking.ClusterScopedAllowList{
 type KonfluxCertManagerReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxcertmanagers,verbs=get;list;watch;create;update;patch;delete
@@ -103,9 +109,14 @@ func (r *KonfluxCertManagerReconciler) Reconcile(ctx context.Context, req ctrl.R
 	}
 
 	log.Info("Reconciling KonfluxCertManager", "name", certManager.Name)
+	previousReady := recorder.ReadyCondition(certManager)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, certManager, string(manifests.CertManager)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), certManager, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, certManager, crKind)
 
 	// Create a tracking client for this reconcile with ownership config.
 	// Resources applied through this client are automatically tracked and have ownership set.
@@ -116,13 +127,17 @@ func (r *KonfluxCertManagerReconciler) Reconcile(ctx context.Context, req ctrl.R
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.CertManager),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(certManager),
+		Recorder:          r.Recorder,
 	})
 
 	// Apply manifests only if createClusterIssuer is enabled (defaults to true).
 	// The cert-manager namespace must already exist (created by whoever installs cert-manager);
 	// if it does not, applyManifests will fail and the error is reported via the status.
 	if certManager.Spec.ShouldCreateClusterIssuer() {
-		if err := r.applyManifests(ctx, tc); err != nil {
+		if err := r.applyManifests(ctx, tc, certManager); err != nil {
 			return errHandler.HandleApplyError(ctx, err)
 		}
 	} else {
@@ -137,11 +152,20 @@ func (r *KonfluxCertManagerReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, certManager, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxCertManager status
 	// Note: cert-manager has no deployments, so this will set Ready=true with appropriate message
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, certManager); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(certManager, tc.Drifts(), string(manifests.CertManager))
+	condition.RecordCleanup(certManager, tc.CleanupResults())
+	condition.RecordOperatorVersion(certManager)
+	condition.RecordManifestProvenance(certManager, r.ObjectStore.Provenance(manifests.CertManager))
+	recorder.RecordReadyTransition(r.Recorder, certManager, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, certManager); err != nil {
@@ -155,13 +179,20 @@ func (r *KonfluxCertManagerReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 // applyManifests loads and applies all embedded manifests to the cluster using the tracking client.
 // Manifests are parsed once and cached; deep copies are used during reconciliation.
-func (r *KonfluxCertManagerReconciler) applyManifests(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxCertManagerReconciler) applyManifests(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.KonfluxCertManager) error {
 	objects, err := r.ObjectStore.GetForComponent(manifests.CertManager)
 	if err != nil {
 		return fmt.Errorf("failed to get parsed manifests for CertManager: %w", err)
 	}
 
 	for _, obj := range objects {
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
 				obj.GetNamespace(), obj.GetName(), tracking.GetKind(obj), manifests.CertManager, err)
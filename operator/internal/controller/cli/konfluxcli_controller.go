@@ -24,6 +24,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +34,8 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
@@ -55,7 +58,10 @@ var CLICleanupGVKs = []schema.GroupVersionKind{}
 type KonfluxCLIReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxclis,verbs=get;list;watch;create;update;patch;delete
@@ -77,8 +83,13 @@ func (r *KonfluxCLIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	log.Info("Reconciling KonfluxCLI", "name", konfluxCLI.Name)
+	previousReady := recorder.ReadyCondition(konfluxCLI)
 
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), konfluxCLI, crKind)
+	if paused, err := condition.HandlePaused(ctx, r.Client, konfluxCLI, string(manifests.CLI)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
+
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, konfluxCLI, crKind)
 
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
 		Owner:             konfluxCLI,
@@ -86,9 +97,13 @@ func (r *KonfluxCLIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.CLI),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(konfluxCLI),
+		Recorder:          r.Recorder,
 	})
 
-	if err := r.applyManifests(ctx, tc); err != nil {
+	if err := r.applyManifests(ctx, tc, konfluxCLI); err != nil {
 		return errHandler.HandleApplyError(ctx, err)
 	}
 
@@ -96,9 +111,18 @@ func (r *KonfluxCLIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, konfluxCLI, tc.Plan())
+	}
+
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, konfluxCLI); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(konfluxCLI, tc.Drifts(), string(manifests.CLI))
+	condition.RecordCleanup(konfluxCLI, tc.CleanupResults())
+	condition.RecordOperatorVersion(konfluxCLI)
+	condition.RecordManifestProvenance(konfluxCLI, r.ObjectStore.Provenance(manifests.CLI))
+	recorder.RecordReadyTransition(r.Recorder, konfluxCLI, previousReady)
 
 	if err := r.Status().Update(ctx, konfluxCLI); err != nil {
 		log.Error(err, "Failed to update status")
@@ -109,13 +133,20 @@ func (r *KonfluxCLIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{}, nil
 }
 
-func (r *KonfluxCLIReconciler) applyManifests(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxCLIReconciler) applyManifests(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.KonfluxCLI) error {
 	objects, err := r.ObjectStore.GetForComponent(manifests.CLI)
 	if err != nil {
 		return fmt.Errorf("failed to get manifests for CLI: %w", err)
 	}
 
 	for _, obj := range objects {
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
 				obj.GetNamespace(), obj.GetName(), tracking.GetKind(obj), manifests.CLI, err)
@@ -26,6 +26,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +39,8 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/internalregistry"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
@@ -73,7 +76,10 @@ var DefaultTenantClusterScopedAllowList tracking.ClusterScopedAllowList = nil
 type KonfluxDefaultTenantReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxdefaulttenants,verbs=get;list;watch;create;update;patch;delete
@@ -101,9 +107,14 @@ func (r *KonfluxDefaultTenantReconciler) Reconcile(ctx context.Context, req ctrl
 	}
 
 	log.Info("Reconciling KonfluxDefaultTenant", "name", defaultTenant.Name)
+	previousReady := recorder.ReadyCondition(defaultTenant)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, defaultTenant, string(manifests.DefaultTenant)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), defaultTenant, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, defaultTenant, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -112,10 +123,14 @@ func (r *KonfluxDefaultTenantReconciler) Reconcile(ctx context.Context, req ctrl
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.DefaultTenant),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(defaultTenant),
+		Recorder:          r.Recorder,
 	})
 
 	// Apply all embedded manifests
-	if err := r.applyManifests(ctx, tc); err != nil {
+	if err := r.applyManifests(ctx, tc, defaultTenant); err != nil {
 		return errHandler.HandleApplyError(ctx, err)
 	}
 
@@ -131,11 +146,20 @@ func (r *KonfluxDefaultTenantReconciler) Reconcile(ctx context.Context, req ctrl
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, defaultTenant, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxDefaultTenant status
 	// Note: default-tenant has no deployments, so this will set Ready=true with appropriate message
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, defaultTenant); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(defaultTenant, tc.Drifts(), string(manifests.DefaultTenant))
+	condition.RecordCleanup(defaultTenant, tc.CleanupResults())
+	condition.RecordOperatorVersion(defaultTenant)
+	condition.RecordManifestProvenance(defaultTenant, r.ObjectStore.Provenance(manifests.DefaultTenant))
+	recorder.RecordReadyTransition(r.Recorder, defaultTenant, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, defaultTenant); err != nil {
@@ -149,7 +173,7 @@ func (r *KonfluxDefaultTenantReconciler) Reconcile(ctx context.Context, req ctrl
 
 // applyManifests loads and applies all embedded manifests to the cluster using the tracking client.
 // Manifests are parsed once and cached; deep copies are used during reconciliation.
-func (r *KonfluxDefaultTenantReconciler) applyManifests(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxDefaultTenantReconciler) applyManifests(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.KonfluxDefaultTenant) error {
 	log := logf.FromContext(ctx)
 
 	objects, err := r.ObjectStore.GetForComponent(manifests.DefaultTenant)
@@ -158,6 +182,13 @@ func (r *KonfluxDefaultTenantReconciler) applyManifests(ctx context.Context, tc
 	}
 
 	for _, obj := range objects {
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		// Apply with ownership using the tracking client
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,6 +38,8 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	crdhandler "github.com/konflux-ci/konflux-ci/operator/internal/controller/handler"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
@@ -67,7 +70,10 @@ var EnterpriseContractClusterScopedAllowList tracking.ClusterScopedAllowList = n
 type KonfluxEnterpriseContractReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxenterprisecontracts,verbs=get;list;watch;create;update;patch;delete
@@ -95,9 +101,14 @@ func (r *KonfluxEnterpriseContractReconciler) Reconcile(ctx context.Context, req
 	}
 
 	log.Info("Reconciling KonfluxEnterpriseContract", "name", konfluxEnterpriseContract.Name)
+	previousReady := recorder.ReadyCondition(konfluxEnterpriseContract)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, konfluxEnterpriseContract, string(manifests.EnterpriseContract)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), konfluxEnterpriseContract, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, konfluxEnterpriseContract, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -106,6 +117,10 @@ func (r *KonfluxEnterpriseContractReconciler) Reconcile(ctx context.Context, req
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.EnterpriseContract),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(konfluxEnterpriseContract),
+		Recorder:          r.Recorder,
 	})
 
 	// Apply embedded manifests (policies are skipped when spec.skipPolicies is true)
@@ -119,10 +134,19 @@ func (r *KonfluxEnterpriseContractReconciler) Reconcile(ctx context.Context, req
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, konfluxEnterpriseContract, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxEnterpriseContract status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, konfluxEnterpriseContract); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(konfluxEnterpriseContract, tc.Drifts(), string(manifests.EnterpriseContract))
+	condition.RecordCleanup(konfluxEnterpriseContract, tc.CleanupResults())
+	condition.RecordOperatorVersion(konfluxEnterpriseContract)
+	condition.RecordManifestProvenance(konfluxEnterpriseContract, r.ObjectStore.Provenance(manifests.EnterpriseContract))
+	recorder.RecordReadyTransition(r.Recorder, konfluxEnterpriseContract, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, konfluxEnterpriseContract); err != nil {
@@ -154,6 +178,13 @@ func (r *KonfluxEnterpriseContractReconciler) applyManifests(ctx context.Context
 			continue
 		}
 
+		if err := customization.ApplyObjectPatches(obj, spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, spec.ImagePull)
+		customization.ApplyProxy(obj, spec.EgressProxy)
+
 		// Apply with ownership using the tracking client
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
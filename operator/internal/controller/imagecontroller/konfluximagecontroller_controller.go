@@ -20,18 +20,21 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,6 +50,7 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	crdhandler "github.com/konflux-ci/konflux-ci/operator/internal/controller/handler"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
@@ -80,8 +84,9 @@ const (
 
 // ImageControllerCleanupGVKs defines which resource types should be cleaned up when they are
 // no longer part of the desired state. Metrics scrape resources may be skipped during apply
-// (componentMetrics disabled) or removed across releases while metrics stay enabled.
-var ImageControllerCleanupGVKs = append([]schema.GroupVersionKind(nil), kubernetes.ComponentMetricsOrphanCleanupGVKs...)
+// (componentMetrics disabled) or removed across releases while metrics stay enabled, and the
+// PodDisruptionBudget is only applied while it is configured.
+var ImageControllerCleanupGVKs = slices.Concat(kubernetes.ComponentMetricsOrphanCleanupGVKs, common.AvailabilityCleanupGVKs)
 
 // ImageControllerClusterScopedAllowList restricts which cluster-scoped resources can be deleted
 // during orphan cleanup. Only metrics scrape ClusterRoles and ClusterRoleBindings are listed;
@@ -99,6 +104,7 @@ var ImageControllerClusterScopedAllowList = tracking.ClusterScopedAllowList{
 type KonfluxImageControllerReconciler struct {
 	client.Client
 	Scheme              *runtime.Scheme
+	Recorder            events.EventRecorder
 	ObjectStore         *manifests.ObjectStore
 	ClusterInfo         *clusterinfo.Info
 	TokenCreator        kubernetes.TokenCreator
@@ -106,12 +112,15 @@ type KonfluxImageControllerReconciler struct {
 	TokenRotationEvents <-chan event.TypedGenericEvent[client.Object]
 	// SecretReader loads metrics TLS Secrets; prefer mgr.GetAPIReader() to avoid stale cache.
 	SecretReader client.Reader
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluximagecontrollers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluximagecontrollers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluximagecontrollers/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services;serviceaccounts,verbs=get;list;watch;create;patch;delete
@@ -143,9 +152,14 @@ func (r *KonfluxImageControllerReconciler) Reconcile(ctx context.Context, req ct
 	}
 
 	log.Info("Reconciling KonfluxImageController", "name", imageController.Name)
+	previousReady := recorder.ReadyCondition(imageController)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, imageController, string(manifests.ImageController)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), imageController, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, imageController, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -154,6 +168,10 @@ func (r *KonfluxImageControllerReconciler) Reconcile(ctx context.Context, req ct
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.ImageController),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(imageController),
+		Recorder:          r.Recorder,
 	})
 
 	// Apply all embedded manifests
@@ -162,12 +180,17 @@ func (r *KonfluxImageControllerReconciler) Reconcile(ctx context.Context, req ct
 	}
 
 	scrapeResult := reconcile.Result{}
-	if imageController.Spec.ComponentMetrics.IsEnabled() && r.TokenCreator != nil {
+	scrapeMetrics := imageController.Spec.ComponentMetrics.IsEnabled() && r.TokenCreator != nil
+	if scrapeMetrics && tc.Planning() {
+		common.KeepPrometheusScrapeObjects(tc, imageControllerNamespace, "image-controller")
+	} else if scrapeMetrics {
 		// Deferred ServiceMonitor apply: mint scrape token, wait for metrics TLS, apply SM.
 		scraper := kubernetes.OperandMetricsScraperSA(imageControllerNamespace)
 		var scrapeErr error
 		scrapeResult, scrapeErr = common.ReconcilePrometheusScrapeToken(ctx, common.ScrapeTokenReconcilerConfig{
 			Client:             r.Client,
+			Recorder:           r.Recorder,
+			Owner:              imageController,
 			SecretReader:       r.SecretReader,
 			Clock:              r.Clock,
 			TokenCreator:       r.TokenCreator,
@@ -204,10 +227,19 @@ func (r *KonfluxImageControllerReconciler) Reconcile(ctx context.Context, req ct
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, imageController, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxImageController status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, imageController); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(imageController, tc.Drifts(), string(manifests.ImageController))
+	condition.RecordCleanup(imageController, tc.CleanupResults())
+	condition.RecordOperatorVersion(imageController)
+	condition.RecordManifestProvenance(imageController, r.ObjectStore.Provenance(manifests.ImageController))
+	recorder.RecordReadyTransition(r.Recorder, imageController, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, imageController); err != nil {
@@ -272,10 +304,26 @@ func (r *KonfluxImageControllerReconciler) applyManifests(ctx context.Context, t
 			}
 		}
 
+		if err := common.ApplyPodPlacement(obj, owner.Spec.PodPlacement); err != nil {
+			return fmt.Errorf("failed to apply pod placement to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		if err := common.ApplyMetricsScraperBindingSubjects(imageControllerNamespace, obj); err != nil {
 			return fmt.Errorf("apply metrics scraper binding subjects for %s: %w", obj.GetName(), err)
 		}
 
+		if deployment, ok := obj.(*appsv1.Deployment); ok && deployment.Name == controllerManagerDeploymentName {
+			if err := common.ApplyAvailability(ctx, tc, deployment, owner.Spec.ImageControllerManager.GetPodDisruptionBudget(), nil); err != nil {
+				return err
+			}
+		}
+
 		// Apply with ownership using the tracking client
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
@@ -290,7 +338,7 @@ func applyImageControllerDeploymentCustomizations(deployment *appsv1.Deployment,
 	switch deployment.Name {
 	case controllerManagerDeploymentName:
 		if spec.ImageControllerManager != nil {
-			deployment.Spec.Replicas = &spec.ImageControllerManager.Replicas
+			deployment.Spec.Replicas = ptr.To(spec.ImageControllerManager.GetReplicas())
 		}
 		overlay, err := buildImageControllerManagerOverlay(spec)
 		if err != nil {
@@ -333,17 +381,14 @@ func buildImageControllerManagerOverlay(spec konfluxv1alpha1.KonfluxImageControl
 	var managerSpec *konfluxv1alpha1.ContainerSpec
 	if spec.ImageControllerManager != nil {
 		managerSpec = spec.ImageControllerManager.Manager
-		deployCtx = customization.DeploymentContext{Replicas: spec.ImageControllerManager.Replicas}
+		deployCtx = customization.DeploymentContext{Replicas: spec.ImageControllerManager.GetReplicas()}
 	}
 
 	containerOpts = append(containerOpts,
 		customization.FromContainerSpec(managerSpec),
 	)
 
-	if spec.LogEncoder != "" {
-		podOpts = append(podOpts, customization.WithArgReplace(
-			managerContainerName, konfluxv1alpha1.ZapEncoderArg+"="+string(spec.LogEncoder)))
-	}
+	podOpts = append(podOpts, customization.WithZapLogging(managerContainerName, spec.GetLogging()))
 
 	podOpts = append(podOpts,
 		customization.WithContainerOpts(managerContainerName, deployCtx, containerOpts...),
@@ -374,6 +419,7 @@ func (r *KonfluxImageControllerReconciler) SetupWithManager(mgr ctrl.Manager) er
 		// Use predicates to filter out unnecessary updates and prevent reconcile loops
 		// Deployments: watch spec changes AND readiness status changes
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(predicate.DeploymentReadinessPredicate)).
+		Owns(&policyv1.PodDisruptionBudget{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
 		Owns(&batchv1.CronJob{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
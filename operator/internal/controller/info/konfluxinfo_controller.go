@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,7 +47,9 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/ingress"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
@@ -136,12 +139,15 @@ var InfoClusterScopedAllowList tracking.ClusterScopedAllowList = nil
 type KonfluxInfoReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
 	// DiscoverClusterConfig is an optional discoverer for cluster configuration values.
 	// If nil, a defaultClusterConfigDiscoverer will be used (returns empty values).
 	// This field allows injecting a custom discovery implementation for testing.
 	DiscoverClusterConfig ClusterConfigDiscoverer
 	ClusterInfo           *clusterinfo.Info
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxinfoes,verbs=get;list;watch;create;update;patch;delete
@@ -170,9 +176,14 @@ func (r *KonfluxInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	log.Info("Reconciling KonfluxInfo", "name", konfluxInfo.Name)
+	previousReady := recorder.ReadyCondition(konfluxInfo)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, konfluxInfo, string(manifests.Info)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), konfluxInfo, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, konfluxInfo, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -181,6 +192,10 @@ func (r *KonfluxInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.Info),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(konfluxInfo),
+		Recorder:          r.Recorder,
 	})
 
 	// Ensure konflux-info namespace exists
@@ -203,7 +218,7 @@ func (r *KonfluxInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// Apply all embedded manifests
-	if err := r.applyManifests(ctx, tc); err != nil {
+	if err := r.applyManifests(ctx, tc, konfluxInfo); err != nil {
 		return errHandler.HandleApplyError(ctx, err)
 	}
 
@@ -213,11 +228,20 @@ func (r *KonfluxInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, konfluxInfo, tc.Plan())
+	}
+
 	// Update component status (sets Ready condition based on owned resources)
 	// Note: konflux-info has no deployments, so this will set Ready=true
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, konfluxInfo); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(konfluxInfo, tc.Drifts(), string(manifests.Info))
+	condition.RecordCleanup(konfluxInfo, tc.CleanupResults())
+	condition.RecordOperatorVersion(konfluxInfo)
+	condition.RecordManifestProvenance(konfluxInfo, r.ObjectStore.Provenance(manifests.Info))
+	recorder.RecordReadyTransition(r.Recorder, konfluxInfo, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, konfluxInfo); err != nil {
@@ -230,13 +254,20 @@ func (r *KonfluxInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 }
 
 // applyManifests loads and applies all embedded manifests to the cluster using the tracking client.
-func (r *KonfluxInfoReconciler) applyManifests(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxInfoReconciler) applyManifests(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.KonfluxInfo) error {
 	objects, err := r.ObjectStore.GetForComponent(manifests.Info)
 	if err != nil {
 		return fmt.Errorf("failed to get manifests for Info: %w", err)
 	}
 
 	for _, obj := range objects {
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		// Apply with ownership using the tracking client
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
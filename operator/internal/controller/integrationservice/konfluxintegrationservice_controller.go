@@ -19,6 +19,7 @@ package integrationservice
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -26,13 +27,15 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,6 +52,7 @@ import (
 	crdhandler "github.com/konflux-ci/konflux-ci/operator/internal/controller/handler"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/ui"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
@@ -91,8 +95,9 @@ const (
 
 // IntegrationServiceCleanupGVKs defines which resource types should be cleaned up when they are
 // no longer part of the desired state. Metrics scrape resources may be skipped during apply
-// (componentMetrics disabled) or removed across releases while metrics stay enabled.
-var IntegrationServiceCleanupGVKs = append([]schema.GroupVersionKind(nil), kubernetes.ComponentMetricsOrphanCleanupGVKs...)
+// (componentMetrics disabled) or removed across releases while metrics stay enabled, and the
+// PodDisruptionBudget is only applied while it is configured.
+var IntegrationServiceCleanupGVKs = slices.Concat(kubernetes.ComponentMetricsOrphanCleanupGVKs, common.AvailabilityCleanupGVKs)
 
 // IntegrationServiceClusterScopedAllowList restricts which cluster-scoped resources can be deleted
 // during orphan cleanup. Only metrics scrape ClusterRoles and ClusterRoleBindings are listed;
@@ -110,6 +115,7 @@ var IntegrationServiceClusterScopedAllowList = tracking.ClusterScopedAllowList{
 type KonfluxIntegrationServiceReconciler struct {
 	client.Client
 	Scheme       *runtime.Scheme
+	Recorder     events.EventRecorder
 	ObjectStore  *manifests.ObjectStore
 	ClusterInfo  *clusterinfo.Info
 	TokenCreator kubernetes.TokenCreator
@@ -117,6 +123,10 @@ type KonfluxIntegrationServiceReconciler struct {
 	SecretReader        client.Reader
 	Clock               clock.Clock
 	TokenRotationEvents <-chan event.TypedGenericEvent[client.Object]
+	// ApplyCache, if set, skips applying manifests that are unchanged since the last reconcile.
+	ApplyCache *tracking.ApplyCache
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxintegrationservices,verbs=get;list;watch;create;update;patch;delete
@@ -124,6 +134,7 @@ type KonfluxIntegrationServiceReconciler struct {
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxintegrationservices/finalizers,verbs=update
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxuis,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services;secrets;serviceaccounts,verbs=get;list;watch;create;patch;delete
@@ -155,9 +166,14 @@ func (r *KonfluxIntegrationServiceReconciler) Reconcile(ctx context.Context, req
 	}
 
 	log.Info("Reconciling KonfluxIntegrationService", "name", integrationService.Name)
+	previousReady := recorder.ReadyCondition(integrationService)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, integrationService, string(manifests.Integration)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), integrationService, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, integrationService, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -166,6 +182,11 @@ func (r *KonfluxIntegrationServiceReconciler) Reconcile(ctx context.Context, req
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.Integration),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(integrationService),
+		Recorder:          r.Recorder,
+		ApplyCache:        r.ApplyCache,
 	})
 
 	// Fetch KonfluxUI to get console URL
@@ -198,12 +219,17 @@ func (r *KonfluxIntegrationServiceReconciler) Reconcile(ctx context.Context, req
 	}
 
 	scrapeResult := reconcile.Result{}
-	if integrationService.Spec.ComponentMetrics.IsEnabled() && r.TokenCreator != nil {
+	scrapeMetrics := integrationService.Spec.ComponentMetrics.IsEnabled() && r.TokenCreator != nil
+	if scrapeMetrics && tc.Planning() {
+		common.KeepPrometheusScrapeObjects(tc, integrationServiceNamespace, "integration-service")
+	} else if scrapeMetrics {
 		// Deferred ServiceMonitor apply: mint scrape token, wait for metrics TLS, apply SM.
 		scraper := kubernetes.OperandMetricsScraperSA(integrationServiceNamespace)
 		var scrapeErr error
 		scrapeResult, scrapeErr = common.ReconcilePrometheusScrapeToken(ctx, common.ScrapeTokenReconcilerConfig{
 			Client:             r.Client,
+			Recorder:           r.Recorder,
+			Owner:              integrationService,
 			SecretReader:       r.SecretReader,
 			Clock:              r.Clock,
 			TokenCreator:       r.TokenCreator,
@@ -240,10 +266,19 @@ func (r *KonfluxIntegrationServiceReconciler) Reconcile(ctx context.Context, req
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, integrationService, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxIntegrationService status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, integrationService); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(integrationService, tc.Drifts(), string(manifests.Integration))
+	condition.RecordCleanup(integrationService, tc.CleanupResults())
+	condition.RecordOperatorVersion(integrationService)
+	condition.RecordManifestProvenance(integrationService, r.ObjectStore.Provenance(manifests.Integration))
+	recorder.RecordReadyTransition(r.Recorder, integrationService, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, integrationService); err != nil {
@@ -268,6 +303,7 @@ func (r *KonfluxIntegrationServiceReconciler) applyManifests(ctx context.Context
 		return fmt.Errorf("failed to get parsed manifests for Integration: %w", err)
 	}
 
+	toApply := make([]client.Object, 0, len(objects))
 	for _, obj := range objects {
 		// Deferred ServiceMonitor apply: skip operand SM until ReconcilePrometheusScrapeToken
 		// applies it after prometheus-scrape-token and metrics TLS are ready.
@@ -302,15 +338,32 @@ func (r *KonfluxIntegrationServiceReconciler) applyManifests(ctx context.Context
 			}
 		}
 
+		if err := common.ApplyPodPlacement(obj, owner.Spec.PodPlacement); err != nil {
+			return fmt.Errorf("failed to apply pod placement to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		if err := common.ApplyMetricsScraperBindingSubjects(integrationServiceNamespace, obj); err != nil {
 			return fmt.Errorf("apply metrics scraper binding subjects for %s: %w", obj.GetName(), err)
 		}
 
-		// Apply with ownership using the tracking client
-		if err := tc.ApplyOwned(ctx, obj); err != nil {
-			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
-				obj.GetNamespace(), obj.GetName(), tracking.GetKind(obj), manifests.Integration, err)
+		if deployment, ok := obj.(*appsv1.Deployment); ok && deployment.Name == controllerManagerDeploymentName {
+			if err := common.ApplyAvailability(ctx, tc, deployment, owner.Spec.IntegrationControllerManager.GetPodDisruptionBudget(), nil); err != nil {
+				return err
+			}
 		}
+
+		toApply = append(toApply, obj)
+	}
+
+	// Apply with ownership using the tracking client, several objects at a time.
+	if err := tc.ApplyOwnedBatch(ctx, toApply); err != nil {
+		return fmt.Errorf("%w from %s", err, manifests.Integration)
 	}
 	return nil
 }
@@ -320,7 +373,7 @@ func applyIntegrationServiceDeploymentCustomizations(deployment *appsv1.Deployme
 	switch deployment.Name {
 	case controllerManagerDeploymentName:
 		if spec.IntegrationControllerManager != nil {
-			deployment.Spec.Replicas = &spec.IntegrationControllerManager.Replicas
+			deployment.Spec.Replicas = ptr.To(spec.IntegrationControllerManager.GetReplicas())
 		}
 		if err := buildControllerManagerOverlay(spec.IntegrationControllerManager, consoleURL, spec).ApplyToDeployment(deployment); err != nil {
 			return err
@@ -342,10 +395,9 @@ func buildControllerManagerOverlay(spec *konfluxv1alpha1.ControllerManagerDeploy
 		consoleURLTasklogTemplate = consoleURLTemplate + "/logs/{{ .TaskName }}"
 	}
 
-	replicas := int32(1)
+	replicas := spec.GetReplicas()
 	var managerSpec *konfluxv1alpha1.ContainerSpec
 	if spec != nil {
-		replicas = spec.Replicas
 		managerSpec = spec.Manager
 	}
 
@@ -360,6 +412,7 @@ func buildControllerManagerOverlay(spec *konfluxv1alpha1.ControllerManagerDeploy
 			customization.WithOptionalEnvOverride(envFinallyTimeout, integrationSpec.FinallyTimeout),
 		),
 		customization.WithLeaderElection(managerContainerName, replicas),
+		customization.WithZapLogging(managerContainerName, integrationSpec.Logging),
 	)
 }
 
@@ -417,6 +470,7 @@ func (r *KonfluxIntegrationServiceReconciler) SetupWithManager(mgr ctrl.Manager)
 		// Use predicates to filter out unnecessary updates and prevent reconcile loops
 		// Deployments: watch spec changes AND readiness status changes
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(predicate.DeploymentReadinessPredicate)).
+		Owns(&policyv1.PodDisruptionBudget{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
 		Owns(&batchv1.CronJob{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
@@ -29,15 +29,19 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/common"
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
@@ -78,7 +82,10 @@ var InternalRegistryClusterScopedAllowList tracking.ClusterScopedAllowList = nil
 type KonfluxInternalRegistryReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxinternalregistries,verbs=get;list;watch;create;update;patch;delete
@@ -104,9 +111,14 @@ func (r *KonfluxInternalRegistryReconciler) Reconcile(ctx context.Context, req c
 	}
 
 	log.Info("Reconciling KonfluxInternalRegistry", "name", registry.Name)
+	previousReady := recorder.ReadyCondition(registry)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, registry, string(manifests.Registry)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), registry, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, registry, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	// Resources applied through this client are automatically tracked and owned.
@@ -117,14 +129,18 @@ func (r *KonfluxInternalRegistryReconciler) Reconcile(ctx context.Context, req c
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.Registry),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(registry),
+		Recorder:          r.Recorder,
 	})
 
 	// Apply manifests (if CR exists, it's enabled)
-	if err := r.applyManifests(ctx, tc); err != nil {
+	if err := r.applyManifests(ctx, tc, registry); err != nil {
 		return errHandler.HandleApplyError(ctx, err)
 	}
 
-	if err := r.ensureRegistryCredentials(ctx, tc); err != nil {
+	if err := r.ensureRegistryCredentials(ctx, tc, registry); err != nil {
 		return errHandler.HandleApplyError(ctx, err)
 	}
 
@@ -136,10 +152,19 @@ func (r *KonfluxInternalRegistryReconciler) Reconcile(ctx context.Context, req c
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, registry, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxInternalRegistry status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, registry); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(registry, tc.Drifts(), string(manifests.Registry))
+	condition.RecordCleanup(registry, tc.CleanupResults())
+	condition.RecordOperatorVersion(registry)
+	condition.RecordManifestProvenance(registry, r.ObjectStore.Provenance(manifests.Registry))
+	recorder.RecordReadyTransition(r.Recorder, registry, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, registry); err != nil {
@@ -153,7 +178,7 @@ func (r *KonfluxInternalRegistryReconciler) Reconcile(ctx context.Context, req c
 
 // applyManifests loads and applies all embedded manifests to the cluster using the tracking client.
 // Manifests are parsed once and cached; deep copies are used during reconciliation.
-func (r *KonfluxInternalRegistryReconciler) applyManifests(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxInternalRegistryReconciler) applyManifests(ctx context.Context, tc *tracking.Client, registry *konfluxv1alpha1.KonfluxInternalRegistry) error {
 	log := logf.FromContext(ctx)
 
 	objects, err := r.ObjectStore.GetForComponent(manifests.Registry)
@@ -162,6 +187,16 @@ func (r *KonfluxInternalRegistryReconciler) applyManifests(ctx context.Context,
 	}
 
 	for _, obj := range objects {
+		if err := common.ApplyPodPlacement(obj, registry.Spec.PodPlacement); err != nil {
+			return fmt.Errorf("failed to apply pod placement to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		if err := customization.ApplyObjectPatches(obj, registry.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, registry.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, registry.Spec.ImagePull)
+		customization.ApplyProxy(obj, registry.Spec.EgressProxy)
+
 		// Apply with ownership - automatically sets labels, owner reference, and tracks
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			// Only skip if it's specifically a "CRD not installed" error for trust-manager (Bundle).
@@ -207,8 +242,13 @@ func (r *KonfluxInternalRegistryReconciler) SetupWithManager(mgr ctrl.Manager) e
 }
 
 // ensureRegistryCredentials keeps Zot htpasswd credentials and the client dockerconfig
-// secret in sync. If either side is missing or empty, both are rotated together.
-func (r *KonfluxInternalRegistryReconciler) ensureRegistryCredentials(ctx context.Context, tc *tracking.Client) error {
+// secret in sync. If either side is missing or empty, both are rotated together, and a
+// SecretRotated Event is emitted on registry unless both were missing (first install).
+func (r *KonfluxInternalRegistryReconciler) ensureRegistryCredentials(
+	ctx context.Context,
+	tc *tracking.Client,
+	registry *konfluxv1alpha1.KonfluxInternalRegistry,
+) error {
 	htpasswd := &corev1.Secret{}
 	htpasswd.SetName(HtpasswdSecretName)
 	htpasswd.SetNamespace(RegistryNamespace)
@@ -228,6 +268,9 @@ func (r *KonfluxInternalRegistryReconciler) ensureRegistryCredentials(ctx contex
 	rotateCredentials := !hasSecretData(htpasswd, "htpasswd") ||
 		!hasSecretData(registryAuthSecret, corev1.DockerConfigJsonKey)
 
+	replacedCredentials := rotateCredentials &&
+		(hasSecretData(htpasswd, "htpasswd") || hasSecretData(registryAuthSecret, corev1.DockerConfigJsonKey))
+
 	password := ""
 	if rotateCredentials {
 		var err error
@@ -293,6 +336,11 @@ func (r *KonfluxInternalRegistryReconciler) ensureRegistryCredentials(ctx contex
 		return fmt.Errorf("ensure %s: %w", ClientCredentialsSecretName, err)
 	}
 
+	if replacedCredentials {
+		recorder.Normal(r.Recorder, registry, recorder.ReasonSecretRotated, recorder.ActionRotate,
+			fmt.Sprintf("Rotated registry credentials in Secrets %s/%s and %s/%s",
+				RegistryNamespace, HtpasswdSecretName, RegistryNamespace, ClientCredentialsSecretName))
+	}
 	return nil
 }
 
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
@@ -117,8 +118,10 @@ var _ = Describe("Internal registry credential helpers", func() {
 			FieldManager:      FieldManager,
 		})
 
-		r := &KonfluxInternalRegistryReconciler{Client: cl, Scheme: scheme}
-		Expect(r.ensureRegistryCredentials(ctx, tc)).To(Succeed())
+		eventRecorder := events.NewFakeRecorder(1)
+		r := &KonfluxInternalRegistryReconciler{Client: cl, Scheme: scheme, Recorder: eventRecorder}
+		Expect(r.ensureRegistryCredentials(ctx, tc, owner)).To(Succeed())
+		Expect(eventRecorder.Events).NotTo(Receive())
 
 		gotHtpasswd := &corev1.Secret{}
 		Expect(cl.Get(ctx, types.NamespacedName{Name: HtpasswdSecretName, Namespace: RegistryNamespace}, gotHtpasswd)).To(Succeed())
@@ -163,8 +166,10 @@ var _ = Describe("Internal registry credential helpers", func() {
 			FieldManager:      FieldManager,
 		})
 
-		r := &KonfluxInternalRegistryReconciler{Client: cl, Scheme: scheme}
-		Expect(r.ensureRegistryCredentials(ctx, tc)).To(Succeed())
+		eventRecorder := events.NewFakeRecorder(1)
+		r := &KonfluxInternalRegistryReconciler{Client: cl, Scheme: scheme, Recorder: eventRecorder}
+		Expect(r.ensureRegistryCredentials(ctx, tc, owner)).To(Succeed())
+		Expect(eventRecorder.Events).To(Receive(HavePrefix("Normal SecretRotated ")))
 
 		gotHtpasswd := &corev1.Secret{}
 		Expect(cl.Get(ctx, types.NamespacedName{Name: HtpasswdSecretName, Namespace: RegistryNamespace}, gotHtpasswd)).To(Succeed())
@@ -207,7 +212,7 @@ var _ = Describe("Internal registry credential helpers", func() {
 		})
 		r := &KonfluxInternalRegistryReconciler{Client: cl, Scheme: scheme}
 
-		err := r.ensureRegistryCredentials(ctx, tc)
+		err := r.ensureRegistryCredentials(ctx, tc, owner)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring(HtpasswdSecretName))
 	})
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
+)
+
+// componentStatus describes what is deployed for component c: the sub-CR's Ready condition,
+// the manifests it is deployed from, and the replicas and images of the Deployments its
+// reconciler owns. subCR may be empty when the sub-CR has not been created yet.
+func (r *KonfluxReconciler) componentStatus(
+	ctx context.Context,
+	c component,
+	subCR konfluxv1alpha1.ConditionAccessor,
+) (konfluxv1alpha1.ComponentStatus, error) {
+	status := konfluxv1alpha1.ComponentStatus{Name: c.name}
+	if versioned, ok := subCR.(konfluxv1alpha1.OperatorVersionAccessor); ok {
+		status.ManifestVersion = versioned.GetOperatorVersion()
+	}
+	if ready := apimeta.FindStatusCondition(subCR.GetConditions(), condition.TypeReady); ready != nil {
+		status.Ready = ready.Status == metav1.ConditionTrue
+		status.Message = ready.Message
+		status.ObservedGeneration = ready.ObservedGeneration
+		status.LastTransitionTime = ready.LastTransitionTime.DeepCopy()
+	}
+
+	source := manifests.SourceStatus{Source: manifests.Source{Type: manifests.SourceEmbedded}}
+	if r.ObjectStore != nil {
+		source = r.ObjectStore.Source(c.manifest)
+		active := source.Active()
+		status.ManifestSource = &konfluxv1alpha1.ManifestSourceStatus{
+			Type:           string(active.Type),
+			Reference:      active.Location,
+			Digest:         active.Digest,
+			FallbackReason: source.FallbackReason,
+		}
+	}
+
+	// Upstream revisions are only known for the manifests embedded in the running operator.
+	if status.ManifestVersion == version.Version && source.Active().Type == manifests.SourceEmbedded {
+		revisions, err := manifests.UpstreamRevisions(c.manifest)
+		if err != nil {
+			return status, fmt.Errorf("failed to read upstream revisions for %s: %w", c.manifest, err)
+		}
+		status.UpstreamRevisions = revisions
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.MatchingLabels{
+		constant.KonfluxOwnerLabel: subCR.GetName(),
+	}); err != nil {
+		return status, fmt.Errorf("failed to list deployments of %s: %w", c.name, err)
+	}
+	if len(deployments.Items) == 0 {
+		return status, nil
+	}
+
+	status.Replicas = &konfluxv1alpha1.ReplicaStatus{}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		status.Replicas.Desired += desired
+		status.Replicas.Available += deployment.Status.AvailableReplicas
+
+		images, err := r.runningImages(ctx, deployment)
+		if err != nil {
+			return status, err
+		}
+		status.Images = append(status.Images, images...)
+	}
+	status.Images = sortedUniqueImages(status.Images)
+	return status, nil
+}
+
+// runningImages returns the images the deployment's pods run, with the digests reported by
+// the kubelet. Pods are read through PodReader so that the operator does not cache every pod
+// in the cluster; without a PodReader no images are reported.
+func (r *KonfluxReconciler) runningImages(
+	ctx context.Context,
+	deployment *appsv1.Deployment,
+) ([]konfluxv1alpha1.RunningImage, error) {
+	if r.PodReader == nil || deployment.Spec.Selector == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.PodReader.List(ctx, pods, client.InNamespace(deployment.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list pods of deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+
+	var images []konfluxv1alpha1.RunningImage
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			images = append(images, konfluxv1alpha1.RunningImage{
+				Container: cs.Name,
+				Image:     cs.Image,
+				ImageID:   strings.TrimPrefix(cs.ImageID, "docker-pullable://"),
+			})
+		}
+	}
+	return images, nil
+}
+
+// sortedUniqueImages sorts images and drops duplicates reported by replicas of the same pod.
+func sortedUniqueImages(images []konfluxv1alpha1.RunningImage) []konfluxv1alpha1.RunningImage {
+	slices.SortFunc(images, func(a, b konfluxv1alpha1.RunningImage) int {
+		return strings.Compare(a.Container+"\x00"+a.Image+"\x00"+a.ImageID, b.Container+"\x00"+b.Image+"\x00"+b.ImageID)
+	})
+	return slices.Compact(images)
+}
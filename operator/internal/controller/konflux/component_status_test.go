@@ -0,0 +1,183 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/buildservice"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
+)
+
+func buildServiceComponent(t *testing.T) component {
+	t.Helper()
+	for _, c := range konfluxComponents {
+		if c.name == componentBuildService {
+			return c
+		}
+	}
+	t.Fatalf("component %s not found", componentBuildService)
+	return component{}
+}
+
+func runningPod(name string, imageID string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "build-service",
+			Labels:    map[string]string{"control-plane": "controller-manager"},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    "manager",
+				Image:   "quay.io/konflux-ci/build-service:abc",
+				ImageID: imageID,
+			}},
+		},
+	}
+}
+
+func TestComponentStatus(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(appsv1.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(konfluxv1alpha1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	transition := metav1.Now()
+	subCR := &konfluxv1alpha1.KonfluxBuildService{
+		ObjectMeta: metav1.ObjectMeta{Name: buildservice.CRName, Generation: 3},
+		Status: konfluxv1alpha1.KonfluxBuildServiceStatus{
+			Conditions: []metav1.Condition{{
+				Type:               constant.ConditionTypeReady,
+				Status:             metav1.ConditionTrue,
+				Reason:             "AllComponentsReady",
+				Message:            "All 1 components are ready",
+				ObservedGeneration: 3,
+				LastTransitionTime: transition,
+			}},
+			OperatorVersion: version.Version,
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "build-service-controller-manager",
+			Namespace: "build-service",
+			Labels:    map[string]string{constant.KonfluxOwnerLabel: buildservice.CRName},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"control-plane": "controller-manager"}},
+		},
+		Status: appsv1.DeploymentStatus{AvailableReplicas: 1},
+	}
+	digest := "quay.io/konflux-ci/build-service@sha256:0123"
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		deployment,
+		runningPod("manager-1", "docker-pullable://"+digest),
+		runningPod("manager-2", digest),
+	).Build()
+
+	r := &KonfluxReconciler{Client: fakeClient, Scheme: scheme, PodReader: fakeClient}
+	status, err := r.componentStatus(ctx, buildServiceComponent(t), subCR)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	g.Expect(status.Name).To(gomega.Equal(componentBuildService))
+	g.Expect(status.Ready).To(gomega.BeTrue())
+	g.Expect(status.Message).To(gomega.Equal("All 1 components are ready"))
+	g.Expect(status.ObservedGeneration).To(gomega.Equal(int64(3)))
+	g.Expect(status.LastTransitionTime).NotTo(gomega.BeNil())
+	g.Expect(status.ManifestVersion).To(gomega.Equal(version.Version))
+	g.Expect(status.UpstreamRevisions).To(gomega.ContainElement(gomega.HavePrefix("konflux-ci/build-service@")))
+	g.Expect(status.Replicas).To(gomega.Equal(&konfluxv1alpha1.ReplicaStatus{Desired: 2, Available: 1}))
+	g.Expect(status.Images).To(gomega.Equal([]konfluxv1alpha1.RunningImage{{
+		Container: "manager",
+		Image:     "quay.io/konflux-ci/build-service:abc",
+		ImageID:   digest,
+	}}))
+}
+
+func TestComponentStatus_NotCreatedYet(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(appsv1.AddToScheme(scheme)).To(gomega.Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	r := &KonfluxReconciler{Client: fakeClient, Scheme: scheme}
+	subCR := &konfluxv1alpha1.KonfluxBuildService{ObjectMeta: metav1.ObjectMeta{Name: buildservice.CRName}}
+	status, err := r.componentStatus(context.Background(), buildServiceComponent(t), subCR)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	g.Expect(status.Ready).To(gomega.BeFalse())
+	g.Expect(status.ManifestVersion).To(gomega.BeEmpty())
+	g.Expect(status.UpstreamRevisions).To(gomega.BeEmpty())
+	g.Expect(status.LastTransitionTime).To(gomega.BeNil())
+	g.Expect(status.Replicas).To(gomega.BeNil())
+	g.Expect(status.Images).To(gomega.BeEmpty())
+}
+
+func TestComponentStatus_ManifestSource(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(appsv1.AddToScheme(scheme)).To(gomega.Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	content := []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: hotfix\n  namespace: build-service\n")
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "manifests.yaml"), content, 0o600)).To(gomega.Succeed())
+	sum := sha256.Sum256(content)
+	source := manifests.Source{Type: manifests.SourceDirectory, Location: dir, Digest: "sha256:" + hex.EncodeToString(sum[:])}
+	store, err := manifests.NewObjectStoreWithSources(ctx, scheme, manifests.Sources{manifests.BuildService: source}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	r := &KonfluxReconciler{Client: fakeClient, Scheme: scheme, ObjectStore: store}
+	subCR := &konfluxv1alpha1.KonfluxBuildService{
+		ObjectMeta: metav1.ObjectMeta{Name: buildservice.CRName},
+		Status:     konfluxv1alpha1.KonfluxBuildServiceStatus{OperatorVersion: version.Version},
+	}
+	status, err := r.componentStatus(ctx, buildServiceComponent(t), subCR)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	g.Expect(status.ManifestSource).To(gomega.Equal(&konfluxv1alpha1.ManifestSourceStatus{
+		Type:      "Directory",
+		Reference: dir,
+		Digest:    source.Digest,
+	}))
+	// The upstream revisions of the embedded manifests do not describe a hotfix.
+	g.Expect(status.UpstreamRevisions).To(gomega.BeEmpty())
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
+)
+
+// forwardedImageRegistryMirrors returns a copy of the registry mirrors of the Konflux CR for a
+// component CR.
+func forwardedImageRegistryMirrors(owner *konfluxv1alpha1.Konflux) []konfluxv1alpha1.ImageRegistryMirror {
+	if owner == nil || len(owner.Spec.ImageRegistryMirrors) == 0 {
+		return nil
+	}
+	return slices.Clone(owner.Spec.ImageRegistryMirrors)
+}
+
+// unmirroredImages returns the sorted container images of the enabled components' embedded
+// manifests that match none of the mirrors.
+func (r *KonfluxReconciler) unmirroredImages(konflux *konfluxv1alpha1.Konflux) []string {
+	if r.ObjectStore == nil {
+		return nil
+	}
+	var images []string
+	for _, c := range konfluxComponents {
+		if !c.isEnabled(&konflux.Spec) {
+			continue
+		}
+		objects, err := r.ObjectStore.GetForComponent(c.manifest)
+		if err != nil {
+			continue
+		}
+		for _, obj := range objects {
+			images = append(images, customization.UnmirroredImages(obj, konflux.Spec.ImageRegistryMirrors)...)
+		}
+	}
+	slices.Sort(images)
+	return slices.Compact(images)
+}
+
+// setImagesMirroredCondition records the images that no mirror covers in
+// status.unmirroredImages and sets the ImagesMirrored condition. Both are cleared when the
+// Konflux CR has no mirrors.
+func (r *KonfluxReconciler) setImagesMirroredCondition(konflux *konfluxv1alpha1.Konflux) {
+	if len(konflux.Spec.ImageRegistryMirrors) == 0 {
+		konflux.Status.UnmirroredImages = nil
+		condition.CleanupStaleConditions(konflux, func(c metav1.Condition) bool {
+			return c.Type != condition.TypeImagesMirrored
+		})
+		return
+	}
+
+	unmirrored := r.unmirroredImages(konflux)
+	konflux.Status.UnmirroredImages = unmirrored
+	if len(unmirrored) == 0 {
+		condition.SetCondition(konflux, metav1.Condition{
+			Type:    condition.TypeImagesMirrored,
+			Status:  metav1.ConditionTrue,
+			Reason:  condition.ReasonAllImagesMirrored,
+			Message: "All images of the enabled components are pulled from a mirror",
+		})
+		return
+	}
+	message := fmt.Sprintf("%d image(s) match no mirror and are pulled from their original registry: %s",
+		len(unmirrored), strings.Join(unmirrored, ", "))
+	condition.SetCondition(konflux, metav1.Condition{
+		Type:    condition.TypeImagesMirrored,
+		Status:  metav1.ConditionFalse,
+		Reason:  condition.ReasonImagesNotMirrored,
+		Message: message,
+	})
+	recorder.Warning(r.Recorder, konflux, condition.ReasonImagesNotMirrored, recorder.ActionReconcile, message)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/imageref"
+)
+
+func TestForwardedImageRegistryMirrors(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(forwardedImageRegistryMirrors(nil)).To(gomega.BeNil())
+	g.Expect(forwardedImageRegistryMirrors(&konfluxv1alpha1.Konflux{})).To(gomega.BeNil())
+
+	mirrors := []konfluxv1alpha1.ImageRegistryMirror{{Source: "quay.io/konflux-ci", Mirror: "mirror.example.com/konflux-ci"}}
+	forwarded := forwardedImageRegistryMirrors(&konfluxv1alpha1.Konflux{
+		Spec: konfluxv1alpha1.KonfluxSpec{ImageRegistryMirrors: mirrors},
+	})
+	g.Expect(forwarded).To(gomega.Equal(mirrors))
+	forwarded[0].Mirror = "changed"
+	g.Expect(mirrors[0].Mirror).To(gomega.Equal("mirror.example.com/konflux-ci"), "forwarded mirrors must be a copy")
+}
+
+func TestSetImagesMirroredCondition(t *testing.T) {
+	r := newPatchesReconciler(t)
+
+	t.Run("reports images outside the mirrored prefixes", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			ImageRegistryMirrors: []konfluxv1alpha1.ImageRegistryMirror{
+				{Source: "quay.io/konflux-ci", Mirror: "mirror.example.com/konflux-ci"},
+			},
+		}}
+		r.setImagesMirroredCondition(konflux)
+
+		g.Expect(konflux.Status.UnmirroredImages).NotTo(gomega.BeEmpty())
+		for _, image := range konflux.Status.UnmirroredImages {
+			g.Expect(image).NotTo(gomega.HavePrefix("quay.io/konflux-ci/"))
+		}
+		cond := meta.FindStatusCondition(konflux.Status.Conditions, condition.TypeImagesMirrored)
+		g.Expect(cond).NotTo(gomega.BeNil())
+		g.Expect(cond.Status).To(gomega.Equal(metav1.ConditionFalse))
+		g.Expect(cond.Reason).To(gomega.Equal(condition.ReasonImagesNotMirrored))
+		g.Expect(cond.Message).To(gomega.ContainSubstring(strings.Join(konflux.Status.UnmirroredImages, ", ")))
+	})
+
+	t.Run("true when every image has a mirror", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			ImageRegistryMirrors: []konfluxv1alpha1.ImageRegistryMirror{
+				{Source: "quay.io/konflux-ci", Mirror: "mirror.example.com/konflux-ci"},
+			},
+		}}
+		for _, image := range r.unmirroredImages(konflux) {
+			name, _, _ := imageref.Parse(image)
+			konflux.Spec.ImageRegistryMirrors = append(konflux.Spec.ImageRegistryMirrors,
+				konfluxv1alpha1.ImageRegistryMirror{Source: name, Mirror: "mirror.example.com/" + name})
+		}
+		r.setImagesMirroredCondition(konflux)
+
+		g.Expect(konflux.Status.UnmirroredImages).To(gomega.BeEmpty())
+		cond := meta.FindStatusCondition(konflux.Status.Conditions, condition.TypeImagesMirrored)
+		g.Expect(cond).NotTo(gomega.BeNil())
+		g.Expect(cond.Reason).To(gomega.Equal(condition.ReasonAllImagesMirrored))
+	})
+
+	t.Run("cleared when the mirrors are removed", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			ImageRegistryMirrors: []konfluxv1alpha1.ImageRegistryMirror{{Source: "quay.io", Mirror: "mirror.example.com"}},
+		}}
+		r.setImagesMirroredCondition(konflux)
+		konflux.Spec.ImageRegistryMirrors = nil
+		r.setImagesMirroredCondition(konflux)
+
+		g.Expect(konflux.Status.UnmirroredImages).To(gomega.BeNil())
+		g.Expect(meta.FindStatusCondition(konflux.Status.Conditions, condition.TypeImagesMirrored)).To(gomega.BeNil())
+	})
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+// forwardedImagePull returns the image pull settings of the Konflux CR for a component CR, or
+// nil when neither image pull secrets nor a pull policy are set.
+func forwardedImagePull(owner *konfluxv1alpha1.Konflux) *konfluxv1alpha1.ImagePullConfig {
+	if owner == nil || (len(owner.Spec.ImagePullSecrets) == 0 && owner.Spec.ImagePullPolicy == "") {
+		return nil
+	}
+	pull := &konfluxv1alpha1.ImagePullConfig{Policy: owner.Spec.ImagePullPolicy}
+	for _, secret := range owner.Spec.ImagePullSecrets {
+		pull.Secrets = append(pull.Secrets, corev1.LocalObjectReference{Name: secret.Name})
+	}
+	return pull
+}
+
+// imagePullSecretResyncInterval is how often the copies of spec.imagePullSecrets are refreshed
+// from their sources, which are read directly instead of being watched.
+const imagePullSecretResyncInterval = 5 * time.Minute
+
+// imagePullNamespaces returns the sorted namespaces in which the enabled components run pods
+// or define ServiceAccounts, or those of every component when konflux is nil.
+func (r *KonfluxReconciler) imagePullNamespaces(konflux *konfluxv1alpha1.Konflux) []string {
+	if r.ObjectStore == nil {
+		return nil
+	}
+	var namespaces []string
+	for _, c := range konfluxComponents {
+		if konflux != nil && !c.isEnabled(&konflux.Spec) {
+			continue
+		}
+		objects, err := r.ObjectStore.GetForComponent(c.manifest)
+		if err != nil {
+			continue
+		}
+		for _, obj := range objects {
+			if _, ok := obj.(*corev1.ServiceAccount); (ok || customization.RunsPods(obj)) && obj.GetNamespace() != "" {
+				namespaces = append(namespaces, obj.GetNamespace())
+			}
+		}
+	}
+	slices.Sort(namespaces)
+	return slices.Compact(namespaces)
+}
+
+// applyImagePullSecrets copies the Secrets of spec.imagePullSecrets into the namespaces of the
+// enabled components. Namespaces that do not exist yet are skipped: the component reconcilers
+// create them, and the resulting sub-CR status change triggers another reconcile.
+// The sources are read with the SecretReader, so that no Secret outside the component
+// namespaces is cached.
+func (r *KonfluxReconciler) applyImagePullSecrets(ctx context.Context, tc *tracking.Client, konflux *konfluxv1alpha1.Konflux) error {
+	if len(konflux.Spec.ImagePullSecrets) == 0 {
+		return nil
+	}
+	log := logf.FromContext(ctx)
+	var reader client.Reader = r.Client
+	if r.SecretReader != nil {
+		reader = r.SecretReader
+	}
+
+	var namespaces []string
+	for _, namespace := range r.imagePullNamespaces(konflux) {
+		if err := r.Get(ctx, client.ObjectKey{Name: namespace}, &corev1.Namespace{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.V(1).Info("Namespace does not exist yet, not copying image pull secrets", "namespace", namespace)
+				continue
+			}
+			return fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+		}
+		namespaces = append(namespaces, namespace)
+	}
+
+	for _, ref := range konflux.Spec.ImagePullSecrets {
+		source := &corev1.Secret{}
+		if err := reader.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, source); err != nil {
+			return fmt.Errorf("failed to get image pull secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		for _, namespace := range namespaces {
+			if namespace == ref.Namespace {
+				continue
+			}
+			secret := &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "Secret",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ref.Name,
+					Namespace: namespace,
+				},
+				Type: source.Type,
+				Data: source.Data,
+			}
+			if err := tc.ApplyOwned(ctx, secret); err != nil {
+				return fmt.Errorf("failed to copy image pull secret %s/%s to %s: %w", ref.Namespace, ref.Name, namespace, err)
+			}
+		}
+	}
+	return nil
+}
+
+// newImagePullSecretCache returns a cache of the Secrets in namespaces that carry the owner
+// label, the only Secrets the Konflux reconciler applies. Watching the copies of
+// spec.imagePullSecrets through it, rather than the manager's cache, keeps the reconciler from
+// watching every Secret in the cluster. The cache is started by mgr.
+func newImagePullSecretCache(mgr ctrl.Manager, namespaces []string) (cache.Cache, error) {
+	selector, err := labels.Parse(constant.KonfluxOwnerLabel)
+	if err != nil {
+		return nil, err
+	}
+	defaultNamespaces := make(map[string]cache.Config, len(namespaces))
+	for _, namespace := range namespaces {
+		defaultNamespaces[namespace] = cache.Config{}
+	}
+	secretCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient:        mgr.GetHTTPClient(),
+		Scheme:            mgr.GetScheme(),
+		Mapper:            mgr.GetRESTMapper(),
+		DefaultNamespaces: defaultNamespaces,
+		ByObject:          map[client.Object]cache.ByObject{&corev1.Secret{}: {Label: selector}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image pull Secret cache: %w", err)
+	}
+	if err := mgr.Add(secretCache); err != nil {
+		return nil, fmt.Errorf("failed to add image pull Secret cache: %w", err)
+	}
+	return secretCache, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+func TestForwardedImagePull(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(forwardedImagePull(nil)).To(gomega.BeNil())
+	g.Expect(forwardedImagePull(&konfluxv1alpha1.Konflux{})).To(gomega.BeNil())
+
+	owner := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+		ImagePullSecrets: []konfluxv1alpha1.ImagePullSecretReference{{Name: "mirror-credentials", Namespace: "konflux-operator"}},
+		ImagePullPolicy:  corev1.PullIfNotPresent,
+	}}
+	g.Expect(forwardedImagePull(owner)).To(gomega.Equal(&konfluxv1alpha1.ImagePullConfig{
+		Secrets: []corev1.LocalObjectReference{{Name: "mirror-credentials"}},
+		Policy:  corev1.PullIfNotPresent,
+	}))
+}
+
+func TestApplyImagePullSecrets(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(konfluxv1alpha1.AddToScheme(scheme))
+
+	konflux := &konfluxv1alpha1.Konflux{
+		ObjectMeta: metav1.ObjectMeta{Name: CRName, UID: "konflux-uid"},
+		Spec: konfluxv1alpha1.KonfluxSpec{
+			ImagePullSecrets: []konfluxv1alpha1.ImagePullSecretReference{{Name: "mirror-credentials", Namespace: "konflux-operator"}},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mirror-credentials", Namespace: "konflux-operator"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+	}
+	// Only build-service's namespace exists; the other components are not rolled out yet.
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		konflux, source, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "build-service"}},
+	).Build()
+
+	r := newPatchesReconciler(t)
+	r.Client = fakeClient
+	tc := tracking.NewClientWithOwnership(fakeClient, tracking.OwnershipConfig{
+		Owner:             konflux,
+		OwnerLabelKey:     constant.KonfluxOwnerLabel,
+		ComponentLabelKey: constant.KonfluxComponentLabel,
+		Component:         "konflux",
+		FieldManager:      FieldManager,
+	})
+	g.Expect(r.imagePullNamespaces(konflux)).To(gomega.ContainElements("build-service", "integration-service"))
+	// The copies are watched and cleaned up in the namespaces of every component, enabled or not.
+	g.Expect(r.imagePullNamespaces(nil)).To(gomega.ContainElements(r.imagePullNamespaces(konflux)))
+	g.Expect(r.applyImagePullSecrets(ctx, tc, konflux)).To(gomega.Succeed())
+
+	secret := &corev1.Secret{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "build-service", Name: "mirror-credentials"}, secret)).To(gomega.Succeed())
+	g.Expect(secret.Type).To(gomega.Equal(corev1.SecretTypeDockerConfigJson))
+	g.Expect(secret.Data).To(gomega.Equal(source.Data))
+	g.Expect(secret.Labels).To(gomega.HaveKeyWithValue(constant.KonfluxOwnerLabel, CRName))
+
+	err := fakeClient.Get(ctx, client.ObjectKey{Namespace: "integration-service", Name: "mirror-credentials"}, &corev1.Secret{})
+	g.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue(), "namespaces that do not exist yet are skipped")
+
+	konflux.Spec.ImagePullSecrets[0].Name = "missing"
+	g.Expect(r.applyImagePullSecrets(ctx, tc, konflux)).To(gomega.MatchError(gomega.ContainSubstring("konflux-operator/missing")))
+}
@@ -18,16 +18,22 @@ package konflux
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
 	"github.com/konflux-ci/konflux-ci/operator/internal/common"
@@ -49,8 +55,12 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/segmentbridge"
 	uictrl "github.com/konflux-ci/konflux-ci/operator/internal/controller/ui"
 	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/internal/upgrade"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
 )
 
 const (
@@ -63,46 +73,86 @@ const (
 )
 
 var (
-	// Optional sub-CRs shared by cleanup and cluster-scoped allow-list tables.
-	imageControllerGVK  = konfluxv1alpha1.GroupVersion.WithKind("KonfluxImageController")
-	internalRegistryGVK = konfluxv1alpha1.GroupVersion.WithKind("KonfluxInternalRegistry")
-	defaultTenantGVK    = konfluxv1alpha1.GroupVersion.WithKind("KonfluxDefaultTenant")
-	segmentBridgeGVK    = konfluxv1alpha1.GroupVersion.WithKind("KonfluxSegmentBridge")
+	// Sub-CRs that can be disabled, shared by cleanup and cluster-scoped allow-list tables.
+	uiGVK                 = konfluxv1alpha1.GroupVersion.WithKind("KonfluxUI")
+	integrationServiceGVK = konfluxv1alpha1.GroupVersion.WithKind("KonfluxIntegrationService")
+	releaseServiceGVK     = konfluxv1alpha1.GroupVersion.WithKind("KonfluxReleaseService")
+	buildServiceGVK       = konfluxv1alpha1.GroupVersion.WithKind("KonfluxBuildService")
+	namespaceListerGVK    = konfluxv1alpha1.GroupVersion.WithKind("KonfluxNamespaceLister")
+	infoGVK               = konfluxv1alpha1.GroupVersion.WithKind("KonfluxInfo")
+	enterpriseContractGVK = konfluxv1alpha1.GroupVersion.WithKind("KonfluxEnterpriseContract")
+	certManagerGVK        = konfluxv1alpha1.GroupVersion.WithKind("KonfluxCertManager")
+	cliGVK                = konfluxv1alpha1.GroupVersion.WithKind("KonfluxCLI")
+	imageControllerGVK    = konfluxv1alpha1.GroupVersion.WithKind("KonfluxImageController")
+	internalRegistryGVK   = konfluxv1alpha1.GroupVersion.WithKind("KonfluxInternalRegistry")
+	defaultTenantGVK      = konfluxv1alpha1.GroupVersion.WithKind("KonfluxDefaultTenant")
+	segmentBridgeGVK      = konfluxv1alpha1.GroupVersion.WithKind("KonfluxSegmentBridge")
+	secretGVK             = corev1.SchemeGroupVersion.WithKind("Secret")
 )
 
 // konfluxCleanupGVKs defines which sub-CR types should be cleaned up when they are
-// no longer part of the desired state. Only optional/conditional sub-CRs are listed here.
-// Always-applied sub-CRs don't need cleanup (they're always tracked and never become orphans).
+// no longer part of the desired state. Every sub-CR that can be disabled is listed here;
+// deleting it lets Kubernetes garbage-collect the operands it owns.
+// Sub-CRs that are always applied (application-api, rbac) don't need cleanup
+// (they're always tracked and never become orphans). The image pull Secrets copied into the
+// component namespaces are the only other resources the Konflux reconciler applies.
 var konfluxCleanupGVKs = []schema.GroupVersionKind{
+	// KonfluxUI - disabled when spec.ui.enabled is false
+	uiGVK,
+	// KonfluxIntegrationService - disabled when spec.integrationService.enabled is false
+	integrationServiceGVK,
+	// KonfluxReleaseService - disabled when spec.releaseService.enabled is false
+	releaseServiceGVK,
+	// KonfluxBuildService - disabled when spec.buildService.enabled is false
+	buildServiceGVK,
+	// KonfluxNamespaceLister - disabled when spec.namespaceLister.enabled is false
+	namespaceListerGVK,
+	// KonfluxInfo - disabled when spec.info.enabled is false
+	infoGVK,
+	// KonfluxEnterpriseContract - disabled when spec.enterpriseContract.enabled is false
+	enterpriseContractGVK,
+	// KonfluxCertManager - disabled when spec.certManager.enabled is false
+	certManagerGVK,
+	// KonfluxCLI - disabled when spec.cli.enabled is false
+	cliGVK,
 	// KonfluxImageController is optional - only created when spec.imageController.enabled is true
 	imageControllerGVK,
 	// KonfluxInternalRegistry is optional - only created when spec.internalRegistry.enabled is true
 	internalRegistryGVK,
 	// KonfluxDefaultTenant is optional - only created when spec.defaultTenant.enabled is true (default)
 	defaultTenantGVK,
-	// KonfluxSegmentBridge is optional - only created when spec.segmentBridge.enabled is true
+	// KonfluxSegmentBridge is optional - only created when spec.telemetry.enabled is true
 	segmentBridgeGVK,
+	// Secrets - copies of spec.imagePullSecrets, deleted when removed from the list. Only the
+	// component namespaces are searched for them.
+	secretGVK,
 }
 
 // konfluxClusterScopedAllowList restricts which cluster-scoped sub-CRs can be deleted
-// during orphan cleanup. Only conditionally-created sub-CRs need to be listed here.
+// during orphan cleanup. Only sub-CRs that can be disabled need to be listed here.
 var konfluxClusterScopedAllowList = tracking.ClusterScopedAllowList{
-	// KonfluxImageController is optional - only created when spec.imageController.enabled is true
-	imageControllerGVK: sets.New(
-		"konflux-image-controller",
-	),
-	// KonfluxInternalRegistry is optional - only created when spec.internalRegistry.enabled is true
-	internalRegistryGVK: sets.New(
-		"konflux-internal-registry",
-	),
-	// KonfluxDefaultTenant is optional - only created when spec.defaultTenant.enabled is true (default)
-	defaultTenantGVK: sets.New(
-		"konflux-default-tenant",
-	),
-	// KonfluxSegmentBridge is optional - only created when spec.segmentBridge.enabled is true
-	segmentBridgeGVK: sets.New(
-		"konflux-segment-bridge",
-	),
+	uiGVK:                 sets.New(uictrl.CRName),
+	integrationServiceGVK: sets.New(integrationservice.CRName),
+	releaseServiceGVK:     sets.New(releaseservice.CRName),
+	buildServiceGVK:       sets.New(buildservice.CRName),
+	namespaceListerGVK:    sets.New(namespacelister.CRName),
+	infoGVK:               sets.New(info.CRName),
+	enterpriseContractGVK: sets.New(enterprisecontract.CRName),
+	certManagerGVK:        sets.New(certmanager.CRName),
+	cliGVK:                sets.New(clictrl.CRName),
+	imageControllerGVK:    sets.New(imagecontroller.CRName),
+	internalRegistryGVK:   sets.New(internalregistry.CRName),
+	defaultTenantGVK:      sets.New(defaulttenant.CRName),
+	segmentBridgeGVK:      sets.New(segmentbridge.CRName),
+}
+
+// konfluxDeletionPolicies keeps the sub-CRs whose operands hold user data when they are
+// disabled: deleting KonfluxInternalRegistry or KonfluxDefaultTenant garbage-collects the
+// registry storage and the tenant namespace. They are deleted once annotated with
+// tracking.ConfirmDeletionAnnotation.
+var konfluxDeletionPolicies = tracking.DeletionPolicies{
+	internalRegistryGVK: tracking.DeletionPolicyRequireConfirmation,
+	defaultTenantGVK:    tracking.DeletionPolicyRequireConfirmation,
 }
 
 // KonfluxReconciler reconciles a Konflux object
@@ -110,9 +160,24 @@ type KonfluxReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
 	ClusterInfo *clusterinfo.Info
+	// SecretReader reads the sources of spec.imagePullSecrets; prefer mgr.GetAPIReader() so
+	// that Secrets are not cached cluster-wide. The client is used when nil.
+	SecretReader client.Reader
+	// PodReader lists component pods to report the images they run; prefer mgr.GetAPIReader()
+	// so pods are not cached cluster-wide. Images are not reported when nil.
+	PodReader client.Reader
+	// UpgradeSteps are the migration steps run when the installed version changes; see upgrade.Steps.
+	UpgradeSteps []upgrade.Step
+	// Recorder emits Events on the Konflux CR; none are emitted when nil.
+	Recorder events.EventRecorder
+	// ObjectStore provides the embedded manifests that spec.patches are routed by. When nil,
+	// every patch is forwarded to every component and unmatched patches are not reported.
+	ObjectStore *manifests.ObjectStore
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxes/finalizers,verbs=update
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxbuildservices,verbs=get;list;watch;create;patch;delete
@@ -161,7 +226,7 @@ type KonfluxReconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
 //
-//nolint:gocyclo // High complexity is acceptable here due to multiple sub-CR reconciliations
+//nolint:gocyclo // High complexity is acceptable here due to rollout, pause and upgrade handling
 func (r *KonfluxReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
@@ -178,9 +243,10 @@ func (r *KonfluxReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	defer func() { operatormetrics.SetKonfluxUp(konflux.IsReady()) }()
 
 	log.Info("Reconciling Konflux", "name", konflux.Name)
+	previousReady := recorder.ReadyCondition(konflux)
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), konflux, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, konflux, crKind)
 
 	// Initialize tracking client for declarative resource management
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -189,228 +255,175 @@ func (r *KonfluxReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         "konflux",
 		FieldManager:      FieldManager,
+		Annotations:       map[string]string{constant.KonfluxOperatorVersionAnnotation: version.Version},
+		Plan:              condition.IsPlanRequested(konflux),
+		Recorder:          r.Recorder,
 	})
 
-	// Apply the KonfluxApplicationAPI CR
-	if err := r.applyKonfluxApplicationAPI(ctx, tc); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxApplicationAPI")
-	}
-
-	// Apply the KonfluxBuildService CR
-	if err := r.applyKonfluxBuildService(ctx, tc, konflux); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxBuildService")
-	}
-
-	// Apply the KonfluxIntegrationService CR
-	if err := r.applyKonfluxIntegrationService(ctx, tc, konflux); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxIntegrationService")
-	}
-
-	// Apply the KonfluxReleaseService CR
-	if err := r.applyKonfluxReleaseService(ctx, tc, konflux); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxReleaseService")
-	}
-
-	// Apply the KonfluxUI CR
-	if err := r.applyKonfluxUI(ctx, tc, konflux); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxUI")
-	}
-
-	// Apply the KonfluxRBAC CR
-	if err := r.applyKonfluxRBAC(ctx, tc); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxRBAC")
-	}
-
-	// Apply the KonfluxInfo CR
-	if err := r.applyKonfluxInfo(ctx, tc, konflux); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxInfo")
-	}
-
-	// Apply the KonfluxNamespaceLister CR
-	if err := r.applyKonfluxNamespaceLister(ctx, tc, konflux); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxNamespaceLister")
-	}
+	// HandlePlan sets the Planned condition on the stored status, so it never survives a reconcile.
+	condition.CleanupStaleConditions(konflux, func(cond metav1.Condition) bool {
+		return cond.Type != condition.TypePlanned
+	})
 
-	// Apply the KonfluxEnterpriseContract CR
-	if err := r.applyKonfluxEnterpriseContract(ctx, tc, konflux); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxEnterpriseContract")
+	// While paused, sub-CRs are neither applied nor cleaned up, so that manual changes to them
+	// survive; their status is still aggregated below.
+	paused := condition.IsPaused(konflux)
+	operatormetrics.SetComponentPaused("konflux", paused)
+	if paused {
+		log.Info("Reconciliation is paused, skipping apply", "annotation", constant.KonfluxPausedAnnotation)
+		condition.SetPausedCondition(konflux)
+	} else {
+		condition.CleanupStaleConditions(konflux, func(cond metav1.Condition) bool {
+			return cond.Type != condition.TypePaused
+		})
 	}
 
-	// Apply the KonfluxImageController CR (only if enabled)
-	if konflux.Spec.IsImageControllerEnabled() {
-		if err := r.applyKonfluxImageController(ctx, tc, konflux); err != nil {
-			return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxImageController")
+	// Compare the running operator with the installed version before applying anything. An
+	// older operator leaves everything as it is, like a paused one, unless the downgrade is allowed.
+	upgradePlan, downgradeBlocked := r.beginUpgrade(ctx, konflux)
+	frozen := paused || downgradeBlocked
+	// Upgrade steps cannot be dry-run, so a plan leaves them out.
+	if upgradePlan != nil && !frozen && !tc.Planning() {
+		if err := r.runUpgradeSteps(ctx, konflux, upgradePlan.Pre); err != nil {
+			return errHandler.HandleWithReason(ctx, err, condition.ReasonUpgradeStepFailed, "run pre-upgrade steps")
 		}
 	}
 
-	// Apply the KonfluxCertManager CR
-	if err := r.applyKonfluxCertManager(ctx, tc, konflux); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxCertManager")
-	}
-
-	// Apply the KonfluxInternalRegistry CR (only if enabled)
-	if konflux.Spec.IsInternalRegistryEnabled() {
-		if err := r.applyKonfluxInternalRegistry(ctx, tc); err != nil {
-			return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxInternalRegistry")
-		}
+	phases, err := rolloutPhases(enabledComponents(konfluxComponents, &konflux.Spec))
+	if err != nil {
+		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "plan component rollout")
 	}
 
-	// Apply the KonfluxDefaultTenant CR (enabled by default)
-	if konflux.Spec.IsDefaultTenantEnabled() {
-		if err := r.applyKonfluxDefaultTenant(ctx, tc); err != nil {
-			return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxDefaultTenant")
+	// Resolve the egress proxy once; the component CRs receive it from status.proxy.
+	if !frozen {
+		proxy, err := r.resolveProxy(ctx, konflux)
+		if err != nil {
+			return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "resolve egress proxy")
 		}
+		konflux.Status.Proxy = proxy
 	}
 
-	// Apply the KonfluxSegmentBridge CR (only if enabled)
-	if konflux.Spec.IsTelemetryEnabled() {
-		if err := r.applyKonfluxSegmentBridge(ctx, tc, konflux); err != nil {
-			return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxSegmentBridge")
+	// Drop the conditions copied from disabled sub-CRs so that they no longer show up on
+	// the Konflux CR; disabled components are not part of the aggregated readiness either.
+	for _, c := range konfluxComponents {
+		if !c.isEnabled(&konflux.Spec) {
+			prefix := c.name + "."
+			condition.CleanupStaleConditions(konflux, func(cond metav1.Condition) bool {
+				return !strings.HasPrefix(cond.Type, prefix)
+			})
 		}
 	}
-
-	// Apply the KonfluxCLI CR
-	if err := r.applyKonfluxCLI(ctx, tc); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply KonfluxCLI")
-	}
-
-	// Cleanup orphaned sub-CRs - delete any sub-CRs with our owner label
-	// that weren't applied during this reconcile (e.g., disabled optional components)
-	if err := tc.CleanupOrphans(ctx, constant.KonfluxOwnerLabel, konflux.Name, konfluxCleanupGVKs,
-		tracking.WithClusterScopedAllowList(konfluxClusterScopedAllowList)); err != nil {
-		return errHandler.HandleCleanupError(ctx, err)
+	if !konflux.Spec.IsUIEnabled() {
+		konflux.Status.UIURL = ""
 	}
 
-	// Collect status from all sub-CRs.
+	// Roll out the sub-CRs phase by phase. A component is applied only once all of its
+	// dependencies report Ready; until then it is left as is (or not created yet) and the
+	// sub-CR watches trigger another reconcile when a dependency becomes Ready. A plan covers
+	// every enabled component, since they would all be applied once their dependencies are Ready.
 	// All component deployments are managed by their respective reconcilers,
 	// so we aggregate readiness by checking each sub-CR's Ready condition.
-	var subCRStatuses []condition.SubCRStatus
-
-	// Get and copy status from the KonfluxBuildService CR
-	buildService := &konfluxv1alpha1.KonfluxBuildService{}
-	if err := r.Get(ctx, client.ObjectKey{Name: buildservice.CRName}, buildService); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxBuildService status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, buildService, "build-service"))
-
-	// Get and copy status from the KonfluxIntegrationService CR
-	integrationService := &konfluxv1alpha1.KonfluxIntegrationService{}
-	if err := r.Get(ctx, client.ObjectKey{Name: integrationservice.CRName}, integrationService); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxIntegrationService status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, integrationService, "integration-service"))
-
-	// Get and copy status from the KonfluxReleaseService CR
-	releaseService := &konfluxv1alpha1.KonfluxReleaseService{}
-	if err := r.Get(ctx, client.ObjectKey{Name: releaseservice.CRName}, releaseService); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxReleaseService status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, releaseService, "release-service"))
-
-	// Get and copy status from the KonfluxUI CR
-	ui := &konfluxv1alpha1.KonfluxUI{}
-	if err := r.Get(ctx, client.ObjectKey{Name: uictrl.CRName}, ui); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxUI status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, ui, "ui"))
-
-	// Propagate UI URL from KonfluxUI status to Konflux status
-	if ui.Status.Ingress != nil {
-		konflux.Status.UIURL = ui.Status.Ingress.URL
+	ready := make(map[string]bool)
+	rollout := &konfluxv1alpha1.RolloutStatus{
+		TotalPhases: int32(len(phases)), //nolint:gosec // bounded by the number of components
 	}
-
-	// Get and copy status from the KonfluxRBAC CR
-	konfluxRBAC := &konfluxv1alpha1.KonfluxRBAC{}
-	if err := r.Get(ctx, client.ObjectKey{Name: rbac.CRName}, konfluxRBAC); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxRBAC status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, konfluxRBAC, "rbac"))
-
-	// Get and copy status from the KonfluxInfo CR
-	konfluxInfo := &konfluxv1alpha1.KonfluxInfo{}
-	if err := r.Get(ctx, client.ObjectKey{Name: info.CRName}, konfluxInfo); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxInfo status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, konfluxInfo, "info"))
-
-	// Get and copy status from the KonfluxNamespaceLister CR
-	konfluxNamespaceLister := &konfluxv1alpha1.KonfluxNamespaceLister{}
-	if err := r.Get(ctx, client.ObjectKey{Name: namespacelister.CRName}, konfluxNamespaceLister); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxNamespaceLister status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, konfluxNamespaceLister, "namespace-lister"))
-
-	// Get and copy status from the KonfluxEnterpriseContract CR
-	konfluxEnterpriseContract := &konfluxv1alpha1.KonfluxEnterpriseContract{}
-	if err := r.Get(ctx, client.ObjectKey{Name: enterprisecontract.CRName}, konfluxEnterpriseContract); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxEnterpriseContract status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, konfluxEnterpriseContract, "enterprise-contract"))
-
-	// Get and copy status from the KonfluxApplicationAPI CR
-	applicationAPI := &konfluxv1alpha1.KonfluxApplicationAPI{}
-	if err := r.Get(ctx, client.ObjectKey{Name: applicationapi.CRName}, applicationAPI); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxApplicationAPI status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, applicationAPI, "application-api"))
-
-	// Get and copy status from the KonfluxCLI CR
-	cliCR := &konfluxv1alpha1.KonfluxCLI{}
-	if err := r.Get(ctx, client.ObjectKey{Name: clictrl.CRName}, cliCR); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxCLI status")
-	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, cliCR, "cli"))
-
-	// Get and copy status from the KonfluxImageController CR (if enabled)
-	if konflux.Spec.IsImageControllerEnabled() {
-		imageController := &konfluxv1alpha1.KonfluxImageController{}
-		if err := r.Get(ctx, client.ObjectKey{Name: imagecontroller.CRName}, imageController); err != nil {
-			return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxImageController status")
+	var subCRStatuses []condition.SubCRStatus
+	var components []konfluxv1alpha1.ComponentStatus
+	var pendingUpgrade []string
+	for _, phase := range phases {
+		for _, c := range phase {
+			waitingFor := unreadyDependencies(c, ready)
+			switch {
+			case frozen:
+				tc.Keep(c.newObject())
+			case len(waitingFor) == 0 || tc.Planning():
+				if err := c.apply(ctx, r, tc, konflux); err != nil {
+					return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply "+c.kind)
+				}
+			default:
+				log.Info("Waiting for dependencies before applying component", "component", c.name, "waitingFor", waitingFor)
+				// Keep an already existing sub-CR out of orphan cleanup while its apply is deferred.
+				tc.Keep(c.newObject())
+				rollout.Blocked = append(rollout.Blocked, konfluxv1alpha1.BlockedComponent{
+					Name:       c.name,
+					WaitingFor: waitingFor,
+				})
+			}
+
+			// Get and copy status from the sub-CR. A blocked, frozen or planned sub-CR may not exist yet.
+			subCR := c.newObject()
+			if err := r.Get(ctx, client.ObjectKeyFromObject(subCR), subCR); err != nil &&
+				((len(waitingFor) == 0 && !frozen && !tc.Planning()) || !apierrors.IsNotFound(err)) {
+				return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get "+c.kind+" status")
+			}
+			status := condition.CopySubCRStatus(konflux, subCR, c.name)
+			ready[c.name] = status.Ready
+			subCRStatuses = append(subCRStatuses, status)
+			if !reconciledByRunningOperator(subCR) {
+				pendingUpgrade = append(pendingUpgrade, c.name)
+			}
+
+			componentStatus, err := r.componentStatus(ctx, c, subCR)
+			if err != nil {
+				return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "collect "+c.kind+" status")
+			}
+			components = append(components, componentStatus)
+
+			// Propagate UI URL from KonfluxUI status to Konflux status
+			if ui, ok := subCR.(*konfluxv1alpha1.KonfluxUI); ok && ui.Status.Ingress != nil {
+				konflux.Status.UIURL = ui.Status.Ingress.URL
+			}
 		}
-		subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, imageController, "image-controller"))
-	}
-
-	// Get and copy status from the KonfluxCertManager CR
-	certManager := &konfluxv1alpha1.KonfluxCertManager{}
-	if err := r.Get(ctx, client.ObjectKey{Name: certmanager.CRName}, certManager); err != nil {
-		return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxCertManager status")
 	}
-	subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, certManager, "cert-manager"))
-
-	// Get and copy status from the KonfluxInternalRegistry CR (if enabled)
-	if konflux.Spec.IsInternalRegistryEnabled() {
-		registry := &konfluxv1alpha1.KonfluxInternalRegistry{}
-		if err := r.Get(ctx, client.ObjectKey{Name: internalregistry.CRName}, registry); err != nil {
-			return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxInternalRegistry status")
+	if !frozen {
+		if err := r.applyImagePullSecrets(ctx, tc, konflux); err != nil {
+			return errHandler.HandleWithReason(ctx, err, condition.ReasonApplyFailed, "apply image pull secrets")
 		}
-		subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, registry, "internal-registry"))
 	}
+	rollout.Phase = currentPhase(phases, ready)
+	konflux.Status.Rollout = rollout
+	konflux.Status.Components = components
 
-	// Get and copy status from the KonfluxDefaultTenant CR (if enabled)
-	if konflux.Spec.IsDefaultTenantEnabled() {
-		defaultTenantCR := &konfluxv1alpha1.KonfluxDefaultTenant{}
-		if err := r.Get(ctx, client.ObjectKey{Name: defaulttenant.CRName}, defaultTenantCR); err != nil {
-			return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxDefaultTenant status")
+	// Cleanup orphaned sub-CRs - delete any sub-CRs with our owner label
+	// that weren't applied during this reconcile (e.g., disabled optional components)
+	if !frozen {
+		if err := tc.CleanupOrphans(ctx, constant.KonfluxOwnerLabel, konflux.Name, konfluxCleanupGVKs,
+			tracking.WithClusterScopedAllowList(konfluxClusterScopedAllowList),
+			tracking.WithDeletionPolicies(konfluxDeletionPolicies),
+			tracking.WithCleanupNamespaces(tracking.CleanupNamespaces{secretGVK: r.imagePullNamespaces(nil)})); err != nil {
+			return errHandler.HandleCleanupError(ctx, err)
 		}
-		subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, defaultTenantCR, "default-tenant"))
-	}
 
-	// Get and copy status from the KonfluxSegmentBridge CR (if enabled)
-	if konflux.Spec.IsTelemetryEnabled() {
-		segmentBridgeCR := &konfluxv1alpha1.KonfluxSegmentBridge{}
-		if err := r.Get(ctx, client.ObjectKey{Name: segmentbridge.CRName}, segmentBridgeCR); err != nil {
-			return errHandler.HandleWithReason(ctx, err, condition.ReasonSubCRStatusFailed, "get KonfluxSegmentBridge status")
+		if tc.Planning() {
+			return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, konflux, tc.Plan())
 		}
-		subCRStatuses = append(subCRStatuses, condition.CopySubCRStatus(konflux, segmentBridgeCR, "segment-bridge"))
+		condition.RecordCleanup(konflux, tc.CleanupResults())
 	}
 
 	// Set overall Ready condition based on all sub-CRs.
 	// All deployments are managed by component-specific reconcilers, so we only aggregate sub-CR statuses.
 	condition.SetAggregatedReadyCondition(konflux, subCRStatuses)
+	r.setPatchesMatchedCondition(konflux)
+	r.setImagesMirroredCondition(konflux)
 
 	// Check cert-manager availability, set CertManagerAvailable condition, and override Ready if missing.
 	certManagerResult := r.checkCertManagerAvailability(ctx, konflux)
 
+	if downgradeBlocked {
+		condition.SetCondition(konflux, metav1.Condition{
+			Type:    condition.TypeReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  condition.ReasonDowngradeBlocked,
+			Message: condition.DowngradeBlockedMessage(konflux.Status.InstalledVersion, version.Version),
+		})
+	} else if !paused {
+		if err := r.finishUpgrade(ctx, konflux, upgradePlan, pendingUpgrade); err != nil {
+			return errHandler.HandleWithReason(ctx, err, condition.ReasonUpgradeStepFailed, "run post-upgrade steps")
+		}
+	}
+
+	recorder.RecordReadyTransition(r.Recorder, konflux, previousReady)
+
 	// Update the status subresource with all collected conditions
 	if err := r.Status().Update(ctx, konflux); err != nil {
 		log.Error(err, "Failed to update Konflux status")
@@ -421,7 +434,12 @@ func (r *KonfluxReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 
 	// Requeue when cert-manager check failed (transient error) or cert-manager is missing,
 	// so we periodically re-run the check and status self-heals when cert-manager is installed.
-	return certManagerResult, nil
+	// Copies of spec.imagePullSecrets are refreshed periodically, as their sources are not watched.
+	result := certManagerResult
+	if len(konflux.Spec.ImagePullSecrets) > 0 && (result.RequeueAfter == 0 || result.RequeueAfter > imagePullSecretResyncInterval) {
+		result.RequeueAfter = imagePullSecretResyncInterval
+	}
+	return result, nil
 }
 
 // checkCertManagerAvailability checks if cert-manager CRDs are installed, sets the
@@ -466,13 +484,16 @@ func (r *KonfluxReconciler) checkCertManagerAvailability(
 		result = ctrl.Result{}
 	}
 	// Override Ready to False when cert-manager is explicitly missing (Unknown is allowed).
-	condition.OverrideReadyIfDependencyFalse(konflux, []condition.DependencyOverride{
+	override := condition.OverrideReadyIfDependencyFalse(konflux, []condition.DependencyOverride{
 		{
 			ConditionType: constant.ConditionTypeCertManagerAvailable,
 			Reason:        condition.ReasonCertManagerNotInstalled,
 			Message:       "cert-manager CRDs are not installed. Some components require cert-manager to function properly.",
 		},
 	})
+	if override != nil {
+		recorder.Warning(r.Recorder, konflux, override.Reason, recorder.ActionReconcile, override.Message)
+	}
 	return result
 }
 
@@ -485,6 +506,13 @@ func (r *KonfluxReconciler) applyKonfluxBuildService(ctx context.Context, tc *tr
 		spec.KonfluxBuildServiceConfigSpec = *owner.Spec.KonfluxBuildService.Spec
 	}
 	spec.ComponentMetrics = common.ForwardedComponentMetrics(owner)
+	spec.PodPlacement = common.ForwardedPodPlacement(owner, spec.PodPlacement)
+	spec.Logging = common.ForwardedLogging(owner, spec.GetLogging())
+	spec.Patches = r.forwardedPatches(owner, manifests.BuildService, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
+	spec.BuildControllerManager = forwardedControllerManager(owner, profileBuildControllerManager, spec.BuildControllerManager)
 
 	// Ensure PipelineConfig is always present in the SSA payload so the
 	// controller claims ownership. Combined with the atomic marker on
@@ -517,6 +545,13 @@ func (r *KonfluxReconciler) applyKonfluxIntegrationService(ctx context.Context,
 		spec.KonfluxIntegrationServiceConfigSpec = *owner.Spec.KonfluxIntegrationService.Spec
 	}
 	spec.ComponentMetrics = common.ForwardedComponentMetrics(owner)
+	spec.PodPlacement = common.ForwardedPodPlacement(owner, spec.PodPlacement)
+	spec.Logging = common.ForwardedLogging(owner, spec.Logging)
+	spec.Patches = r.forwardedPatches(owner, manifests.Integration, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
+	spec.IntegrationControllerManager = forwardedControllerManager(owner, profileIntegrationControllerManager, spec.IntegrationControllerManager)
 
 	integrationService := &konfluxv1alpha1.KonfluxIntegrationService{
 		TypeMeta: metav1.TypeMeta{
@@ -542,6 +577,13 @@ func (r *KonfluxReconciler) applyKonfluxReleaseService(ctx context.Context, tc *
 		spec.KonfluxReleaseServiceConfigSpec = *owner.Spec.KonfluxReleaseService.Spec
 	}
 	spec.ComponentMetrics = common.ForwardedComponentMetrics(owner)
+	spec.PodPlacement = common.ForwardedPodPlacement(owner, spec.PodPlacement)
+	spec.Logging = common.ForwardedLogging(owner, spec.Logging)
+	spec.Patches = r.forwardedPatches(owner, manifests.Release, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
+	spec.ReleaseControllerManager = forwardedControllerManager(owner, profileReleaseControllerManager, spec.ReleaseControllerManager)
 
 	releaseService := &konfluxv1alpha1.KonfluxReleaseService{
 		TypeMeta: metav1.TypeMeta{
@@ -566,6 +608,14 @@ func (r *KonfluxReconciler) applyKonfluxUI(ctx context.Context, tc *tracking.Cli
 		spec.KonfluxUIConfigSpec = *owner.Spec.KonfluxUI.Spec
 	}
 	spec.ComponentMetrics = common.ForwardedComponentMetrics(owner)
+	spec.PodPlacement = common.ForwardedPodPlacement(owner, spec.PodPlacement)
+	spec.Logging = common.ForwardedLogging(owner, spec.Logging)
+	spec.Patches = r.forwardedPatches(owner, manifests.UI, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
+	spec.Proxy = forwardedUIProxyDeployment(owner, spec.Proxy)
+	spec.Dex = forwardedDexDeployment(owner, spec.Dex)
 
 	ui := &konfluxv1alpha1.KonfluxUI{
 		TypeMeta: metav1.TypeMeta{
@@ -583,7 +633,7 @@ func (r *KonfluxReconciler) applyKonfluxUI(ctx context.Context, tc *tracking.Cli
 }
 
 // applyKonfluxRBAC creates or updates the KonfluxRBAC CR.
-func (r *KonfluxReconciler) applyKonfluxRBAC(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxReconciler) applyKonfluxRBAC(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
 	log := logf.FromContext(ctx)
 
 	konfluxRBAC := &konfluxv1alpha1.KonfluxRBAC{
@@ -594,6 +644,12 @@ func (r *KonfluxReconciler) applyKonfluxRBAC(ctx context.Context, tc *tracking.C
 		ObjectMeta: metav1.ObjectMeta{
 			Name: rbac.CRName,
 		},
+		Spec: konfluxv1alpha1.KonfluxRBACSpec{
+			Patches:              r.forwardedPatches(owner, manifests.RBAC, nil),
+			ImageRegistryMirrors: forwardedImageRegistryMirrors(owner),
+			ImagePull:            forwardedImagePull(owner),
+			EgressProxy:          forwardedEgressProxy(owner),
+		},
 	}
 
 	log.Info("Applying KonfluxRBAC CR", "name", konfluxRBAC.Name)
@@ -608,6 +664,10 @@ func (r *KonfluxReconciler) applyKonfluxInfo(ctx context.Context, tc *tracking.C
 	if owner.Spec.KonfluxInfo != nil && owner.Spec.KonfluxInfo.Spec != nil {
 		spec = *owner.Spec.KonfluxInfo.Spec
 	}
+	spec.Patches = r.forwardedPatches(owner, manifests.Info, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
 
 	// Normalize Banner field to prevent empty banner array from being serialized
 	if spec.Banner != nil && (spec.Banner.Items == nil || len(*spec.Banner.Items) == 0) {
@@ -637,6 +697,13 @@ func (r *KonfluxReconciler) applyKonfluxNamespaceLister(ctx context.Context, tc
 	if owner.Spec.NamespaceLister != nil && owner.Spec.NamespaceLister.Spec != nil {
 		spec = *owner.Spec.NamespaceLister.Spec
 	}
+	spec.PodPlacement = common.ForwardedPodPlacement(owner, spec.PodPlacement)
+	spec.Logging = common.ForwardedLogging(owner, spec.GetLogging())
+	spec.Patches = r.forwardedPatches(owner, manifests.NamespaceLister, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
+	spec.NamespaceLister = forwardedNamespaceListerDeployment(owner, spec.NamespaceLister)
 
 	konfluxNamespaceLister := &konfluxv1alpha1.KonfluxNamespaceLister{
 		TypeMeta: metav1.TypeMeta{
@@ -661,6 +728,10 @@ func (r *KonfluxReconciler) applyKonfluxEnterpriseContract(ctx context.Context,
 	if owner.Spec.EnterpriseContract != nil {
 		spec.SkipPolicies = owner.Spec.EnterpriseContract.SkipPolicies
 	}
+	spec.Patches = r.forwardedPatches(owner, manifests.EnterpriseContract, nil)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
 
 	konfluxEnterpriseContract := &konfluxv1alpha1.KonfluxEnterpriseContract{
 		TypeMeta: metav1.TypeMeta{
@@ -678,7 +749,7 @@ func (r *KonfluxReconciler) applyKonfluxEnterpriseContract(ctx context.Context,
 }
 
 // applyKonfluxApplicationAPI creates or updates the KonfluxApplicationAPI CR.
-func (r *KonfluxReconciler) applyKonfluxApplicationAPI(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxReconciler) applyKonfluxApplicationAPI(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
 	log := logf.FromContext(ctx)
 
 	applicationAPI := &konfluxv1alpha1.KonfluxApplicationAPI{
@@ -689,6 +760,12 @@ func (r *KonfluxReconciler) applyKonfluxApplicationAPI(ctx context.Context, tc *
 		ObjectMeta: metav1.ObjectMeta{
 			Name: applicationapi.CRName,
 		},
+		Spec: konfluxv1alpha1.KonfluxApplicationAPISpec{
+			Patches:              r.forwardedPatches(owner, manifests.ApplicationAPI, nil),
+			ImageRegistryMirrors: forwardedImageRegistryMirrors(owner),
+			ImagePull:            forwardedImagePull(owner),
+			EgressProxy:          forwardedEgressProxy(owner),
+		},
 	}
 
 	log.Info("Applying KonfluxApplicationAPI CR", "name", applicationAPI.Name)
@@ -705,6 +782,13 @@ func (r *KonfluxReconciler) applyKonfluxImageController(ctx context.Context, tc
 		spec.KonfluxImageControllerConfigSpec = *owner.Spec.ImageController.Spec
 	}
 	spec.ComponentMetrics = common.ForwardedComponentMetrics(owner)
+	spec.PodPlacement = common.ForwardedPodPlacement(owner, spec.PodPlacement)
+	spec.Logging = common.ForwardedLogging(owner, spec.GetLogging())
+	spec.Patches = r.forwardedPatches(owner, manifests.ImageController, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
+	spec.ImageControllerManager = forwardedControllerManager(owner, profileImageControllerManager, spec.ImageControllerManager)
 
 	imageController := &konfluxv1alpha1.KonfluxImageController{
 		TypeMeta: metav1.TypeMeta{
@@ -729,6 +813,10 @@ func (r *KonfluxReconciler) applyKonfluxCertManager(ctx context.Context, tc *tra
 	if owner.Spec.CertManager != nil {
 		spec.CreateClusterIssuer = owner.Spec.CertManager.CreateClusterIssuer
 	}
+	spec.Patches = r.forwardedPatches(owner, manifests.CertManager, nil)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
 
 	certManager := &konfluxv1alpha1.KonfluxCertManager{
 		TypeMeta: metav1.TypeMeta{
@@ -747,9 +835,19 @@ func (r *KonfluxReconciler) applyKonfluxCertManager(ctx context.Context, tc *tra
 
 // applyKonfluxInternalRegistry creates or updates the KonfluxInternalRegistry CR.
 // The caller is responsible for checking if internal registry is enabled.
-func (r *KonfluxReconciler) applyKonfluxInternalRegistry(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxReconciler) applyKonfluxInternalRegistry(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
 	log := logf.FromContext(ctx)
 
+	var spec konfluxv1alpha1.KonfluxInternalRegistrySpec
+	if owner.Spec.InternalRegistry != nil && owner.Spec.InternalRegistry.Spec != nil {
+		spec = *owner.Spec.InternalRegistry.Spec
+	}
+	spec.PodPlacement = common.ForwardedPodPlacement(owner, spec.PodPlacement)
+	spec.Patches = r.forwardedPatches(owner, manifests.Registry, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
+
 	registry := &konfluxv1alpha1.KonfluxInternalRegistry{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: konfluxv1alpha1.GroupVersion.String(),
@@ -758,6 +856,7 @@ func (r *KonfluxReconciler) applyKonfluxInternalRegistry(ctx context.Context, tc
 		ObjectMeta: metav1.ObjectMeta{
 			Name: internalregistry.CRName,
 		},
+		Spec: spec,
 	}
 
 	log.Info("Applying KonfluxInternalRegistry CR", "name", registry.Name)
@@ -766,7 +865,7 @@ func (r *KonfluxReconciler) applyKonfluxInternalRegistry(ctx context.Context, tc
 
 // applyKonfluxDefaultTenant creates or updates the KonfluxDefaultTenant CR.
 // The caller is responsible for checking if default tenant is enabled.
-func (r *KonfluxReconciler) applyKonfluxDefaultTenant(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxReconciler) applyKonfluxDefaultTenant(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
 	log := logf.FromContext(ctx)
 
 	defaultTenantCR := &konfluxv1alpha1.KonfluxDefaultTenant{
@@ -777,6 +876,12 @@ func (r *KonfluxReconciler) applyKonfluxDefaultTenant(ctx context.Context, tc *t
 		ObjectMeta: metav1.ObjectMeta{
 			Name: defaulttenant.CRName,
 		},
+		Spec: konfluxv1alpha1.KonfluxDefaultTenantSpec{
+			Patches:              r.forwardedPatches(owner, manifests.DefaultTenant, nil),
+			ImageRegistryMirrors: forwardedImageRegistryMirrors(owner),
+			ImagePull:            forwardedImagePull(owner),
+			EgressProxy:          forwardedEgressProxy(owner),
+		},
 	}
 
 	log.Info("Applying KonfluxDefaultTenant CR", "name", defaultTenantCR.Name)
@@ -792,6 +897,11 @@ func (r *KonfluxReconciler) applyKonfluxSegmentBridge(ctx context.Context, tc *t
 	if owner.Spec.Telemetry != nil && owner.Spec.Telemetry.Spec != nil {
 		spec = *owner.Spec.Telemetry.Spec
 	}
+	spec.PodPlacement = common.ForwardedPodPlacement(owner, spec.PodPlacement)
+	spec.Patches = r.forwardedPatches(owner, manifests.SegmentBridge, spec.Patches)
+	spec.ImageRegistryMirrors = forwardedImageRegistryMirrors(owner)
+	spec.ImagePull = forwardedImagePull(owner)
+	spec.EgressProxy = forwardedEgressProxy(owner)
 
 	segmentBridgeCR := &konfluxv1alpha1.KonfluxSegmentBridge{
 		TypeMeta: metav1.TypeMeta{
@@ -809,7 +919,7 @@ func (r *KonfluxReconciler) applyKonfluxSegmentBridge(ctx context.Context, tc *t
 }
 
 // applyKonfluxCLI creates or updates the KonfluxCLI CR.
-func (r *KonfluxReconciler) applyKonfluxCLI(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxReconciler) applyKonfluxCLI(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
 	log := logf.FromContext(ctx)
 
 	cliCR := &konfluxv1alpha1.KonfluxCLI{
@@ -820,6 +930,12 @@ func (r *KonfluxReconciler) applyKonfluxCLI(ctx context.Context, tc *tracking.Cl
 		ObjectMeta: metav1.ObjectMeta{
 			Name: clictrl.CRName,
 		},
+		Spec: konfluxv1alpha1.KonfluxCLISpec{
+			Patches:              r.forwardedPatches(owner, manifests.CLI, nil),
+			ImageRegistryMirrors: forwardedImageRegistryMirrors(owner),
+			ImagePull:            forwardedImagePull(owner),
+			EgressProxy:          forwardedEgressProxy(owner),
+		},
 	}
 
 	log.Info("Applying KonfluxCLI CR", "name", cliCR.Name)
@@ -828,7 +944,7 @@ func (r *KonfluxReconciler) applyKonfluxCLI(ctx context.Context, tc *tracking.Cl
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *KonfluxReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&konfluxv1alpha1.Konflux{}).
 		Named("konflux").
 		// Watch sub-CRs for status changes to aggregate conditions on the parent Konflux CR
@@ -860,6 +976,24 @@ func (r *KonfluxReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		// Watch KonfluxDefaultTenant for any changes to copy conditions to Konflux CR
 		Owns(&konfluxv1alpha1.KonfluxDefaultTenant{}).
 		Owns(&konfluxv1alpha1.KonfluxSegmentBridge{}).
-		Owns(&konfluxv1alpha1.KonfluxCLI{}).
-		Complete(r)
+		Owns(&konfluxv1alpha1.KonfluxCLI{})
+
+	// Recreate deleted copies of spec.imagePullSecrets
+	if namespaces := r.imagePullNamespaces(nil); len(namespaces) > 0 {
+		secretCache, err := newImagePullSecretCache(mgr, namespaces)
+		if err != nil {
+			return err
+		}
+		controllerBuilder = controllerBuilder.WatchesRawSource(source.Kind(secretCache, &corev1.Secret{},
+			handler.TypedEnqueueRequestForOwner[*corev1.Secret](mgr.GetScheme(), mgr.GetRESTMapper(),
+				&konfluxv1alpha1.Konflux{}, handler.OnlyControllerOwner())))
+	}
+
+	// Follow the cluster-wide Proxy only on OpenShift
+	if r.isOpenShift() {
+		controllerBuilder = controllerBuilder.
+			Watches(&configv1.Proxy{}, handler.EnqueueRequestsFromMapFunc(r.mapClusterProxyToKonflux))
+	}
+
+	return controllerBuilder.Complete(r)
 }
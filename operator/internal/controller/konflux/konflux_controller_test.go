@@ -18,6 +18,7 @@ package konflux
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -32,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/applicationapi"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/buildservice"
@@ -51,6 +53,7 @@ import (
 	uictrl "github.com/konflux-ci/konflux-ci/operator/internal/controller/ui"
 	"github.com/konflux-ci/konflux-ci/operator/internal/operatormetrics"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
 
 var _ = Describe("Konflux Controller", func() {
@@ -97,6 +100,35 @@ var _ = Describe("Konflux Controller", func() {
 		})
 	}
 
+	// markSubCRsReady sets Ready=True on the given sub-CRs once they exist. The component
+	// reconcilers do not run in these tests, so this stands in for them to let the rollout
+	// proceed to the components that depend on these sub-CRs.
+	markSubCRsReady := func(ctx context.Context, objs ...konfluxv1alpha1.ConditionAccessor) {
+		for _, obj := range objs {
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+				conditions := obj.GetConditions()
+				apimeta.SetStatusCondition(&conditions, metav1.Condition{
+					Type:    constant.ConditionTypeReady,
+					Status:  metav1.ConditionTrue,
+					Reason:  "TestReady",
+					Message: "marked ready by test",
+				})
+				obj.SetConditions(conditions)
+				g.Expect(k8sClient.Status().Update(ctx, obj)).To(Succeed())
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+		}
+	}
+
+	// markPrerequisitesReady marks every component that other components depend on as Ready.
+	markPrerequisitesReady := func(ctx context.Context) {
+		markSubCRsReady(ctx,
+			&konfluxv1alpha1.KonfluxApplicationAPI{ObjectMeta: metav1.ObjectMeta{Name: applicationapi.CRName}},
+			&konfluxv1alpha1.KonfluxUI{ObjectMeta: metav1.ObjectMeta{Name: uictrl.CRName}},
+			&konfluxv1alpha1.KonfluxCertManager{ObjectMeta: metav1.ObjectMeta{Name: certmanager.CRName}},
+		)
+	}
+
 	Context("When reconciling a resource", func() {
 		It("should successfully reconcile the resource", func(ctx context.Context) {
 			startManager(createTestClusterInfo())
@@ -104,6 +136,7 @@ var _ = Describe("Konflux Controller", func() {
 			cr := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: CRName}}
 			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
 			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
 
 			// The Ready condition is written only after the reconciler completes all apply/get/status steps
 			// without an early error return. Its presence (regardless of True/False) is therefore a reliable
@@ -142,6 +175,81 @@ var _ = Describe("Konflux Controller", func() {
 		})
 	})
 
+	Context("Phased rollout", func() {
+		expectNotFound := func(ctx context.Context, g Gomega, obj client.Object) {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+			g.Expect(errors.IsNotFound(err)).To(BeTrue(), "expected %s to not exist, got: %v", obj.GetName(), err)
+		}
+
+		It("should apply dependent components only after their dependencies are Ready", func(ctx context.Context) {
+			startManager(createTestClusterInfo())
+
+			enabled := true
+			cr := &konfluxv1alpha1.Konflux{
+				ObjectMeta: metav1.ObjectMeta{Name: CRName},
+				Spec: konfluxv1alpha1.KonfluxSpec{
+					InternalRegistry: &konfluxv1alpha1.InternalRegistryConfig{Enabled: &enabled},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
+			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+
+			By("holding back the second phase while its dependencies are not Ready")
+			Eventually(func(g Gomega) {
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: CRName}, updated)).To(Succeed())
+				g.Expect(updated.Status.Rollout).NotTo(BeNil())
+				g.Expect(updated.Status.Rollout.Phase).To(Equal(int32(1)))
+				g.Expect(updated.Status.Rollout.TotalPhases).To(Equal(int32(2)))
+				g.Expect(updated.Status.Rollout.Blocked).To(ConsistOf(
+					konfluxv1alpha1.BlockedComponent{Name: "build-service", WaitingFor: []string{"application-api"}},
+					konfluxv1alpha1.BlockedComponent{Name: "integration-service", WaitingFor: []string{"application-api", "ui"}},
+					konfluxv1alpha1.BlockedComponent{Name: "release-service", WaitingFor: []string{"application-api"}},
+					konfluxv1alpha1.BlockedComponent{Name: "internal-registry", WaitingFor: []string{"cert-manager"}},
+				))
+
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: applicationapi.CRName}, &konfluxv1alpha1.KonfluxApplicationAPI{})).To(Succeed())
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: uictrl.CRName}, &konfluxv1alpha1.KonfluxUI{})).To(Succeed())
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: certmanager.CRName}, &konfluxv1alpha1.KonfluxCertManager{})).To(Succeed())
+				expectNotFound(ctx, g, &konfluxv1alpha1.KonfluxBuildService{ObjectMeta: metav1.ObjectMeta{Name: buildservice.CRName}})
+				expectNotFound(ctx, g, &konfluxv1alpha1.KonfluxIntegrationService{ObjectMeta: metav1.ObjectMeta{Name: integrationservice.CRName}})
+				expectNotFound(ctx, g, &konfluxv1alpha1.KonfluxReleaseService{ObjectMeta: metav1.ObjectMeta{Name: releaseservice.CRName}})
+				expectNotFound(ctx, g, &konfluxv1alpha1.KonfluxInternalRegistry{ObjectMeta: metav1.ObjectMeta{Name: internalregistry.CRName}})
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+
+			By("applying build and release services once application-api is Ready")
+			markSubCRsReady(ctx, &konfluxv1alpha1.KonfluxApplicationAPI{ObjectMeta: metav1.ObjectMeta{Name: applicationapi.CRName}})
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: buildservice.CRName}, &konfluxv1alpha1.KonfluxBuildService{})).To(Succeed())
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: releaseservice.CRName}, &konfluxv1alpha1.KonfluxReleaseService{})).To(Succeed())
+				expectNotFound(ctx, g, &konfluxv1alpha1.KonfluxIntegrationService{ObjectMeta: metav1.ObjectMeta{Name: integrationservice.CRName}})
+
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: CRName}, updated)).To(Succeed())
+				g.Expect(updated.Status.Rollout).NotTo(BeNil())
+				g.Expect(updated.Status.Rollout.Blocked).To(ConsistOf(
+					konfluxv1alpha1.BlockedComponent{Name: "integration-service", WaitingFor: []string{"ui"}},
+					konfluxv1alpha1.BlockedComponent{Name: "internal-registry", WaitingFor: []string{"cert-manager"}},
+				))
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+
+			By("applying the remaining components once all dependencies are Ready")
+			markSubCRsReady(ctx,
+				&konfluxv1alpha1.KonfluxUI{ObjectMeta: metav1.ObjectMeta{Name: uictrl.CRName}},
+				&konfluxv1alpha1.KonfluxCertManager{ObjectMeta: metav1.ObjectMeta{Name: certmanager.CRName}},
+			)
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: integrationservice.CRName}, &konfluxv1alpha1.KonfluxIntegrationService{})).To(Succeed())
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: internalregistry.CRName}, &konfluxv1alpha1.KonfluxInternalRegistry{})).To(Succeed())
+
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: CRName}, updated)).To(Succeed())
+				g.Expect(updated.Status.Rollout).NotTo(BeNil())
+				g.Expect(updated.Status.Rollout.Blocked).To(BeEmpty())
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+		})
+	})
+
 	Context("Konflux Name Validation (CEL)", func() {
 		const requiredKonfluxName = "konflux"
 
@@ -252,6 +360,7 @@ var _ = Describe("Konflux Controller", func() {
 			}
 			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
 			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
 
 			By("verifying InternalRegistry CR was created")
 			registry := &konfluxv1alpha1.KonfluxInternalRegistry{}
@@ -261,7 +370,7 @@ var _ = Describe("Konflux Controller", func() {
 			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
 		})
 
-		It("should delete InternalRegistry CR when enabled changes from true to false", func(ctx context.Context) {
+		It("should keep InternalRegistry CR when disabled until its deletion is confirmed", func(ctx context.Context) {
 			startManager(createTestClusterInfo())
 
 			By("creating Konflux CR with internalRegistry.enabled=true")
@@ -274,6 +383,7 @@ var _ = Describe("Konflux Controller", func() {
 			}
 			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
 			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
 
 			By("waiting for InternalRegistry CR to be created")
 			Eventually(func(g Gomega) {
@@ -288,11 +398,32 @@ var _ = Describe("Konflux Controller", func() {
 			updatedKonflux.Spec.InternalRegistry = &konfluxv1alpha1.InternalRegistryConfig{Enabled: &disabled}
 			Expect(k8sClient.Update(ctx, updatedKonflux)).To(Succeed())
 
+			By("waiting for the Konflux CR to report the pending deletion")
+			Eventually(func(g Gomega) {
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				pending := apimeta.FindStatusCondition(updated.GetConditions(), condition.TypeDeletionPending)
+				g.Expect(pending).NotTo(BeNil())
+				g.Expect(pending.Reason).To(Equal(condition.ReasonDeletionConfirmationRequired))
+				g.Expect(pending.Message).To(ContainSubstring("KonfluxInternalRegistry/" + internalregistry.CRName))
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+			kept := &konfluxv1alpha1.KonfluxInternalRegistry{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: internalregistry.CRName}, kept)).To(Succeed())
+			Expect(kept.DeletionTimestamp).To(BeNil())
+
+			By("confirming the deletion of the InternalRegistry CR")
+			kept.Annotations = map[string]string{tracking.ConfirmDeletionAnnotation: "true"}
+			Expect(k8sClient.Update(ctx, kept)).To(Succeed())
+
 			By("waiting for InternalRegistry CR to be deleted")
 			Eventually(func(g Gomega) {
 				err := k8sClient.Get(ctx, types.NamespacedName{Name: internalregistry.CRName},
 					&konfluxv1alpha1.KonfluxInternalRegistry{})
-				g.Expect(errors.IsNotFound(err)).To(BeTrue(), "InternalRegistry CR should be deleted when enabled changes to false")
+				g.Expect(errors.IsNotFound(err)).To(BeTrue(), "InternalRegistry CR should be deleted once its deletion is confirmed")
+
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				g.Expect(apimeta.FindStatusCondition(updated.GetConditions(), condition.TypeDeletionPending)).To(BeNil())
 			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
 		})
 	})
@@ -364,7 +495,7 @@ var _ = Describe("Konflux Controller", func() {
 			Expect(errors.IsNotFound(err)).To(BeTrue(), "DefaultTenant CR should not exist when enabled=false")
 		})
 
-		It("should delete DefaultTenant CR when enabled changes from true to false", func(ctx context.Context) {
+		It("should keep DefaultTenant CR when disabled until its deletion is confirmed", func(ctx context.Context) {
 			startManager(createTestClusterInfo())
 
 			By("creating Konflux CR with defaultTenant.enabled=true")
@@ -391,11 +522,32 @@ var _ = Describe("Konflux Controller", func() {
 			updatedKonflux.Spec.DefaultTenant = &konfluxv1alpha1.DefaultTenantConfig{Enabled: &disabled}
 			Expect(k8sClient.Update(ctx, updatedKonflux)).To(Succeed())
 
+			By("waiting for the Konflux CR to report the pending deletion")
+			Eventually(func(g Gomega) {
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				pending := apimeta.FindStatusCondition(updated.GetConditions(), condition.TypeDeletionPending)
+				g.Expect(pending).NotTo(BeNil())
+				g.Expect(pending.Reason).To(Equal(condition.ReasonDeletionConfirmationRequired))
+				g.Expect(pending.Message).To(ContainSubstring("KonfluxDefaultTenant/" + defaulttenant.CRName))
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+			kept := &konfluxv1alpha1.KonfluxDefaultTenant{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: defaulttenant.CRName}, kept)).To(Succeed())
+			Expect(kept.DeletionTimestamp).To(BeNil())
+
+			By("confirming the deletion of the DefaultTenant CR")
+			kept.Annotations = map[string]string{tracking.ConfirmDeletionAnnotation: "true"}
+			Expect(k8sClient.Update(ctx, kept)).To(Succeed())
+
 			By("waiting for DefaultTenant CR to be deleted")
 			Eventually(func(g Gomega) {
 				err := k8sClient.Get(ctx, types.NamespacedName{Name: defaulttenant.CRName},
 					&konfluxv1alpha1.KonfluxDefaultTenant{})
-				g.Expect(errors.IsNotFound(err)).To(BeTrue(), "DefaultTenant CR should be deleted when enabled changes to false")
+				g.Expect(errors.IsNotFound(err)).To(BeTrue(), "DefaultTenant CR should be deleted once its deletion is confirmed")
+
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				g.Expect(apimeta.FindStatusCondition(updated.GetConditions(), condition.TypeDeletionPending)).To(BeNil())
 			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
 		})
 	})
@@ -542,6 +694,153 @@ var _ = Describe("Konflux Controller", func() {
 		})
 	})
 
+	Context("Component enablement", func() {
+		const resourceName = "konflux"
+
+		It("should not create sub-CRs of disabled components", func(ctx context.Context) {
+			startManager(createTestClusterInfo())
+
+			disabled := false
+			cr := &konfluxv1alpha1.Konflux{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName},
+				Spec: konfluxv1alpha1.KonfluxSpec{
+					KonfluxReleaseService: &konfluxv1alpha1.ReleaseServiceConfig{Enabled: &disabled},
+					EnterpriseContract:    &konfluxv1alpha1.EnterpriseContractConfig{Enabled: &disabled},
+					CLI:                   &konfluxv1alpha1.CLIConfig{Enabled: &disabled},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
+			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
+
+			By("waiting for the enabled components to be rolled out")
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: buildservice.CRName}, &konfluxv1alpha1.KonfluxBuildService{})).To(Succeed())
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+
+			By("verifying the disabled components were not created")
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: releaseservice.CRName}, &konfluxv1alpha1.KonfluxReleaseService{})
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "ReleaseService CR should not exist when disabled")
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: enterprisecontract.CRName}, &konfluxv1alpha1.KonfluxEnterpriseContract{})
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "EnterpriseContract CR should not exist when disabled")
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: cli.CRName}, &konfluxv1alpha1.KonfluxCLI{})
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "CLI CR should not exist when disabled")
+
+			By("verifying only enabled components are reported in status.components")
+			Eventually(func(g Gomega) {
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				var names []string
+				for _, c := range updated.Status.Components {
+					names = append(names, c.Name)
+				}
+				g.Expect(names).To(ContainElement("build-service"))
+				g.Expect(names).NotTo(ContainElements("release-service", "enterprise-contract", "cli"))
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+		})
+
+		It("should delete the sub-CR and its conditions when a component is disabled", func(ctx context.Context) {
+			startManager(createTestClusterInfo())
+
+			cr := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: resourceName}}
+			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
+			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
+
+			By("waiting for the ReleaseService CR to be created")
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: releaseservice.CRName},
+					&konfluxv1alpha1.KonfluxReleaseService{})).To(Succeed())
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+			markSubCRsReady(ctx, &konfluxv1alpha1.KonfluxReleaseService{ObjectMeta: metav1.ObjectMeta{Name: releaseservice.CRName}})
+			Eventually(func(g Gomega) {
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				g.Expect(apimeta.FindStatusCondition(updated.GetConditions(), "release-service.Ready")).NotTo(BeNil())
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+
+			By("disabling release-service")
+			updatedKonflux := &konfluxv1alpha1.Konflux{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updatedKonflux)).To(Succeed())
+			disabled := false
+			updatedKonflux.Spec.KonfluxReleaseService = &konfluxv1alpha1.ReleaseServiceConfig{Enabled: &disabled}
+			Expect(k8sClient.Update(ctx, updatedKonflux)).To(Succeed())
+
+			By("waiting for the ReleaseService CR and its conditions to be removed")
+			Eventually(func(g Gomega) {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: releaseservice.CRName},
+					&konfluxv1alpha1.KonfluxReleaseService{})
+				g.Expect(errors.IsNotFound(err)).To(BeTrue(), "ReleaseService CR should be deleted when disabled")
+
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				g.Expect(apimeta.FindStatusCondition(updated.GetConditions(), "release-service.Ready")).To(BeNil())
+				ready := apimeta.FindStatusCondition(updated.GetConditions(), constant.ConditionTypeReady)
+				g.Expect(ready).NotTo(BeNil())
+				g.Expect(ready.Message).NotTo(ContainSubstring("release-service"))
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+		})
+	})
+
+	Context("Paused reconciliation", func() {
+		const resourceName = "konflux"
+
+		It("should leave sub-CRs alone while paused and resume afterwards", func(ctx context.Context) {
+			startManager(createTestClusterInfo())
+
+			cr := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: resourceName}}
+			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
+			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
+
+			By("waiting for the ReleaseService CR to be created")
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: releaseservice.CRName},
+					&konfluxv1alpha1.KonfluxReleaseService{})).To(Succeed())
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+
+			By("pausing the Konflux CR and disabling release-service")
+			updatedKonflux := &konfluxv1alpha1.Konflux{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updatedKonflux)).To(Succeed())
+			updatedKonflux.Annotations = map[string]string{constant.KonfluxPausedAnnotation: "true"}
+			disabled := false
+			updatedKonflux.Spec.KonfluxReleaseService = &konfluxv1alpha1.ReleaseServiceConfig{Enabled: &disabled}
+			Expect(k8sClient.Update(ctx, updatedKonflux)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				paused := apimeta.FindStatusCondition(updated.GetConditions(), "Paused")
+				g.Expect(paused).NotTo(BeNil())
+				g.Expect(paused.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(apimeta.FindStatusCondition(updated.GetConditions(), constant.ConditionTypeReady)).NotTo(BeNil())
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+			Expect(prometheustestutil.ToFloat64(operatormetrics.ComponentPausedGauge("konflux"))).To(Equal(float64(1)))
+
+			By("verifying the ReleaseService CR is not cleaned up while paused")
+			Consistently(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: releaseservice.CRName},
+					&konfluxv1alpha1.KonfluxReleaseService{})).To(Succeed())
+			}).WithTimeout(2 * time.Second).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+
+			By("resuming reconciliation")
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updatedKonflux)).To(Succeed())
+			delete(updatedKonflux.Annotations, constant.KonfluxPausedAnnotation)
+			Expect(k8sClient.Update(ctx, updatedKonflux)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: releaseservice.CRName},
+					&konfluxv1alpha1.KonfluxReleaseService{})
+				g.Expect(errors.IsNotFound(err)).To(BeTrue(), "ReleaseService CR should be deleted once resumed")
+
+				updated := &konfluxv1alpha1.Konflux{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updated)).To(Succeed())
+				g.Expect(apimeta.FindStatusCondition(updated.GetConditions(), "Paused")).To(BeNil())
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+			Expect(prometheustestutil.ToFloat64(operatormetrics.ComponentPausedGauge("konflux"))).To(Equal(float64(0)))
+		})
+	})
+
 	Context("ImageController spec propagation", func() {
 		const resourceName = "konflux"
 
@@ -660,6 +959,7 @@ var _ = Describe("Konflux Controller", func() {
 			}
 			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
 			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
 
 			Eventually(func(g Gomega) {
 				bs := &konfluxv1alpha1.KonfluxBuildService{}
@@ -697,6 +997,7 @@ var _ = Describe("Konflux Controller", func() {
 			}
 			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
 			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
 
 			Eventually(func(g Gomega) {
 				rs := &konfluxv1alpha1.KonfluxReleaseService{}
@@ -719,6 +1020,7 @@ var _ = Describe("Konflux Controller", func() {
 			}
 			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
 			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
 
 			Eventually(func(g Gomega) {
 				bs := &konfluxv1alpha1.KonfluxBuildService{}
@@ -740,6 +1042,48 @@ var _ = Describe("Konflux Controller", func() {
 		})
 	})
 
+	Context("PodPlacement propagation", func() {
+		const resourceName = "konflux"
+
+		It("should forward spec.podPlacement and apply per-component overrides", func(ctx context.Context) {
+			startManager(createTestClusterInfo())
+
+			cr := &konfluxv1alpha1.Konflux{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName},
+				Spec: konfluxv1alpha1.KonfluxSpec{
+					PodPlacement: &konfluxv1alpha1.PodPlacementSpec{
+						NodeSelector:      map[string]string{"node-role.kubernetes.io/infra": ""},
+						PriorityClassName: "konflux-critical",
+					},
+					KonfluxUI: &konfluxv1alpha1.KonfluxUIConfig{
+						Spec: &konfluxv1alpha1.KonfluxUIConfigSpec{
+							PodPlacement: &konfluxv1alpha1.PodPlacementSpec{
+								PriorityClassName: "konflux-ui",
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cr)).To(Succeed())
+			testutil.DeferCleanupParentAndChildren(k8sClient, cr, allSubCRs()...)
+			markPrerequisitesReady(ctx)
+
+			Eventually(func(g Gomega) {
+				bs := &konfluxv1alpha1.KonfluxBuildService{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: buildservice.CRName}, bs)).To(Succeed())
+				g.Expect(bs.Spec.PodPlacement).NotTo(BeNil())
+				g.Expect(bs.Spec.PodPlacement.NodeSelector).To(HaveKey("node-role.kubernetes.io/infra"))
+				g.Expect(bs.Spec.PodPlacement.PriorityClassName).To(Equal("konflux-critical"))
+
+				ui := &konfluxv1alpha1.KonfluxUI{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: uictrl.CRName}, ui)).To(Succeed())
+				g.Expect(ui.Spec.PodPlacement).NotTo(BeNil())
+				g.Expect(ui.Spec.PodPlacement.NodeSelector).To(HaveKey("node-role.kubernetes.io/infra"))
+				g.Expect(ui.Spec.PodPlacement.PriorityClassName).To(Equal("konflux-ui"))
+			}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+		})
+	})
+
 	Context("EnterpriseContract spec propagation", func() {
 		const resourceName = "konflux"
 
@@ -0,0 +1,192 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+// forwardedPatches returns the patches for a component CR: the spec.patches of the Konflux CR
+// that target the component's manifests, followed by the component's own patches.
+func (r *KonfluxReconciler) forwardedPatches(
+	owner *konfluxv1alpha1.Konflux,
+	component manifests.Component,
+	own []konfluxv1alpha1.ObjectPatch,
+) []konfluxv1alpha1.ObjectPatch {
+	var patches []konfluxv1alpha1.ObjectPatch
+	for _, patch := range owner.Spec.Patches {
+		if r.targetsComponent(component, patch.Target) {
+			patches = append(patches, *patch.DeepCopy())
+		}
+	}
+	for _, patch := range own {
+		patches = append(patches, *patch.DeepCopy())
+	}
+	return patches
+}
+
+// targetsComponent reports whether target selects an object of the component's embedded
+// manifests. Without an ObjectStore every target is assumed to match.
+func (r *KonfluxReconciler) targetsComponent(component manifests.Component, target konfluxv1alpha1.PatchTarget) bool {
+	if r.ObjectStore == nil {
+		return true
+	}
+	found, err := r.ObjectStore.Contains(component, func(obj client.Object) bool {
+		return customization.MatchesPatchTarget(obj, target)
+	})
+	return err == nil && found
+}
+
+// setPatchesMatchedCondition sets the PatchesMatched condition when the Konflux CR has patches,
+// listing those that match no object of an enabled component. Per-component patches only
+// match the objects of their component.
+func (r *KonfluxReconciler) setPatchesMatchedCondition(konflux *konfluxv1alpha1.Konflux) {
+	spec := &konflux.Spec
+	total := len(spec.Patches)
+	var unmatched []string
+	for i, patch := range spec.Patches {
+		if !slices.ContainsFunc(konfluxComponents, func(c component) bool {
+			return c.isEnabled(spec) && r.targetsComponent(c.manifest, patch.Target)
+		}) {
+			unmatched = append(unmatched, fmt.Sprintf("spec.patches[%d] (%s)", i, describePatchTarget(patch.Target)))
+		}
+	}
+	for _, c := range konfluxComponents {
+		if c.patches == nil || !c.isEnabled(spec) {
+			continue
+		}
+		patches := c.patches(spec)
+		total += len(patches)
+		for i, patch := range patches {
+			if !r.targetsComponent(c.manifest, patch.Target) {
+				unmatched = append(unmatched, fmt.Sprintf("%s patches[%d] (%s)", c.name, i, describePatchTarget(patch.Target)))
+			}
+		}
+	}
+	if total == 0 {
+		condition.CleanupStaleConditions(konflux, func(c metav1.Condition) bool {
+			return c.Type != condition.TypePatchesMatched
+		})
+		return
+	}
+
+	if len(unmatched) == 0 {
+		condition.SetCondition(konflux, metav1.Condition{
+			Type:    condition.TypePatchesMatched,
+			Status:  metav1.ConditionTrue,
+			Reason:  condition.ReasonAllPatchesMatched,
+			Message: fmt.Sprintf("All %d patch(es) match an object of the embedded manifests", total),
+		})
+		return
+	}
+	message := fmt.Sprintf("%d patch(es) match no object of the enabled components: %s",
+		len(unmatched), strings.Join(unmatched, "; "))
+	condition.SetCondition(konflux, metav1.Condition{
+		Type:    condition.TypePatchesMatched,
+		Status:  metav1.ConditionFalse,
+		Reason:  condition.ReasonPatchTargetNotFound,
+		Message: message,
+	})
+	recorder.Warning(r.Recorder, konflux, condition.ReasonPatchTargetNotFound, recorder.ActionReconcile, message)
+}
+
+func describePatchTarget(target konfluxv1alpha1.PatchTarget) string {
+	kind := target.Kind
+	if target.Group != "" {
+		kind = target.Kind + "." + target.Group
+	}
+	if target.Namespace != "" {
+		return kind + " " + target.Namespace + "/" + target.Name
+	}
+	return kind + " " + target.Name
+}
+
+// Accessors for the per-component patches of the Konflux CR.
+
+func buildServicePatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.KonfluxBuildService == nil || spec.KonfluxBuildService.Spec == nil {
+		return nil
+	}
+	return spec.KonfluxBuildService.Spec.Patches
+}
+
+func integrationServicePatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.KonfluxIntegrationService == nil || spec.KonfluxIntegrationService.Spec == nil {
+		return nil
+	}
+	return spec.KonfluxIntegrationService.Spec.Patches
+}
+
+func releaseServicePatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.KonfluxReleaseService == nil || spec.KonfluxReleaseService.Spec == nil {
+		return nil
+	}
+	return spec.KonfluxReleaseService.Spec.Patches
+}
+
+func uiPatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.KonfluxUI == nil || spec.KonfluxUI.Spec == nil {
+		return nil
+	}
+	return spec.KonfluxUI.Spec.Patches
+}
+
+func infoPatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.KonfluxInfo == nil || spec.KonfluxInfo.Spec == nil {
+		return nil
+	}
+	return spec.KonfluxInfo.Spec.Patches
+}
+
+func namespaceListerPatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.NamespaceLister == nil || spec.NamespaceLister.Spec == nil {
+		return nil
+	}
+	return spec.NamespaceLister.Spec.Patches
+}
+
+func imageControllerPatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.ImageController == nil || spec.ImageController.Spec == nil {
+		return nil
+	}
+	return spec.ImageController.Spec.Patches
+}
+
+func internalRegistryPatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.InternalRegistry == nil || spec.InternalRegistry.Spec == nil {
+		return nil
+	}
+	return spec.InternalRegistry.Spec.Patches
+}
+
+func segmentBridgePatches(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch {
+	if spec.Telemetry == nil || spec.Telemetry.Spec == nil {
+		return nil
+	}
+	return spec.Telemetry.Spec.Patches
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+func newPatchesReconciler(t *testing.T) *KonfluxReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	store, err := manifests.NewObjectStore(scheme)
+	gomega.NewWithT(t).Expect(err).NotTo(gomega.HaveOccurred())
+	return &KonfluxReconciler{ObjectStore: store}
+}
+
+var buildServiceDeploymentPatch = konfluxv1alpha1.ObjectPatch{
+	Target: konfluxv1alpha1.PatchTarget{
+		Group:     "apps",
+		Kind:      "Deployment",
+		Namespace: "build-service",
+		Name:      "build-service-controller-manager",
+	},
+	Patch: "metadata:\n  annotations:\n    example.com/patched: \"true\"\n",
+}
+
+var missingServicePatch = konfluxv1alpha1.ObjectPatch{
+	Target: konfluxv1alpha1.PatchTarget{Kind: "Service", Namespace: "build-service", Name: "does-not-exist"},
+	Patch:  "metadata: {}",
+}
+
+func TestForwardedPatches(t *testing.T) {
+	r := newPatchesReconciler(t)
+	own := konfluxv1alpha1.ObjectPatch{
+		Target: konfluxv1alpha1.PatchTarget{Kind: "ConfigMap", Name: "own"},
+		Patch:  "data: {}",
+	}
+	owner := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+		Patches: []konfluxv1alpha1.ObjectPatch{buildServiceDeploymentPatch, missingServicePatch},
+	}}
+
+	t.Run("global patches are routed to the component they target", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(r.forwardedPatches(owner, manifests.BuildService, []konfluxv1alpha1.ObjectPatch{own})).
+			To(gomega.Equal([]konfluxv1alpha1.ObjectPatch{buildServiceDeploymentPatch, own}))
+		g.Expect(r.forwardedPatches(owner, manifests.UI, nil)).To(gomega.BeEmpty())
+	})
+
+	t.Run("every patch is forwarded without an ObjectStore", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect((&KonfluxReconciler{}).forwardedPatches(owner, manifests.UI, nil)).To(gomega.HaveLen(2))
+	})
+}
+
+func TestSetPatchesMatchedCondition(t *testing.T) {
+	r := newPatchesReconciler(t)
+
+	t.Run("not set without patches", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{}
+		r.setPatchesMatchedCondition(konflux)
+		g.Expect(konflux.Status.Conditions).To(gomega.BeEmpty())
+	})
+
+	t.Run("removed when the patches are removed", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			Patches: []konfluxv1alpha1.ObjectPatch{buildServiceDeploymentPatch},
+		}}
+		r.setPatchesMatchedCondition(konflux)
+		konflux.Spec.Patches = nil
+		r.setPatchesMatchedCondition(konflux)
+		g.Expect(meta.FindStatusCondition(konflux.Status.Conditions, condition.TypePatchesMatched)).To(gomega.BeNil())
+	})
+
+	t.Run("true when every patch matches", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			Patches: []konfluxv1alpha1.ObjectPatch{buildServiceDeploymentPatch},
+		}}
+		r.setPatchesMatchedCondition(konflux)
+		cond := meta.FindStatusCondition(konflux.Status.Conditions, condition.TypePatchesMatched)
+		g.Expect(cond).NotTo(gomega.BeNil())
+		g.Expect(cond.Reason).To(gomega.Equal(condition.ReasonAllPatchesMatched))
+	})
+
+	t.Run("reports global and per-component patches that match nothing", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			Patches: []konfluxv1alpha1.ObjectPatch{missingServicePatch},
+			KonfluxUI: &konfluxv1alpha1.KonfluxUIConfig{Spec: &konfluxv1alpha1.KonfluxUIConfigSpec{
+				// Matches an object of build-service, not of the UI.
+				Patches: []konfluxv1alpha1.ObjectPatch{buildServiceDeploymentPatch},
+			}},
+		}}
+		r.setPatchesMatchedCondition(konflux)
+		cond := meta.FindStatusCondition(konflux.Status.Conditions, condition.TypePatchesMatched)
+		g.Expect(cond).NotTo(gomega.BeNil())
+		g.Expect(cond.Reason).To(gomega.Equal(condition.ReasonPatchTargetNotFound))
+		g.Expect(cond.Message).To(gomega.ContainSubstring("spec.patches[0] (Service build-service/does-not-exist)"))
+		g.Expect(cond.Message).To(gomega.ContainSubstring(
+			"ui patches[0] (Deployment.apps build-service/build-service-controller-manager)"))
+	})
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// profileDeployment identifies a Deployment that the profiles size.
+type profileDeployment string
+
+const (
+	profileBuildControllerManager       profileDeployment = "build-service"
+	profileImageControllerManager       profileDeployment = "image-controller"
+	profileIntegrationControllerManager profileDeployment = "integration-service"
+	profileReleaseControllerManager     profileDeployment = "release-service"
+	profileNamespaceLister              profileDeployment = "namespace-lister"
+	profileUIProxy                      profileDeployment = "ui-proxy"
+	profileDex                          profileDeployment = "dex"
+)
+
+// profileSizing is the sizing that a profile gives a Deployment.
+type profileSizing struct {
+	replicas int32
+	// resources are given to every container of the Deployment that the API exposes.
+	resources corev1.ResourceRequirements
+	// podDisruptionBudget creates a PodDisruptionBudget with the default maxUnavailable of 1.
+	podDisruptionBudget bool
+}
+
+// sized returns the resources with the given CPU and memory requests and limits.
+func sized(requestCPU, requestMemory, limitCPU, limitMemory string) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(requestCPU),
+			corev1.ResourceMemory: resource.MustParse(requestMemory),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(limitCPU),
+			corev1.ResourceMemory: resource.MustParse(limitMemory),
+		},
+	}
+}
+
+// profiles holds the sizing of every profile. Keep it in sync with the table in
+// docs/content/docs/guides/profiles.md.
+var profiles = map[konfluxv1alpha1.KonfluxProfile]map[profileDeployment]profileSizing{
+	konfluxv1alpha1.ProfileDev: {
+		profileBuildControllerManager:       {replicas: 1, resources: sized("10m", "64Mi", "500m", "512Mi")},
+		profileImageControllerManager:       {replicas: 1, resources: sized("10m", "32Mi", "200m", "128Mi")},
+		profileIntegrationControllerManager: {replicas: 1, resources: sized("10m", "64Mi", "500m", "256Mi")},
+		profileReleaseControllerManager:     {replicas: 1, resources: sized("10m", "64Mi", "500m", "256Mi")},
+		profileNamespaceLister:              {replicas: 1, resources: sized("10m", "32Mi", "100m", "128Mi")},
+		profileUIProxy:                      {replicas: 1, resources: sized("10m", "64Mi", "200m", "128Mi")},
+		profileDex:                          {replicas: 1, resources: sized("10m", "32Mi", "50m", "128Mi")},
+	},
+	konfluxv1alpha1.ProfileSmall: {
+		profileBuildControllerManager:       {replicas: 1, resources: sized("50m", "128Mi", "1", "1Gi")},
+		profileImageControllerManager:       {replicas: 1, resources: sized("20m", "64Mi", "500m", "256Mi")},
+		profileIntegrationControllerManager: {replicas: 1, resources: sized("50m", "128Mi", "1", "512Mi")},
+		profileReleaseControllerManager:     {replicas: 1, resources: sized("50m", "128Mi", "1", "512Mi")},
+		profileNamespaceLister:              {replicas: 1, resources: sized("20m", "64Mi", "200m", "256Mi")},
+		profileUIProxy:                      {replicas: 1, resources: sized("30m", "128Mi", "300m", "256Mi")},
+		profileDex:                          {replicas: 1, resources: sized("10m", "64Mi", "100m", "128Mi")},
+	},
+	konfluxv1alpha1.ProfileProductionHA: {
+		profileBuildControllerManager:       {replicas: 2, podDisruptionBudget: true, resources: sized("200m", "512Mi", "2", "2Gi")},
+		profileImageControllerManager:       {replicas: 2, podDisruptionBudget: true, resources: sized("50m", "128Mi", "500m", "512Mi")},
+		profileIntegrationControllerManager: {replicas: 2, podDisruptionBudget: true, resources: sized("200m", "256Mi", "2", "1Gi")},
+		profileReleaseControllerManager:     {replicas: 2, podDisruptionBudget: true, resources: sized("100m", "256Mi", "1", "1Gi")},
+		profileNamespaceLister:              {replicas: 2, podDisruptionBudget: true, resources: sized("100m", "128Mi", "500m", "512Mi")},
+		profileUIProxy:                      {replicas: 2, podDisruptionBudget: true, resources: sized("100m", "256Mi", "1", "512Mi")},
+		profileDex:                          {replicas: 2, podDisruptionBudget: true, resources: sized("50m", "64Mi", "200m", "256Mi")},
+	},
+}
+
+// profileSizingFor returns the sizing that the profile of owner gives deployment. It returns
+// false when owner does not set a profile.
+func profileSizingFor(owner *konfluxv1alpha1.Konflux, deployment profileDeployment) (profileSizing, bool) {
+	if owner == nil {
+		return profileSizing{}, false
+	}
+	sizing, ok := profiles[owner.Spec.Profile][deployment]
+	return sizing, ok
+}
+
+// forwardedControllerManager returns spec with the settings that it does not set taken from the
+// profile of owner.
+func forwardedControllerManager(
+	owner *konfluxv1alpha1.Konflux,
+	deployment profileDeployment,
+	spec *konfluxv1alpha1.ControllerManagerDeploymentSpec,
+) *konfluxv1alpha1.ControllerManagerDeploymentSpec {
+	sizing, ok := profileSizingFor(owner, deployment)
+	if !ok {
+		return spec
+	}
+	sized := spec.DeepCopy()
+	if sized == nil {
+		sized = &konfluxv1alpha1.ControllerManagerDeploymentSpec{}
+	}
+	sized.Replicas = sizing.replicasFor(sized.Replicas)
+	sized.Manager = sizing.containerFor(sized.Manager)
+	sized.PodDisruptionBudget = sizing.podDisruptionBudgetFor(sized.PodDisruptionBudget)
+	return sized
+}
+
+// forwardedNamespaceListerDeployment returns spec with the settings that it does not set taken
+// from the profile of owner.
+func forwardedNamespaceListerDeployment(
+	owner *konfluxv1alpha1.Konflux,
+	spec *konfluxv1alpha1.NamespaceListerDeploymentSpec,
+) *konfluxv1alpha1.NamespaceListerDeploymentSpec {
+	sizing, ok := profileSizingFor(owner, profileNamespaceLister)
+	if !ok {
+		return spec
+	}
+	sized := spec.DeepCopy()
+	if sized == nil {
+		sized = &konfluxv1alpha1.NamespaceListerDeploymentSpec{}
+	}
+	sized.Replicas = sizing.replicasFor(sized.Replicas)
+	sized.NamespaceLister = sizing.containerFor(sized.NamespaceLister)
+	sized.PodDisruptionBudget = sizing.podDisruptionBudgetFor(sized.PodDisruptionBudget)
+	return sized
+}
+
+// forwardedUIProxyDeployment returns spec with the settings that it does not set taken from the
+// profile of owner.
+func forwardedUIProxyDeployment(
+	owner *konfluxv1alpha1.Konflux,
+	spec *konfluxv1alpha1.ProxyDeploymentSpec,
+) *konfluxv1alpha1.ProxyDeploymentSpec {
+	sizing, ok := profileSizingFor(owner, profileUIProxy)
+	if !ok {
+		return spec
+	}
+	sized := spec.DeepCopy()
+	if sized == nil {
+		sized = &konfluxv1alpha1.ProxyDeploymentSpec{}
+	}
+	sized.Replicas = sizing.replicasFor(sized.Replicas)
+	sized.ReverseProxy = sizing.containerFor(sized.ReverseProxy)
+	sized.OAuth2Proxy = sizing.containerFor(sized.OAuth2Proxy)
+	sized.PodDisruptionBudget = sizing.podDisruptionBudgetFor(sized.PodDisruptionBudget)
+	return sized
+}
+
+// forwardedDexDeployment returns spec with the settings that it does not set taken from the
+// profile of owner.
+func forwardedDexDeployment(
+	owner *konfluxv1alpha1.Konflux,
+	spec *konfluxv1alpha1.DexDeploymentSpec,
+) *konfluxv1alpha1.DexDeploymentSpec {
+	sizing, ok := profileSizingFor(owner, profileDex)
+	if !ok {
+		return spec
+	}
+	sized := spec.DeepCopy()
+	if sized == nil {
+		sized = &konfluxv1alpha1.DexDeploymentSpec{}
+	}
+	sized.Replicas = sizing.replicasFor(sized.Replicas)
+	sized.Dex = sizing.containerFor(sized.Dex)
+	sized.PodDisruptionBudget = sizing.podDisruptionBudgetFor(sized.PodDisruptionBudget)
+	return sized
+}
+
+// replicasFor returns replicas, or the replicas of the profile when they are not set.
+func (s profileSizing) replicasFor(replicas int32) int32 {
+	if replicas != 0 {
+		return replicas
+	}
+	return s.replicas
+}
+
+// podDisruptionBudgetFor returns pdb, or the PodDisruptionBudget of the profile when pdb is nil.
+func (s profileSizing) podDisruptionBudgetFor(pdb *konfluxv1alpha1.PodDisruptionBudgetSpec) *konfluxv1alpha1.PodDisruptionBudgetSpec {
+	if pdb != nil || !s.podDisruptionBudget {
+		return pdb
+	}
+	return &konfluxv1alpha1.PodDisruptionBudgetSpec{}
+}
+
+// containerFor returns container with the resources of the profile added. A resource for which
+// the container sets a request or a limit is left to the container, so that the profile never
+// combines a request with a lower limit. container is modified in place.
+func (s profileSizing) containerFor(container *konfluxv1alpha1.ContainerSpec) *konfluxv1alpha1.ContainerSpec {
+	if container == nil {
+		container = &konfluxv1alpha1.ContainerSpec{}
+	}
+	if container.Resources == nil {
+		container.Resources = &corev1.ResourceRequirements{}
+	}
+	resources := container.Resources
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		_, hasRequest := resources.Requests[name]
+		_, hasLimit := resources.Limits[name]
+		if hasRequest || hasLimit {
+			continue
+		}
+		if request, ok := s.resources.Requests[name]; ok {
+			if resources.Requests == nil {
+				resources.Requests = corev1.ResourceList{}
+			}
+			resources.Requests[name] = request.DeepCopy()
+		}
+		if limit, ok := s.resources.Limits[name]; ok {
+			if resources.Limits == nil {
+				resources.Limits = corev1.ResourceList{}
+			}
+			resources.Limits[name] = limit.DeepCopy()
+		}
+	}
+	return container
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func TestProfilesSizeEveryDeployment(t *testing.T) {
+	deployments := []profileDeployment{
+		profileBuildControllerManager, profileImageControllerManager, profileIntegrationControllerManager,
+		profileReleaseControllerManager, profileNamespaceLister, profileUIProxy, profileDex,
+	}
+	for _, profile := range []konfluxv1alpha1.KonfluxProfile{
+		konfluxv1alpha1.ProfileDev, konfluxv1alpha1.ProfileSmall, konfluxv1alpha1.ProfileProductionHA,
+	} {
+		t.Run(string(profile), func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(profiles[profile]).To(gomega.HaveLen(len(deployments)))
+			for _, deployment := range deployments {
+				sizing, ok := profiles[profile][deployment]
+				g.Expect(ok).To(gomega.BeTrue(), "%s has no sizing for %s", profile, deployment)
+				g.Expect(sizing.replicas).To(gomega.BeNumerically(">=", 1))
+				g.Expect(sizing.podDisruptionBudget).To(gomega.Equal(sizing.replicas > 1))
+				for name, limit := range sizing.resources.Limits {
+					request := sizing.resources.Requests[name]
+					g.Expect(request.Cmp(limit)).To(gomega.BeNumerically("<=", 0),
+						"%s: %s request of %s exceeds its limit", profile, name, deployment)
+				}
+			}
+		})
+	}
+}
+
+func TestForwardedControllerManager(t *testing.T) {
+	production := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{Profile: konfluxv1alpha1.ProfileProductionHA}}
+	sizing := profiles[konfluxv1alpha1.ProfileProductionHA][profileBuildControllerManager]
+
+	t.Run("without a profile the spec is kept", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(forwardedControllerManager(&konfluxv1alpha1.Konflux{}, profileBuildControllerManager, nil)).To(gomega.BeNil())
+		g.Expect(forwardedControllerManager(nil, profileBuildControllerManager, nil)).To(gomega.BeNil())
+	})
+
+	t.Run("the profile fills an empty spec", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		spec := forwardedControllerManager(production, profileBuildControllerManager, nil)
+		g.Expect(spec.Replicas).To(gomega.Equal(int32(2)))
+		g.Expect(spec.PodDisruptionBudget).To(gomega.Equal(&konfluxv1alpha1.PodDisruptionBudgetSpec{}))
+		g.Expect(*spec.Manager.Resources).To(gomega.Equal(sizing.resources))
+	})
+
+	t.Run("settings of the component take precedence", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		pdb := &konfluxv1alpha1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(2))}
+		spec := &konfluxv1alpha1.ControllerManagerDeploymentSpec{
+			Replicas: 3,
+			Manager: &konfluxv1alpha1.ContainerSpec{Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+			}},
+			PodDisruptionBudget: pdb,
+		}
+		original := spec.DeepCopy()
+
+		sized := forwardedControllerManager(production, profileBuildControllerManager, spec)
+		g.Expect(sized.Replicas).To(gomega.Equal(int32(3)))
+		g.Expect(sized.PodDisruptionBudget).To(gomega.Equal(pdb))
+		// The memory set by the user is kept whole; the CPU comes from the profile.
+		g.Expect(sized.Manager.Resources.Limits).To(gomega.Equal(corev1.ResourceList{
+			corev1.ResourceCPU:    sizing.resources.Limits[corev1.ResourceCPU],
+			corev1.ResourceMemory: resource.MustParse("4Gi"),
+		}))
+		g.Expect(sized.Manager.Resources.Requests).To(gomega.Equal(corev1.ResourceList{
+			corev1.ResourceCPU: sizing.resources.Requests[corev1.ResourceCPU],
+		}))
+		g.Expect(spec).To(gomega.Equal(original), "the spec of the Konflux CR must not be modified")
+	})
+
+	t.Run("a single replica gets no PodDisruptionBudget", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		dev := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{Profile: konfluxv1alpha1.ProfileDev}}
+		spec := forwardedControllerManager(dev, profileReleaseControllerManager, nil)
+		g.Expect(spec.Replicas).To(gomega.Equal(int32(1)))
+		g.Expect(spec.PodDisruptionBudget).To(gomega.BeNil())
+	})
+}
+
+func TestForwardedUIDeployments(t *testing.T) {
+	g := gomega.NewWithT(t)
+	owner := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{Profile: konfluxv1alpha1.ProfileSmall}}
+
+	proxy := forwardedUIProxyDeployment(owner, &konfluxv1alpha1.ProxyDeploymentSpec{
+		Endpoints: &konfluxv1alpha1.ProxyEndpointsSpec{Kite: &konfluxv1alpha1.EndpointSpec{Enabled: true}},
+	})
+	g.Expect(proxy.Replicas).To(gomega.Equal(int32(1)))
+	g.Expect(proxy.ReverseProxy.Resources).NotTo(gomega.BeNil())
+	g.Expect(proxy.OAuth2Proxy.Resources).NotTo(gomega.BeNil())
+	g.Expect(proxy.Endpoints.Kite.Enabled).To(gomega.BeTrue())
+
+	dex := forwardedDexDeployment(owner, nil)
+	g.Expect(*dex.Dex.Resources).To(gomega.Equal(profiles[konfluxv1alpha1.ProfileSmall][profileDex].resources))
+
+	namespaceLister := forwardedNamespaceListerDeployment(owner, &konfluxv1alpha1.NamespaceListerDeploymentSpec{Replicas: 2})
+	g.Expect(namespaceLister.Replicas).To(gomega.Equal(int32(2)))
+	g.Expect(namespaceLister.PodDisruptionBudget).To(gomega.BeNil())
+	g.Expect(namespaceLister.NamespaceLister.Resources).NotTo(gomega.BeNil())
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// clusterProxyName is the name of the cluster-wide Proxy and Network objects of OpenShift.
+const clusterProxyName = "cluster"
+
+// kubernetesServiceName is the name of the Service of the API server in the default namespace.
+const kubernetesServiceName = "kubernetes"
+
+// inClusterNoProxy are the destinations that are always reached directly: the loopback
+// addresses and the in-cluster service domains.
+var inClusterNoProxy = []string{"localhost", "127.0.0.1", "::1", ".svc", ".cluster.local"}
+
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies;networks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=servicecidrs,verbs=get;list;watch
+
+// resolveProxy returns the egress proxy of the components: spec.proxy, or the status of the
+// cluster-wide Proxy on OpenShift when spec.proxy is not set. The noProxy list is extended with
+// the service CIDRs of the cluster and inClusterNoProxy. It returns nil when no proxy URL is set.
+func (r *KonfluxReconciler) resolveProxy(ctx context.Context, konflux *konfluxv1alpha1.Konflux) (*konfluxv1alpha1.EgressProxySpec, error) {
+	proxy := konflux.Spec.Proxy
+	if proxy == nil && r.isOpenShift() {
+		clusterProxy := &configv1.Proxy{}
+		err := r.Get(ctx, client.ObjectKey{Name: clusterProxyName}, clusterProxy)
+		if client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("get cluster proxy: %w", err)
+		}
+		if err == nil {
+			proxy = &konfluxv1alpha1.EgressProxySpec{
+				HTTPProxy:  clusterProxy.Status.HTTPProxy,
+				HTTPSProxy: clusterProxy.Status.HTTPSProxy,
+				NoProxy:    clusterProxy.Status.NoProxy,
+			}
+		}
+	}
+	if proxy == nil || (proxy.HTTPProxy == "" && proxy.HTTPSProxy == "") {
+		return nil, nil
+	}
+
+	serviceCIDRs, err := r.serviceCIDRs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &konfluxv1alpha1.EgressProxySpec{
+		HTTPProxy:  proxy.HTTPProxy,
+		HTTPSProxy: proxy.HTTPSProxy,
+		NoProxy:    joinNoProxy(strings.Split(proxy.NoProxy, ","), serviceCIDRs, inClusterNoProxy),
+	}, nil
+}
+
+// serviceCIDRs returns the CIDRs that Service IPs are allocated from: the service network of
+// OpenShift, or the ServiceCIDR objects on Kubernetes 1.33 and later. Without ServiceCIDR
+// objects, it falls back to the cluster IPs of the kubernetes Service, so that at least the
+// API server is reached directly. It returns nil when the cluster exposes none of them.
+func (r *KonfluxReconciler) serviceCIDRs(ctx context.Context) ([]string, error) {
+	if r.isOpenShift() {
+		network := &configv1.Network{}
+		if err := r.Get(ctx, client.ObjectKey{Name: clusterProxyName}, network); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		if len(network.Status.ServiceNetwork) > 0 {
+			return network.Status.ServiceNetwork, nil
+		}
+		return network.Spec.ServiceNetwork, nil
+	}
+	cidrs, err := r.listServiceCIDRs(ctx)
+	if err != nil || len(cidrs) > 0 {
+		return cidrs, err
+	}
+	return r.kubernetesServiceIPs(ctx)
+}
+
+// listServiceCIDRs returns the CIDRs of the ServiceCIDR objects, or nil when the API is not served.
+func (r *KonfluxReconciler) listServiceCIDRs(ctx context.Context) ([]string, error) {
+	if r.ClusterInfo == nil {
+		return nil, nil
+	}
+	if ok, err := r.ClusterInfo.HasResource(networkingv1.SchemeGroupVersion.String(), "ServiceCIDR"); err != nil || !ok {
+		return nil, err
+	}
+	list := &networkingv1.ServiceCIDRList{}
+	if err := r.List(ctx, list); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("list service CIDRs: %w", err)
+	}
+	var cidrs []string
+	for _, serviceCIDR := range list.Items {
+		cidrs = append(cidrs, serviceCIDR.Spec.CIDRs...)
+	}
+	return cidrs, nil
+}
+
+// kubernetesServiceIPs returns the cluster IPs of the default/kubernetes Service, which is the
+// address in KUBERNETES_SERVICE_HOST, or nil when the Service does not exist.
+func (r *KonfluxReconciler) kubernetesServiceIPs(ctx context.Context) ([]string, error) {
+	service := &corev1.Service{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: kubernetesServiceName}, service); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get kubernetes service: %w", err)
+	}
+	if len(service.Spec.ClusterIPs) > 0 {
+		return service.Spec.ClusterIPs, nil
+	}
+	if service.Spec.ClusterIP != "" {
+		return []string{service.Spec.ClusterIP}, nil
+	}
+	return nil, nil
+}
+
+func (r *KonfluxReconciler) isOpenShift() bool {
+	return r.ClusterInfo != nil && r.ClusterInfo.IsOpenShift()
+}
+
+// joinNoProxy joins the entries of lists into a NO_PROXY value, dropping empty entries and
+// duplicates while keeping the order.
+func joinNoProxy(lists ...[]string) string {
+	seen := make(map[string]bool)
+	var entries []string
+	for _, list := range lists {
+		for _, entry := range list {
+			entry = strings.TrimSpace(entry)
+			if entry == "" || seen[entry] {
+				continue
+			}
+			seen[entry] = true
+			entries = append(entries, entry)
+		}
+	}
+	return strings.Join(entries, ",")
+}
+
+// forwardedEgressProxy returns the resolved egress proxy of the Konflux CR for a component CR.
+func forwardedEgressProxy(owner *konfluxv1alpha1.Konflux) *konfluxv1alpha1.EgressProxySpec {
+	if owner == nil {
+		return nil
+	}
+	return owner.Status.Proxy.DeepCopy()
+}
+
+// mapClusterProxyToKonflux enqueues the Konflux CRs that follow the cluster-wide Proxy of
+// OpenShift, that is those without spec.proxy.
+func (r *KonfluxReconciler) mapClusterProxyToKonflux(ctx context.Context, obj client.Object) []ctrl.Request {
+	if obj.GetName() != clusterProxyName {
+		return nil
+	}
+	list := &konfluxv1alpha1.KonfluxList{}
+	if err := r.List(ctx, list); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list Konflux CRs for cluster proxy")
+		return nil
+	}
+	var requests []ctrl.Request
+	for _, konflux := range list.Items {
+		if konflux.Spec.Proxy == nil {
+			requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&konflux)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+)
+
+func newProxyReconciler(t *testing.T, resources map[string]*metav1.APIResourceList, objs ...client.Object) *KonfluxReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(konfluxv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(configv1.Install(scheme))
+	clusterInfo, err := clusterinfo.DetectWithClient(&testMockDiscoveryClient{
+		resources:     resources,
+		serverVersion: &version.Info{GitVersion: "v1.33.0"},
+	})
+	gomega.NewWithT(t).Expect(err).NotTo(gomega.HaveOccurred())
+	return &KonfluxReconciler{
+		Client:      fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		Scheme:      scheme,
+		ClusterInfo: clusterInfo,
+	}
+}
+
+var openShiftResources = map[string]*metav1.APIResourceList{
+	"config.openshift.io/v1": {APIResources: []metav1.APIResource{{Kind: "ClusterVersion"}}},
+}
+
+func TestResolveProxy(t *testing.T) {
+	ctx := context.Background()
+	clusterProxy := &configv1.Proxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Status: configv1.ProxyStatus{
+			HTTPProxy:  "http://proxy.corp.example.com:3128",
+			HTTPSProxy: "http://proxy.corp.example.com:3128",
+			NoProxy:    ".cluster.local,.svc,172.30.0.0/16,api-int.example.com",
+		},
+	}
+	network := &configv1.Network{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Status:     configv1.NetworkStatus{ServiceNetwork: []string{"172.30.0.0/16"}},
+	}
+
+	t.Run("no proxy", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := newProxyReconciler(t, nil)
+		g.Expect(r.resolveProxy(ctx, &konfluxv1alpha1.Konflux{})).To(gomega.BeNil())
+	})
+
+	t.Run("spec.proxy with the ServiceCIDRs of the cluster", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := newProxyReconciler(t, map[string]*metav1.APIResourceList{
+			"networking.k8s.io/v1": {APIResources: []metav1.APIResource{{Kind: "ServiceCIDR"}}},
+		}, &networkingv1.ServiceCIDR{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes"},
+			Spec:       networkingv1.ServiceCIDRSpec{CIDRs: []string{"10.96.0.0/16", "fd00:10:96::/112"}},
+		})
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			Proxy: &konfluxv1alpha1.EgressProxySpec{
+				HTTPSProxy: "http://proxy.example.com:3128",
+				NoProxy:    "github.internal.example.com, .svc",
+			},
+		}}
+
+		g.Expect(r.resolveProxy(ctx, konflux)).To(gomega.Equal(&konfluxv1alpha1.EgressProxySpec{
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    "github.internal.example.com,.svc,10.96.0.0/16,fd00:10:96::/112,localhost,127.0.0.1,::1,.cluster.local",
+		}))
+	})
+
+	t.Run("spec.proxy without a service CIDR API", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := newProxyReconciler(t, nil)
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			Proxy: &konfluxv1alpha1.EgressProxySpec{HTTPProxy: "http://proxy.example.com:3128"},
+		}}
+
+		g.Expect(r.resolveProxy(ctx, konflux)).To(gomega.Equal(&konfluxv1alpha1.EgressProxySpec{
+			HTTPProxy: "http://proxy.example.com:3128",
+			NoProxy:   "localhost,127.0.0.1,::1,.svc,.cluster.local",
+		}))
+	})
+
+	t.Run("spec.proxy with the kubernetes Service when there are no ServiceCIDRs", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := newProxyReconciler(t, map[string]*metav1.APIResourceList{
+			"networking.k8s.io/v1": {APIResources: []metav1.APIResource{{Kind: "ServiceCIDR"}}},
+		}, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{ClusterIP: "10.96.0.1", ClusterIPs: []string{"10.96.0.1", "fd00:10:96::1"}},
+		})
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			Proxy: &konfluxv1alpha1.EgressProxySpec{HTTPProxy: "http://proxy.example.com:3128"},
+		}}
+
+		g.Expect(r.resolveProxy(ctx, konflux)).To(gomega.Equal(&konfluxv1alpha1.EgressProxySpec{
+			HTTPProxy: "http://proxy.example.com:3128",
+			NoProxy:   "10.96.0.1,fd00:10:96::1,localhost,127.0.0.1,::1,.svc,.cluster.local",
+		}))
+	})
+
+	t.Run("spec.proxy with the kubernetes Service without a service CIDR API", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := newProxyReconciler(t, nil, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+		})
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			Proxy: &konfluxv1alpha1.EgressProxySpec{HTTPProxy: "http://proxy.example.com:3128"},
+		}}
+
+		g.Expect(r.resolveProxy(ctx, konflux)).To(gomega.Equal(&konfluxv1alpha1.EgressProxySpec{
+			HTTPProxy: "http://proxy.example.com:3128",
+			NoProxy:   "10.0.0.1,localhost,127.0.0.1,::1,.svc,.cluster.local",
+		}))
+	})
+
+	t.Run("cluster-wide Proxy of OpenShift", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := newProxyReconciler(t, openShiftResources, clusterProxy, network)
+
+		g.Expect(r.resolveProxy(ctx, &konfluxv1alpha1.Konflux{})).To(gomega.Equal(&konfluxv1alpha1.EgressProxySpec{
+			HTTPProxy:  "http://proxy.corp.example.com:3128",
+			HTTPSProxy: "http://proxy.corp.example.com:3128",
+			NoProxy:    ".cluster.local,.svc,172.30.0.0/16,api-int.example.com,localhost,127.0.0.1,::1",
+		}))
+	})
+
+	t.Run("empty spec.proxy disables the cluster-wide Proxy", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := newProxyReconciler(t, openShiftResources, clusterProxy, network)
+		konflux := &konfluxv1alpha1.Konflux{Spec: konfluxv1alpha1.KonfluxSpec{
+			Proxy: &konfluxv1alpha1.EgressProxySpec{},
+		}}
+
+		g.Expect(r.resolveProxy(ctx, konflux)).To(gomega.BeNil())
+	})
+
+	t.Run("OpenShift without a cluster-wide Proxy", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := newProxyReconciler(t, openShiftResources, network)
+		g.Expect(r.resolveProxy(ctx, &konfluxv1alpha1.Konflux{})).To(gomega.BeNil())
+	})
+}
+
+func TestForwardedEgressProxy(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(forwardedEgressProxy(nil)).To(gomega.BeNil())
+	g.Expect(forwardedEgressProxy(&konfluxv1alpha1.Konflux{})).To(gomega.BeNil())
+
+	proxy := &konfluxv1alpha1.EgressProxySpec{HTTPProxy: "http://proxy.example.com:3128", NoProxy: ".svc"}
+	forwarded := forwardedEgressProxy(&konfluxv1alpha1.Konflux{Status: konfluxv1alpha1.KonfluxStatus{Proxy: proxy}})
+	g.Expect(forwarded).To(gomega.Equal(proxy))
+	g.Expect(forwarded).NotTo(gomega.BeIdenticalTo(proxy))
+}
+
+func TestMapClusterProxyToKonflux(t *testing.T) {
+	g := gomega.NewWithT(t)
+	r := newProxyReconciler(t, openShiftResources,
+		&konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: "konflux"}},
+		&konfluxv1alpha1.Konflux{
+			ObjectMeta: metav1.ObjectMeta{Name: "explicit"},
+			Spec:       konfluxv1alpha1.KonfluxSpec{Proxy: &konfluxv1alpha1.EgressProxySpec{}},
+		},
+	)
+
+	g.Expect(r.mapClusterProxyToKonflux(context.Background(), &configv1.Proxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+	})).To(gomega.ConsistOf(ctrl.Request{NamespacedName: client.ObjectKey{Name: "konflux"}}))
+	g.Expect(r.mapClusterProxyToKonflux(context.Background(), &configv1.Proxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+	})).To(gomega.BeEmpty())
+}
@@ -0,0 +1,388 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/applicationapi"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/buildservice"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/certmanager"
+	clictrl "github.com/konflux-ci/konflux-ci/operator/internal/controller/cli"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/defaulttenant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/enterprisecontract"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/imagecontroller"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/info"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/integrationservice"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/internalregistry"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/namespacelister"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/rbac"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/releaseservice"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/segmentbridge"
+	uictrl "github.com/konflux-ci/konflux-ci/operator/internal/controller/ui"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+// Component names. They identify components in the rollout status and prefix
+// the sub-CR conditions copied onto the Konflux CR.
+const (
+	componentApplicationAPI     = "application-api"
+	componentBuildService       = "build-service"
+	componentIntegrationService = "integration-service"
+	componentReleaseService     = "release-service"
+	componentUI                 = "ui"
+	componentRBAC               = "rbac"
+	componentInfo               = "info"
+	componentNamespaceLister    = "namespace-lister"
+	componentEnterpriseContract = "enterprise-contract"
+	componentImageController    = "image-controller"
+	componentCertManager        = "cert-manager"
+	componentInternalRegistry   = "internal-registry"
+	componentDefaultTenant      = "default-tenant"
+	componentSegmentBridge      = "segment-bridge"
+	componentCLI                = "cli"
+)
+
+// component describes a sub-CR managed by the Konflux reconciler and its place in the rollout.
+type component struct {
+	// name identifies the component (one of the component* constants).
+	name string
+	// kind is the sub-CR kind, used in error messages.
+	kind string
+	// manifest is the embedded manifest bundle the component's reconciler deploys.
+	manifest manifests.Component
+	// dependsOn lists the components that must be Ready before this one is applied.
+	// Dependencies that are disabled are ignored.
+	dependsOn []string
+	// enabled reports whether the component is part of the desired state. Nil means always enabled.
+	enabled func(spec *konfluxv1alpha1.KonfluxSpec) bool
+	// patches returns the per-component patches of the Konflux CR. Nil for components
+	// without a spec on the Konflux CR.
+	patches func(spec *konfluxv1alpha1.KonfluxSpec) []konfluxv1alpha1.ObjectPatch
+	// apply creates or updates the sub-CR.
+	apply func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error
+	// newObject returns an empty sub-CR with only its name set, used to read its status.
+	newObject func() konfluxv1alpha1.ConditionAccessor
+}
+
+// isEnabled reports whether the component is part of the desired state for spec.
+func (c *component) isEnabled(spec *konfluxv1alpha1.KonfluxSpec) bool {
+	return c.enabled == nil || c.enabled(spec)
+}
+
+// konfluxComponents is the dependency graph of the Konflux sub-CRs:
+//   - cert-manager issues the certificates the internal registry serves with.
+//   - application-api installs the CRDs that build, integration and release services watch.
+//   - integration-service reads the console URL from the KonfluxUI status.
+var konfluxComponents = []component{
+	{
+		name:     componentApplicationAPI,
+		kind:     "KonfluxApplicationAPI",
+		manifest: manifests.ApplicationAPI,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxApplicationAPI(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxApplicationAPI{ObjectMeta: metav1.ObjectMeta{Name: applicationapi.CRName}}
+		},
+	},
+	{
+		name:      componentBuildService,
+		kind:      "KonfluxBuildService",
+		manifest:  manifests.BuildService,
+		dependsOn: []string{componentApplicationAPI},
+		enabled:   (*konfluxv1alpha1.KonfluxSpec).IsBuildServiceEnabled,
+		patches:   buildServicePatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxBuildService(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxBuildService{ObjectMeta: metav1.ObjectMeta{Name: buildservice.CRName}}
+		},
+	},
+	{
+		name:      componentIntegrationService,
+		kind:      "KonfluxIntegrationService",
+		manifest:  manifests.Integration,
+		dependsOn: []string{componentApplicationAPI, componentUI},
+		enabled:   (*konfluxv1alpha1.KonfluxSpec).IsIntegrationServiceEnabled,
+		patches:   integrationServicePatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxIntegrationService(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxIntegrationService{ObjectMeta: metav1.ObjectMeta{Name: integrationservice.CRName}}
+		},
+	},
+	{
+		name:      componentReleaseService,
+		kind:      "KonfluxReleaseService",
+		manifest:  manifests.Release,
+		dependsOn: []string{componentApplicationAPI},
+		enabled:   (*konfluxv1alpha1.KonfluxSpec).IsReleaseServiceEnabled,
+		patches:   releaseServicePatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxReleaseService(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxReleaseService{ObjectMeta: metav1.ObjectMeta{Name: releaseservice.CRName}}
+		},
+	},
+	{
+		name:     componentUI,
+		kind:     "KonfluxUI",
+		manifest: manifests.UI,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsUIEnabled,
+		patches:  uiPatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxUI(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxUI{ObjectMeta: metav1.ObjectMeta{Name: uictrl.CRName}}
+		},
+	},
+	{
+		name:     componentRBAC,
+		kind:     "KonfluxRBAC",
+		manifest: manifests.RBAC,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxRBAC(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxRBAC{ObjectMeta: metav1.ObjectMeta{Name: rbac.CRName}}
+		},
+	},
+	{
+		name:     componentInfo,
+		kind:     "KonfluxInfo",
+		manifest: manifests.Info,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsInfoEnabled,
+		patches:  infoPatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxInfo(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxInfo{ObjectMeta: metav1.ObjectMeta{Name: info.CRName}}
+		},
+	},
+	{
+		name:     componentNamespaceLister,
+		kind:     "KonfluxNamespaceLister",
+		manifest: manifests.NamespaceLister,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsNamespaceListerEnabled,
+		patches:  namespaceListerPatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxNamespaceLister(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxNamespaceLister{ObjectMeta: metav1.ObjectMeta{Name: namespacelister.CRName}}
+		},
+	},
+	{
+		name:     componentEnterpriseContract,
+		kind:     "KonfluxEnterpriseContract",
+		manifest: manifests.EnterpriseContract,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsEnterpriseContractEnabled,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxEnterpriseContract(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxEnterpriseContract{ObjectMeta: metav1.ObjectMeta{Name: enterprisecontract.CRName}}
+		},
+	},
+	{
+		name:     componentImageController,
+		kind:     "KonfluxImageController",
+		manifest: manifests.ImageController,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsImageControllerEnabled,
+		patches:  imageControllerPatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxImageController(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxImageController{ObjectMeta: metav1.ObjectMeta{Name: imagecontroller.CRName}}
+		},
+	},
+	{
+		name:     componentCertManager,
+		kind:     "KonfluxCertManager",
+		manifest: manifests.CertManager,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsCertManagerEnabled,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxCertManager(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxCertManager{ObjectMeta: metav1.ObjectMeta{Name: certmanager.CRName}}
+		},
+	},
+	{
+		name:      componentInternalRegistry,
+		kind:      "KonfluxInternalRegistry",
+		manifest:  manifests.Registry,
+		dependsOn: []string{componentCertManager},
+		enabled:   (*konfluxv1alpha1.KonfluxSpec).IsInternalRegistryEnabled,
+		patches:   internalRegistryPatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxInternalRegistry(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxInternalRegistry{ObjectMeta: metav1.ObjectMeta{Name: internalregistry.CRName}}
+		},
+	},
+	{
+		name:     componentDefaultTenant,
+		kind:     "KonfluxDefaultTenant",
+		manifest: manifests.DefaultTenant,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsDefaultTenantEnabled,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxDefaultTenant(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxDefaultTenant{ObjectMeta: metav1.ObjectMeta{Name: defaulttenant.CRName}}
+		},
+	},
+	{
+		name:     componentSegmentBridge,
+		kind:     "KonfluxSegmentBridge",
+		manifest: manifests.SegmentBridge,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsTelemetryEnabled,
+		patches:  segmentBridgePatches,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxSegmentBridge(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxSegmentBridge{ObjectMeta: metav1.ObjectMeta{Name: segmentbridge.CRName}}
+		},
+	},
+	{
+		name:     componentCLI,
+		kind:     "KonfluxCLI",
+		manifest: manifests.CLI,
+		enabled:  (*konfluxv1alpha1.KonfluxSpec).IsCLIEnabled,
+		apply: func(ctx context.Context, r *KonfluxReconciler, tc *tracking.Client, owner *konfluxv1alpha1.Konflux) error {
+			return r.applyKonfluxCLI(ctx, tc, owner)
+		},
+		newObject: func() konfluxv1alpha1.ConditionAccessor {
+			return &konfluxv1alpha1.KonfluxCLI{ObjectMeta: metav1.ObjectMeta{Name: clictrl.CRName}}
+		},
+	},
+}
+
+// enabledComponents returns the components that are part of the desired state for spec,
+// with dependencies on disabled components dropped.
+func enabledComponents(components []component, spec *konfluxv1alpha1.KonfluxSpec) []component {
+	enabled := make(map[string]bool, len(components))
+	for _, c := range components {
+		enabled[c.name] = c.isEnabled(spec)
+	}
+
+	result := make([]component, 0, len(components))
+	for _, c := range components {
+		if !enabled[c.name] {
+			continue
+		}
+		var deps []string
+		for _, dep := range c.dependsOn {
+			if enabled[dep] {
+				deps = append(deps, dep)
+			}
+		}
+		c.dependsOn = deps
+		result = append(result, c)
+	}
+	return result
+}
+
+// rolloutPhases groups components into phases. Components without dependencies are in the
+// first phase and every other component is one phase after its latest dependency.
+// Components keep their relative order within a phase.
+func rolloutPhases(components []component) ([][]component, error) {
+	known := make(map[string]bool, len(components))
+	for _, c := range components {
+		known[c.name] = true
+	}
+	for _, c := range components {
+		for _, dep := range c.dependsOn {
+			if !known[dep] {
+				return nil, fmt.Errorf("component %q depends on unknown component %q", c.name, dep)
+			}
+		}
+	}
+
+	placed := make(map[string]bool, len(components))
+	var phases [][]component
+	for len(placed) < len(components) {
+		var phase []component
+		for _, c := range components {
+			if !placed[c.name] && allPlaced(c.dependsOn, placed) {
+				phase = append(phase, c)
+			}
+		}
+		if len(phase) == 0 {
+			var remaining []string
+			for _, c := range components {
+				if !placed[c.name] {
+					remaining = append(remaining, c.name)
+				}
+			}
+			return nil, fmt.Errorf("dependency cycle between components %v", remaining)
+		}
+		for _, c := range phase {
+			placed[c.name] = true
+		}
+		phases = append(phases, phase)
+	}
+	return phases, nil
+}
+
+func allPlaced(names []string, placed map[string]bool) bool {
+	for _, name := range names {
+		if !placed[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// unreadyDependencies returns the dependencies of c that are not Ready.
+func unreadyDependencies(c component, ready map[string]bool) []string {
+	var waitingFor []string
+	for _, dep := range c.dependsOn {
+		if !ready[dep] {
+			waitingFor = append(waitingFor, dep)
+		}
+	}
+	return waitingFor
+}
+
+// currentPhase returns the first phase, starting at 1, that has a component that is not
+// Ready, or the last phase when all components are Ready.
+func currentPhase(phases [][]component, ready map[string]bool) int32 {
+	for i, phase := range phases {
+		for _, c := range phase {
+			if !ready[c.name] {
+				return int32(i + 1) //nolint:gosec // bounded by the number of components
+			}
+		}
+	}
+	return int32(len(phases)) //nolint:gosec // bounded by the number of components
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func phaseNames(phases [][]component) [][]string {
+	names := make([][]string, 0, len(phases))
+	for _, phase := range phases {
+		var inPhase []string
+		for _, c := range phase {
+			inPhase = append(inPhase, c.name)
+		}
+		names = append(names, inPhase)
+	}
+	return names
+}
+
+func TestKonfluxComponentsRolloutPhases(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	phases, err := rolloutPhases(konfluxComponents)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(phaseNames(phases)).To(gomega.Equal([][]string{
+		{
+			componentApplicationAPI, componentUI, componentRBAC, componentInfo, componentNamespaceLister,
+			componentEnterpriseContract, componentImageController, componentCertManager,
+			componentDefaultTenant, componentSegmentBridge, componentCLI,
+		},
+		{componentBuildService, componentIntegrationService, componentReleaseService, componentInternalRegistry},
+	}))
+}
+
+func TestEnabledComponents(t *testing.T) {
+	t.Run("default spec skips optional components", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		var names []string
+		for _, c := range enabledComponents(konfluxComponents, &konfluxv1alpha1.KonfluxSpec{}) {
+			names = append(names, c.name)
+		}
+		g.Expect(names).To(gomega.ContainElement(componentDefaultTenant))
+		g.Expect(names).NotTo(gomega.ContainElements(componentImageController, componentInternalRegistry, componentSegmentBridge))
+	})
+
+	t.Run("optional component is added when enabled", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		spec := &konfluxv1alpha1.KonfluxSpec{
+			InternalRegistry: &konfluxv1alpha1.InternalRegistryConfig{Enabled: ptr.To(true)},
+		}
+		var registry *component
+		for _, c := range enabledComponents(konfluxComponents, spec) {
+			if c.name == componentInternalRegistry {
+				registry = &c
+			}
+		}
+		g.Expect(registry).NotTo(gomega.BeNil())
+		g.Expect(registry.dependsOn).To(gomega.Equal([]string{componentCertManager}))
+	})
+
+	t.Run("always-on components can be disabled", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		spec := &konfluxv1alpha1.KonfluxSpec{
+			KonfluxUI:             &konfluxv1alpha1.KonfluxUIConfig{Enabled: ptr.To(false)},
+			KonfluxReleaseService: &konfluxv1alpha1.ReleaseServiceConfig{Enabled: ptr.To(false)},
+			EnterpriseContract:    &konfluxv1alpha1.EnterpriseContractConfig{Enabled: ptr.To(false)},
+		}
+		var names []string
+		var integration *component
+		for _, c := range enabledComponents(konfluxComponents, spec) {
+			names = append(names, c.name)
+			if c.name == componentIntegrationService {
+				integration = &c
+			}
+		}
+		g.Expect(names).NotTo(gomega.ContainElements(componentUI, componentReleaseService, componentEnterpriseContract))
+		g.Expect(integration).NotTo(gomega.BeNil())
+		g.Expect(integration.dependsOn).To(gomega.Equal([]string{componentApplicationAPI}))
+	})
+
+	t.Run("dependencies on disabled components are dropped", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		disabled := func(*konfluxv1alpha1.KonfluxSpec) bool { return false }
+		components := []component{
+			{name: "a"},
+			{name: "b", enabled: disabled},
+			{name: "c", dependsOn: []string{"a", "b"}},
+		}
+		result := enabledComponents(components, &konfluxv1alpha1.KonfluxSpec{})
+		g.Expect(result).To(gomega.HaveLen(2))
+		g.Expect(result[1].name).To(gomega.Equal("c"))
+		g.Expect(result[1].dependsOn).To(gomega.Equal([]string{"a"}))
+		// The shared table must not be modified.
+		g.Expect(components[2].dependsOn).To(gomega.Equal([]string{"a", "b"}))
+	})
+}
+
+func TestRolloutPhases(t *testing.T) {
+	t.Run("component is placed after its latest dependency", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		phases, err := rolloutPhases([]component{
+			{name: "d", dependsOn: []string{"c", "a"}},
+			{name: "c", dependsOn: []string{"b"}},
+			{name: "b", dependsOn: []string{"a"}},
+			{name: "a"},
+			{name: "e"},
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(phaseNames(phases)).To(gomega.Equal([][]string{{"a", "e"}, {"b"}, {"c"}, {"d"}}))
+	})
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		_, err := rolloutPhases([]component{{name: "a", dependsOn: []string{"missing"}}})
+		g.Expect(err).To(gomega.MatchError(`component "a" depends on unknown component "missing"`))
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		_, err := rolloutPhases([]component{
+			{name: "a"},
+			{name: "b", dependsOn: []string{"c"}},
+			{name: "c", dependsOn: []string{"b"}},
+		})
+		g.Expect(err).To(gomega.MatchError("dependency cycle between components [b c]"))
+	})
+}
+
+func TestCurrentPhase(t *testing.T) {
+	phases := [][]component{{{name: "a"}, {name: "b"}}, {{name: "c"}}}
+
+	tests := []struct {
+		name  string
+		ready map[string]bool
+		want  int32
+	}{
+		{name: "nothing ready", ready: map[string]bool{}, want: 1},
+		{name: "first phase partially ready", ready: map[string]bool{"a": true}, want: 1},
+		{name: "first phase ready", ready: map[string]bool{"a": true, "b": true}, want: 2},
+		{name: "all ready", ready: map[string]bool{"a": true, "b": true, "c": true}, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(currentPhase(phases, tt.ready)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestUnreadyDependencies(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	c := component{name: "integration-service", dependsOn: []string{componentApplicationAPI, componentUI}}
+	g.Expect(unreadyDependencies(c, map[string]bool{})).To(gomega.Equal([]string{componentApplicationAPI, componentUI}))
+	g.Expect(unreadyDependencies(c, map[string]bool{componentApplicationAPI: true})).To(gomega.Equal([]string{componentUI}))
+	g.Expect(unreadyDependencies(c, map[string]bool{componentApplicationAPI: true, componentUI: true})).To(gomega.BeEmpty())
+}
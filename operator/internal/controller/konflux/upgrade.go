@@ -0,0 +1,158 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/internal/upgrade"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
+)
+
+// beginUpgrade records the running operator version as the target version and compares it with
+// the installed one. It returns the migration plan when this reconcile rolls out a new version,
+// and blocked when the operator is older than the installation and the downgrade was not
+// allowed, in which case nothing may be applied.
+func (r *KonfluxReconciler) beginUpgrade(
+	ctx context.Context,
+	konflux *konfluxv1alpha1.Konflux,
+) (plan *upgrade.Plan, blocked bool) {
+	installed, target := konflux.Status.InstalledVersion, version.Version
+	konflux.Status.TargetVersion = target
+
+	switch {
+	case installed == "" || installed == target:
+		// Nothing to migrate: a fresh installation records its version once it is Ready, and an
+		// upgrade that was rolled back before it completed no longer applies.
+		konflux.Status.CompletedUpgradeSteps = nil
+		if apimeta.IsStatusConditionTrue(konflux.Status.Conditions, condition.TypeUpgrading) {
+			apimeta.RemoveStatusCondition(&konflux.Status.Conditions, condition.TypeUpgrading)
+		}
+		return nil, false
+	case upgrade.DowngradeBlocked(konflux, target):
+		logf.FromContext(ctx).Info("Refusing to downgrade Konflux, skipping apply",
+			"installedVersion", installed, "version", target,
+			"annotation", constant.KonfluxAllowDowngradeAnnotation)
+		condition.SetDowngradeBlockedCondition(konflux, installed, target)
+		recorder.Warning(r.Recorder, konflux, condition.ReasonDowngradeBlocked, recorder.ActionUpgrade,
+			condition.DowngradeBlockedMessage(installed, target))
+		return nil, true
+	}
+
+	p := upgrade.NewPlan(r.UpgradeSteps, installed, target)
+	if !apimeta.IsStatusConditionTrue(konflux.Status.Conditions, condition.TypeUpgrading) {
+		recorder.Normal(r.Recorder, konflux, condition.ReasonUpgradeInProgress, recorder.ActionUpgrade,
+			fmt.Sprintf("Upgrading from %s to %s", installed, target))
+	}
+	setUpgradingCondition(konflux, &p, nil)
+	return &p, false
+}
+
+// finishUpgrade records the target version as installed once every component is Ready and has
+// been reconciled by the running operator, after running the post-upgrade steps of plan.
+// pending lists the components that still report an older operator version.
+func (r *KonfluxReconciler) finishUpgrade(
+	ctx context.Context,
+	konflux *konfluxv1alpha1.Konflux,
+	plan *upgrade.Plan,
+	pending []string,
+) error {
+	target := konflux.Status.TargetVersion
+	if konflux.Status.InstalledVersion == target {
+		return nil
+	}
+	if !konflux.IsReady() || len(pending) > 0 {
+		if plan != nil {
+			setUpgradingCondition(konflux, plan, pending)
+		}
+		return nil
+	}
+
+	if plan != nil {
+		if err := r.runUpgradeSteps(ctx, konflux, plan.Post); err != nil {
+			return err
+		}
+		condition.SetCondition(konflux, metav1.Condition{
+			Type:    condition.TypeUpgrading,
+			Status:  metav1.ConditionFalse,
+			Reason:  condition.ReasonUpgradeSucceeded,
+			Message: fmt.Sprintf("Upgraded from %s to %s", plan.From, plan.To),
+		})
+		logf.FromContext(ctx).Info("Upgraded Konflux", "from", plan.From, "to", plan.To)
+		recorder.Normal(r.Recorder, konflux, condition.ReasonUpgradeSucceeded, recorder.ActionUpgrade,
+			fmt.Sprintf("Upgraded from %s to %s", plan.From, plan.To))
+	}
+	konflux.Status.InstalledVersion = target
+	konflux.Status.CompletedUpgradeSteps = nil
+	return nil
+}
+
+// runUpgradeSteps runs the steps that have not completed yet for the current upgrade and records
+// each completed step in status, so that a retry resumes after the last successful one.
+func (r *KonfluxReconciler) runUpgradeSteps(
+	ctx context.Context,
+	konflux *konfluxv1alpha1.Konflux,
+	steps []upgrade.Step,
+) error {
+	log := logf.FromContext(ctx)
+	for _, step := range steps {
+		if slices.Contains(konflux.Status.CompletedUpgradeSteps, step.Name) {
+			continue
+		}
+		log.Info("Running upgrade step", "step", step.Name, "phase", step.Phase, "version", step.Version)
+		if err := step.Run(ctx, r.Client); err != nil {
+			return fmt.Errorf("upgrade step %s: %w", step.Name, err)
+		}
+		konflux.Status.CompletedUpgradeSteps = append(konflux.Status.CompletedUpgradeSteps, step.Name)
+	}
+	return nil
+}
+
+// setUpgradingCondition reports an upgrade in progress, naming the components it waits for.
+// It notes when the versions are not comparable, as downgrades between them are not blocked.
+func setUpgradingCondition(konflux *konfluxv1alpha1.Konflux, plan *upgrade.Plan, pending []string) {
+	message := fmt.Sprintf("Upgrading from %s to %s", plan.From, plan.To)
+	if !upgrade.Comparable(plan.From, plan.To) {
+		message += " (not a release version, so downgrade protection does not apply)"
+	}
+	if len(pending) > 0 {
+		message += "; waiting for " + strings.Join(pending, ", ")
+	}
+	condition.SetCondition(konflux, metav1.Condition{
+		Type:    condition.TypeUpgrading,
+		Status:  metav1.ConditionTrue,
+		Reason:  condition.ReasonUpgradeInProgress,
+		Message: message,
+	})
+}
+
+// reconciledByRunningOperator reports whether subCR was last applied by this operator version.
+func reconciledByRunningOperator(subCR konfluxv1alpha1.ConditionAccessor) bool {
+	versioned, ok := subCR.(konfluxv1alpha1.OperatorVersionAccessor)
+	return !ok || versioned.GetOperatorVersion() == version.Version
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package konflux
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/upgrade"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/version"
+)
+
+func withOperatorVersion(t *testing.T, v string) {
+	t.Helper()
+	previous := version.Version
+	version.Version = v
+	t.Cleanup(func() { version.Version = previous })
+}
+
+func installedKonflux(installed string, annotations map[string]string) *konfluxv1alpha1.Konflux {
+	return &konfluxv1alpha1.Konflux{
+		ObjectMeta: metav1.ObjectMeta{Name: CRName, Annotations: annotations},
+		Status:     konfluxv1alpha1.KonfluxStatus{InstalledVersion: installed},
+	}
+}
+
+func setReady(konflux *konfluxv1alpha1.Konflux) {
+	condition.SetCondition(konflux, metav1.Condition{
+		Type:   condition.TypeReady,
+		Status: metav1.ConditionTrue,
+		Reason: condition.ReasonAllComponentsReady,
+	})
+}
+
+// recordingSteps returns one pre- and one post-upgrade step that append their name to ran.
+func recordingSteps(ran *[]string) []upgrade.Step {
+	step := func(name string, phase upgrade.Phase) upgrade.Step {
+		return upgrade.Step{
+			Name:    name,
+			Version: "v0.2.0",
+			Phase:   phase,
+			Run: func(context.Context, client.Client) error {
+				*ran = append(*ran, name)
+				return nil
+			},
+		}
+	}
+	return []upgrade.Step{step("rename", upgrade.PreUpgrade), step("migrate", upgrade.PostUpgrade)}
+}
+
+func TestBeginUpgrade_FreshInstall(t *testing.T) {
+	g := gomega.NewWithT(t)
+	withOperatorVersion(t, "v0.2.0")
+	konflux := installedKonflux("", nil)
+
+	plan, blocked := (&KonfluxReconciler{}).beginUpgrade(context.Background(), konflux)
+	g.Expect(plan).To(gomega.BeNil())
+	g.Expect(blocked).To(gomega.BeFalse())
+	g.Expect(konflux.Status.TargetVersion).To(gomega.Equal("v0.2.0"))
+	g.Expect(apimeta.FindStatusCondition(konflux.Status.Conditions, condition.TypeUpgrading)).To(gomega.BeNil())
+}
+
+func TestBeginUpgrade_Upgrade(t *testing.T) {
+	g := gomega.NewWithT(t)
+	withOperatorVersion(t, "v0.2.0")
+	var ran []string
+	konflux := installedKonflux("v0.1.0", nil)
+
+	plan, blocked := (&KonfluxReconciler{UpgradeSteps: recordingSteps(&ran)}).beginUpgrade(context.Background(), konflux)
+	g.Expect(blocked).To(gomega.BeFalse())
+	g.Expect(plan).NotTo(gomega.BeNil())
+	g.Expect(plan.Pre).To(gomega.HaveLen(1))
+	g.Expect(plan.Post).To(gomega.HaveLen(1))
+
+	upgrading := apimeta.FindStatusCondition(konflux.Status.Conditions, condition.TypeUpgrading)
+	g.Expect(upgrading).NotTo(gomega.BeNil())
+	g.Expect(upgrading.Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(upgrading.Message).To(gomega.Equal("Upgrading from v0.1.0 to v0.2.0"))
+}
+
+func TestBeginUpgrade_DevelopmentBuild(t *testing.T) {
+	g := gomega.NewWithT(t)
+	const commit = "8e4b7d0c1a2f3e4d5c6b7a8f9e0d1c2b3a4f5e6d"
+	withOperatorVersion(t, commit)
+	var ran []string
+	konflux := installedKonflux("v0.3.0", nil)
+
+	// The order of a release and a commit build is unknown, so the change is not blocked.
+	plan, blocked := (&KonfluxReconciler{UpgradeSteps: recordingSteps(&ran)}).beginUpgrade(context.Background(), konflux)
+	g.Expect(blocked).To(gomega.BeFalse())
+	g.Expect(plan).NotTo(gomega.BeNil())
+
+	upgrading := apimeta.FindStatusCondition(konflux.Status.Conditions, condition.TypeUpgrading)
+	g.Expect(upgrading).NotTo(gomega.BeNil())
+	g.Expect(upgrading.Message).To(gomega.Equal("Upgrading from v0.3.0 to " + commit +
+		" (not a release version, so downgrade protection does not apply)"))
+}
+
+func TestBeginUpgrade_Downgrade(t *testing.T) {
+	withOperatorVersion(t, "v0.1.0")
+
+	t.Run("is blocked by default", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := installedKonflux("v0.2.0", nil)
+
+		plan, blocked := (&KonfluxReconciler{}).beginUpgrade(context.Background(), konflux)
+		g.Expect(plan).To(gomega.BeNil())
+		g.Expect(blocked).To(gomega.BeTrue())
+		paused := apimeta.FindStatusCondition(konflux.Status.Conditions, condition.TypePaused)
+		g.Expect(paused).NotTo(gomega.BeNil())
+		g.Expect(paused.Reason).To(gomega.Equal(condition.ReasonDowngradeBlocked))
+	})
+
+	t.Run("proceeds with the allow-downgrade annotation", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := installedKonflux("v0.2.0", map[string]string{constant.KonfluxAllowDowngradeAnnotation: "true"})
+
+		plan, blocked := (&KonfluxReconciler{}).beginUpgrade(context.Background(), konflux)
+		g.Expect(blocked).To(gomega.BeFalse())
+		g.Expect(plan).NotTo(gomega.BeNil())
+		g.Expect(apimeta.IsStatusConditionTrue(konflux.Status.Conditions, condition.TypeUpgrading)).To(gomega.BeTrue())
+	})
+}
+
+func TestFinishUpgrade(t *testing.T) {
+	withOperatorVersion(t, "v0.2.0")
+
+	t.Run("waits for components reconciled by an older operator", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		var ran []string
+		r := &KonfluxReconciler{UpgradeSteps: recordingSteps(&ran)}
+		konflux := installedKonflux("v0.1.0", nil)
+		plan, _ := r.beginUpgrade(context.Background(), konflux)
+		setReady(konflux)
+
+		g.Expect(r.finishUpgrade(context.Background(), konflux, plan, []string{"build-service"})).To(gomega.Succeed())
+		g.Expect(ran).To(gomega.BeEmpty())
+		g.Expect(konflux.Status.InstalledVersion).To(gomega.Equal("v0.1.0"))
+		upgrading := apimeta.FindStatusCondition(konflux.Status.Conditions, condition.TypeUpgrading)
+		g.Expect(upgrading.Message).To(gomega.HaveSuffix("waiting for build-service"))
+	})
+
+	t.Run("runs post-upgrade steps and records the installed version", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		var ran []string
+		r := &KonfluxReconciler{UpgradeSteps: recordingSteps(&ran)}
+		konflux := installedKonflux("v0.1.0", nil)
+		plan, _ := r.beginUpgrade(context.Background(), konflux)
+		g.Expect(r.runUpgradeSteps(context.Background(), konflux, plan.Pre)).To(gomega.Succeed())
+		setReady(konflux)
+
+		g.Expect(r.finishUpgrade(context.Background(), konflux, plan, nil)).To(gomega.Succeed())
+		g.Expect(ran).To(gomega.Equal([]string{"rename", "migrate"}))
+		g.Expect(konflux.Status.InstalledVersion).To(gomega.Equal("v0.2.0"))
+		g.Expect(konflux.Status.CompletedUpgradeSteps).To(gomega.BeEmpty())
+		upgrading := apimeta.FindStatusCondition(konflux.Status.Conditions, condition.TypeUpgrading)
+		g.Expect(upgrading.Status).To(gomega.Equal(metav1.ConditionFalse))
+		g.Expect(upgrading.Reason).To(gomega.Equal(condition.ReasonUpgradeSucceeded))
+	})
+
+	t.Run("records the version of a fresh installation once Ready", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		r := &KonfluxReconciler{}
+		konflux := installedKonflux("", nil)
+		plan, _ := r.beginUpgrade(context.Background(), konflux)
+
+		g.Expect(r.finishUpgrade(context.Background(), konflux, plan, nil)).To(gomega.Succeed())
+		g.Expect(konflux.Status.InstalledVersion).To(gomega.BeEmpty())
+
+		setReady(konflux)
+		g.Expect(r.finishUpgrade(context.Background(), konflux, plan, nil)).To(gomega.Succeed())
+		g.Expect(konflux.Status.InstalledVersion).To(gomega.Equal("v0.2.0"))
+	})
+}
+
+func TestRunUpgradeSteps_ResumesAfterFailure(t *testing.T) {
+	g := gomega.NewWithT(t)
+	var ran []string
+	fail := true
+	steps := []upgrade.Step{
+		{Name: "first", Run: func(context.Context, client.Client) error {
+			ran = append(ran, "first")
+			return nil
+		}},
+		{Name: "second", Run: func(context.Context, client.Client) error {
+			if fail {
+				return errors.New("boom")
+			}
+			ran = append(ran, "second")
+			return nil
+		}},
+	}
+	r := &KonfluxReconciler{}
+	konflux := installedKonflux("v0.1.0", nil)
+
+	g.Expect(r.runUpgradeSteps(context.Background(), konflux, steps)).To(gomega.MatchError(gomega.ContainSubstring("upgrade step second: boom")))
+	g.Expect(konflux.Status.CompletedUpgradeSteps).To(gomega.Equal([]string{"first"}))
+
+	fail = false
+	g.Expect(r.runUpgradeSteps(context.Background(), konflux, steps)).To(gomega.Succeed())
+	g.Expect(ran).To(gomega.Equal([]string{"first", "second"}))
+	g.Expect(konflux.Status.CompletedUpgradeSteps).To(gomega.Equal([]string{"first", "second"}))
+}
@@ -22,20 +22,24 @@ import (
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/common"
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
@@ -79,9 +83,9 @@ func resolveLogLevelEnvValue(level konfluxv1alpha1.LogLevel) (string, error) {
 }
 
 // NamespaceListerCleanupGVKs defines which resource types should be cleaned up when they are
-// no longer part of the desired state. All resources managed by this controller are always
-// applied, so no cleanup GVKs are needed (they're always tracked and never become orphans).
-var NamespaceListerCleanupGVKs = []schema.GroupVersionKind{}
+// no longer part of the desired state. Only the PodDisruptionBudget and HorizontalPodAutoscaler
+// are optional; every other resource is always applied.
+var NamespaceListerCleanupGVKs = common.AvailabilityCleanupGVKs
 
 // NamespaceListerClusterScopedAllowList restricts which cluster-scoped resources can be deleted
 // during orphan cleanup. This is a security measure to prevent attackers from
@@ -95,13 +99,18 @@ var NamespaceListerClusterScopedAllowList tracking.ClusterScopedAllowList = nil
 type KonfluxNamespaceListerReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxnamespacelisters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxnamespacelisters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxnamespacelisters/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services;serviceaccounts,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;clusterrolebindings,verbs=get;list;watch;create;patch;delete
@@ -129,9 +138,14 @@ func (r *KonfluxNamespaceListerReconciler) Reconcile(ctx context.Context, req ct
 	}
 
 	log.Info("Reconciling KonfluxNamespaceLister", "name", konfluxNamespaceLister.Name)
+	previousReady := recorder.ReadyCondition(konfluxNamespaceLister)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, konfluxNamespaceLister, string(manifests.NamespaceLister)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), konfluxNamespaceLister, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, konfluxNamespaceLister, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -140,6 +154,10 @@ func (r *KonfluxNamespaceListerReconciler) Reconcile(ctx context.Context, req ct
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.NamespaceLister),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(konfluxNamespaceLister),
+		Recorder:          r.Recorder,
 	})
 
 	// Apply all embedded manifests
@@ -153,10 +171,19 @@ func (r *KonfluxNamespaceListerReconciler) Reconcile(ctx context.Context, req ct
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, konfluxNamespaceLister, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxNamespaceLister status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, konfluxNamespaceLister); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(konfluxNamespaceLister, tc.Drifts(), string(manifests.NamespaceLister))
+	condition.RecordCleanup(konfluxNamespaceLister, tc.CleanupResults())
+	condition.RecordOperatorVersion(konfluxNamespaceLister)
+	condition.RecordManifestProvenance(konfluxNamespaceLister, r.ObjectStore.Provenance(manifests.NamespaceLister))
+	recorder.RecordReadyTransition(r.Recorder, konfluxNamespaceLister, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, konfluxNamespaceLister); err != nil {
@@ -184,6 +211,23 @@ func (r *KonfluxNamespaceListerReconciler) applyManifests(ctx context.Context, t
 			}
 		}
 
+		if err := common.ApplyPodPlacement(obj, owner.Spec.PodPlacement); err != nil {
+			return fmt.Errorf("failed to apply pod placement to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
+		if deployment, ok := obj.(*appsv1.Deployment); ok && owner.Spec.NamespaceLister != nil {
+			if err := common.ApplyAvailability(ctx, tc, deployment,
+				owner.Spec.NamespaceLister.PodDisruptionBudget, owner.Spec.NamespaceLister.Autoscaling); err != nil {
+				return err
+			}
+		}
+
 		// Apply with ownership using the tracking client
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
@@ -203,7 +247,11 @@ func applyNamespaceListerCustomizations(deployment *appsv1.Deployment, spec konf
 		containerSpec = spec.NamespaceLister.NamespaceLister
 	}
 
-	logLevelValue, err := resolveLogLevelEnvValue(spec.LogLevel)
+	var logLevel konfluxv1alpha1.LogLevel
+	if logging := spec.GetLogging(); logging != nil {
+		logLevel = logging.Level
+	}
+	logLevelValue, err := resolveLogLevelEnvValue(logLevel)
 	if err != nil {
 		return fmt.Errorf("invalid namespace-lister spec: %w", err)
 	}
@@ -232,6 +280,8 @@ func (r *KonfluxNamespaceListerReconciler) SetupWithManager(mgr ctrl.Manager) er
 		// Use predicates to filter out unnecessary updates and prevent reconcile loops
 		// Deployments: watch spec changes AND readiness status changes
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(predicate.DeploymentReadinessPredicate)).
+		Owns(&policyv1.PodDisruptionBudget{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&corev1.Namespace{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
@@ -23,12 +23,17 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
@@ -776,6 +781,47 @@ var _ = Describe("KonfluxNamespaceLister Controller", func() {
 	})
 })
 
+var _ = Describe("KonfluxNamespaceLister availability", func() {
+	It("creates the PodDisruptionBudget and HorizontalPodAutoscaler and removes them when unset", func(ctx context.Context) {
+		namespaceLister := &konfluxv1alpha1.KonfluxNamespaceLister{
+			ObjectMeta: metav1.ObjectMeta{Name: CRName},
+			Spec: konfluxv1alpha1.KonfluxNamespaceListerSpec{
+				NamespaceLister: &konfluxv1alpha1.NamespaceListerDeploymentSpec{
+					PodDisruptionBudget: &konfluxv1alpha1.PodDisruptionBudgetSpec{},
+					Autoscaling: &konfluxv1alpha1.AutoscalingSpec{
+						MinReplicas: ptr.To[int32](2),
+						MaxReplicas: 4,
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, namespaceLister)).To(Succeed())
+		DeferCleanup(testutil.DeleteAndWait, k8sClient, namespaceLister)
+
+		nn := types.NamespacedName{Name: namespaceListerNamespace, Namespace: namespaceListerNamespace}
+
+		By("waiting for the PodDisruptionBudget and HorizontalPodAutoscaler")
+		Eventually(func(g Gomega) {
+			pdb := &policyv1.PodDisruptionBudget{}
+			g.Expect(k8sClient.Get(ctx, nn, pdb)).To(Succeed())
+			g.Expect(pdb.Labels).To(HaveKey(constant.KonfluxOwnerLabel))
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			g.Expect(k8sClient.Get(ctx, nn, hpa)).To(Succeed())
+			g.Expect(hpa.Spec.MaxReplicas).To(Equal(int32(4)))
+		}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+
+		By("removing the availability settings")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(namespaceLister), namespaceLister)).To(Succeed())
+		namespaceLister.Spec.NamespaceLister = nil
+		Expect(k8sClient.Update(ctx, namespaceLister)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, nn, &policyv1.PodDisruptionBudget{}))).To(BeTrue())
+			g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, nn, &autoscalingv2.HorizontalPodAutoscaler{}))).To(BeTrue())
+		}).WithTimeout(testutil.EventuallyTimeout).WithPolling(testutil.EventuallyPolling).Should(Succeed())
+	})
+})
+
 var _ = Describe("applyNamespaceListerCustomizations", func() {
 	var deployment *appsv1.Deployment
 
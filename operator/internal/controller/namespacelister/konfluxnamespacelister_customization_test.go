@@ -74,6 +74,23 @@ func TestLogLevelWithRealManifest(t *testing.T) {
 		g.Expect(val).To(gomega.Equal("0"))
 	})
 
+	t.Run("logging.level takes precedence over logLevel", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := getNamespaceListerDeployment(t)
+		spec := konfluxv1alpha1.KonfluxNamespaceListerSpec{
+			LogLevel: konfluxv1alpha1.LogLevelError,
+			Logging:  &konfluxv1alpha1.LoggingSpec{Level: konfluxv1alpha1.LogLevelDebug},
+		}
+		err := applyNamespaceListerCustomizations(deployment, spec)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		container := testutil.FindContainer(deployment.Spec.Template.Spec.Containers, namespaceListerContainerName)
+		g.Expect(container).NotTo(gomega.BeNil())
+		val, found := findEnvValue(container.Env, envLogLevel)
+		g.Expect(found).To(gomega.BeTrue())
+		g.Expect(val).To(gomega.Equal("-4"))
+	})
+
 	t.Run("all enum values map to correct slog integers on real manifest", func(t *testing.T) {
 		cases := map[konfluxv1alpha1.LogLevel]string{
 			konfluxv1alpha1.LogLevelDebug: "-4",
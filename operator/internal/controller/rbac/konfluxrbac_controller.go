@@ -23,6 +23,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -30,6 +31,8 @@ import (
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
 )
@@ -60,7 +63,10 @@ var RBACClusterScopedAllowList tracking.ClusterScopedAllowList = nil
 type KonfluxRBACReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxrbacs,verbs=get;list;watch;create;update;patch;delete
@@ -89,9 +95,14 @@ func (r *KonfluxRBACReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	log.Info("Reconciling KonfluxRBAC", "name", konfluxRBAC.Name)
+	previousReady := recorder.ReadyCondition(konfluxRBAC)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, konfluxRBAC, string(manifests.RBAC)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), konfluxRBAC, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, konfluxRBAC, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -100,10 +111,14 @@ func (r *KonfluxRBACReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.RBAC),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(konfluxRBAC),
+		Recorder:          r.Recorder,
 	})
 
 	// Apply all embedded manifests
-	if err := r.applyManifests(ctx, tc); err != nil {
+	if err := r.applyManifests(ctx, tc, konfluxRBAC); err != nil {
 		return errHandler.HandleApplyError(ctx, err)
 	}
 
@@ -113,10 +128,19 @@ func (r *KonfluxRBACReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, konfluxRBAC, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxRBAC status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, konfluxRBAC); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(konfluxRBAC, tc.Drifts(), string(manifests.RBAC))
+	condition.RecordCleanup(konfluxRBAC, tc.CleanupResults())
+	condition.RecordOperatorVersion(konfluxRBAC)
+	condition.RecordManifestProvenance(konfluxRBAC, r.ObjectStore.Provenance(manifests.RBAC))
+	recorder.RecordReadyTransition(r.Recorder, konfluxRBAC, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, konfluxRBAC); err != nil {
@@ -129,13 +153,20 @@ func (r *KonfluxRBACReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 }
 
 // applyManifests loads and applies all embedded manifests to the cluster using the tracking client.
-func (r *KonfluxRBACReconciler) applyManifests(ctx context.Context, tc *tracking.Client) error {
+func (r *KonfluxRBACReconciler) applyManifests(ctx context.Context, tc *tracking.Client, owner *konfluxv1alpha1.KonfluxRBAC) error {
 	objects, err := r.ObjectStore.GetForComponent(manifests.RBAC)
 	if err != nil {
 		return fmt.Errorf("failed to get manifests for RBAC: %w", err)
 	}
 
 	for _, obj := range objects {
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		// Apply with ownership using the tracking client
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
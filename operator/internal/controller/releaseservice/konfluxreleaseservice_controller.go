@@ -19,11 +19,13 @@ package releaseservice
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -31,7 +33,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,6 +51,7 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	crdhandler "github.com/konflux-ci/konflux-ci/operator/internal/controller/handler"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
@@ -80,8 +85,9 @@ var releaseServiceConfigGVK = schema.GroupVersionKind{Group: releaseServiceConfi
 
 // ReleaseServiceCleanupGVKs defines which resource types should be cleaned up when they are
 // no longer part of the desired state. Metrics scrape resources may be skipped during apply
-// (componentMetrics disabled) or removed across releases while metrics stay enabled.
-var ReleaseServiceCleanupGVKs = append([]schema.GroupVersionKind(nil), kubernetes.ComponentMetricsOrphanCleanupGVKs...)
+// (componentMetrics disabled) or removed across releases while metrics stay enabled, and the
+// PodDisruptionBudget is only applied while it is configured.
+var ReleaseServiceCleanupGVKs = slices.Concat(kubernetes.ComponentMetricsOrphanCleanupGVKs, common.AvailabilityCleanupGVKs)
 
 // ReleaseServiceClusterScopedAllowList restricts which cluster-scoped resources can be deleted
 // during orphan cleanup. Only metrics scrape ClusterRoles and ClusterRoleBindings are listed;
@@ -99,18 +105,24 @@ var ReleaseServiceClusterScopedAllowList = tracking.ClusterScopedAllowList{
 type KonfluxReleaseServiceReconciler struct {
 	client.Client
 	Scheme       *runtime.Scheme
+	Recorder     events.EventRecorder
 	ObjectStore  *manifests.ObjectStore
 	TokenCreator kubernetes.TokenCreator
 	// SecretReader loads metrics TLS Secrets; prefer mgr.GetAPIReader() to avoid stale cache.
 	SecretReader        client.Reader
 	Clock               clock.Clock
 	TokenRotationEvents <-chan event.TypedGenericEvent[client.Object]
+	// ApplyCache, if set, skips applying manifests that are unchanged since the last reconcile.
+	ApplyCache *tracking.ApplyCache
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxreleaseservices,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxreleaseservices/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxreleaseservices/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services;secrets;serviceaccounts,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts/token,verbs=create
@@ -142,9 +154,14 @@ func (r *KonfluxReleaseServiceReconciler) Reconcile(ctx context.Context, req ctr
 	}
 
 	log.Info("Reconciling KonfluxReleaseService", "name", releaseService.Name)
+	previousReady := recorder.ReadyCondition(releaseService)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, releaseService, string(manifests.Release)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), releaseService, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, releaseService, crKind)
 
 	// Create a tracking client with ownership config for this reconcile.
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
@@ -153,6 +170,11 @@ func (r *KonfluxReleaseServiceReconciler) Reconcile(ctx context.Context, req ctr
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.Release),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(releaseService),
+		Recorder:          r.Recorder,
+		ApplyCache:        r.ApplyCache,
 	})
 
 	// Apply all embedded manifests
@@ -161,12 +183,17 @@ func (r *KonfluxReleaseServiceReconciler) Reconcile(ctx context.Context, req ctr
 	}
 
 	scrapeResult := reconcile.Result{}
-	if releaseService.Spec.ComponentMetrics.IsEnabled() && r.TokenCreator != nil {
+	scrapeMetrics := releaseService.Spec.ComponentMetrics.IsEnabled() && r.TokenCreator != nil
+	if scrapeMetrics && tc.Planning() {
+		common.KeepPrometheusScrapeObjects(tc, releaseServiceNamespace, "release-service")
+	} else if scrapeMetrics {
 		// Deferred ServiceMonitor apply: mint scrape token, wait for metrics TLS, apply SM.
 		scraper := kubernetes.OperandMetricsScraperSA(releaseServiceNamespace)
 		var scrapeErr error
 		scrapeResult, scrapeErr = common.ReconcilePrometheusScrapeToken(ctx, common.ScrapeTokenReconcilerConfig{
 			Client:             r.Client,
+			Recorder:           r.Recorder,
+			Owner:              releaseService,
 			SecretReader:       r.SecretReader,
 			Clock:              r.Clock,
 			TokenCreator:       r.TokenCreator,
@@ -203,10 +230,19 @@ func (r *KonfluxReleaseServiceReconciler) Reconcile(ctx context.Context, req ctr
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, releaseService, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxReleaseService status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, releaseService); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(releaseService, tc.Drifts(), string(manifests.Release))
+	condition.RecordCleanup(releaseService, tc.CleanupResults())
+	condition.RecordOperatorVersion(releaseService)
+	condition.RecordManifestProvenance(releaseService, r.ObjectStore.Provenance(manifests.Release))
+	recorder.RecordReadyTransition(r.Recorder, releaseService, previousReady)
 
 	// Update status
 	if err := r.Status().Update(ctx, releaseService); err != nil {
@@ -231,6 +267,7 @@ func (r *KonfluxReleaseServiceReconciler) applyManifests(ctx context.Context, tc
 		return fmt.Errorf("failed to get parsed manifests for Release: %w", err)
 	}
 
+	toApply := make([]client.Object, 0, len(objects))
 	for _, obj := range objects {
 		// Deferred ServiceMonitor apply: skip operand SM until ReconcilePrometheusScrapeToken
 		// applies it after prometheus-scrape-token and metrics TLS are ready.
@@ -265,15 +302,32 @@ func (r *KonfluxReleaseServiceReconciler) applyManifests(ctx context.Context, tc
 			}
 		}
 
+		if err := common.ApplyPodPlacement(obj, owner.Spec.PodPlacement); err != nil {
+			return fmt.Errorf("failed to apply pod placement to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		if err := customization.ApplyObjectPatches(obj, owner.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, owner.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, owner.Spec.ImagePull)
+		customization.ApplyProxy(obj, owner.Spec.EgressProxy)
+
 		if err := common.ApplyMetricsScraperBindingSubjects(releaseServiceNamespace, obj); err != nil {
 			return fmt.Errorf("apply metrics scraper binding subjects for %s: %w", obj.GetName(), err)
 		}
 
-		// Apply with ownership using the tracking client
-		if err := tc.ApplyOwned(ctx, obj); err != nil {
-			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
-				obj.GetNamespace(), obj.GetName(), tracking.GetKind(obj), manifests.Release, err)
+		if deployment, ok := obj.(*appsv1.Deployment); ok && deployment.Name == releaseControllerManagerDeploymentName {
+			if err := common.ApplyAvailability(ctx, tc, deployment, owner.Spec.ReleaseControllerManager.GetPodDisruptionBudget(), nil); err != nil {
+				return err
+			}
 		}
+
+		toApply = append(toApply, obj)
+	}
+
+	// Apply with ownership using the tracking client, several objects at a time.
+	if err := tc.ApplyOwnedBatch(ctx, toApply); err != nil {
+		return fmt.Errorf("%w from %s", err, manifests.Release)
 	}
 	return nil
 }
@@ -283,9 +337,9 @@ func applyReleaseServiceDeploymentCustomizations(deployment *appsv1.Deployment,
 	switch deployment.Name {
 	case releaseControllerManagerDeploymentName:
 		if spec.ReleaseControllerManager != nil {
-			deployment.Spec.Replicas = &spec.ReleaseControllerManager.Replicas
+			deployment.Spec.Replicas = ptr.To(spec.ReleaseControllerManager.GetReplicas())
 		}
-		if err := buildReleaseControllerManagerOverlay(spec.ReleaseControllerManager).ApplyToDeployment(deployment); err != nil {
+		if err := buildReleaseControllerManagerOverlay(spec.ReleaseControllerManager, spec.Logging).ApplyToDeployment(deployment); err != nil {
 			return err
 		}
 	}
@@ -293,17 +347,18 @@ func applyReleaseServiceDeploymentCustomizations(deployment *appsv1.Deployment,
 }
 
 // buildReleaseControllerManagerOverlay builds the pod overlay for the controller-manager deployment.
-func buildReleaseControllerManagerOverlay(spec *konfluxv1alpha1.ControllerManagerDeploymentSpec) *customization.PodOverlay {
+func buildReleaseControllerManagerOverlay(spec *konfluxv1alpha1.ControllerManagerDeploymentSpec, logging *konfluxv1alpha1.LoggingSpec) *customization.PodOverlay {
 	if spec == nil {
-		return customization.NewPodOverlay()
+		return customization.NewPodOverlay(customization.WithZapLogging(releaseManagerContainerName, logging))
 	}
 
-	deployCtx := customization.DeploymentContext{Replicas: spec.Replicas}
+	deployCtx := customization.DeploymentContext{Replicas: spec.GetReplicas()}
 	return customization.NewPodOverlay(
 		customization.WithContainerOpts(releaseManagerContainerName, deployCtx,
 			customization.FromContainerSpec(spec.Manager),
 		),
-		customization.WithLeaderElection(releaseManagerContainerName, spec.Replicas),
+		customization.WithLeaderElection(releaseManagerContainerName, deployCtx.Replicas),
+		customization.WithZapLogging(releaseManagerContainerName, logging),
 	)
 }
 
@@ -361,6 +416,7 @@ func (r *KonfluxReleaseServiceReconciler) SetupWithManager(mgr ctrl.Manager) err
 		Named("konfluxreleaseservice").
 		// Use predicates to filter out unnecessary updates and prevent reconcile loops
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(predicate.DeploymentReadinessPredicate)).
+		Owns(&policyv1.PodDisruptionBudget{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.Namespace{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
@@ -54,14 +54,14 @@ func getReleaseServiceDeployment(t *testing.T) *appsv1.Deployment {
 func TestBuildReleaseControllerManagerOverlay(t *testing.T) {
 	t.Run("nil spec returns empty overlay", func(t *testing.T) {
 		g := gomega.NewWithT(t)
-		overlay := buildReleaseControllerManagerOverlay(nil)
+		overlay := buildReleaseControllerManagerOverlay(nil, nil)
 		g.Expect(overlay).NotTo(gomega.BeNil())
 	})
 
 	t.Run("empty spec returns overlay without customizations", func(t *testing.T) {
 		g := gomega.NewWithT(t)
 		spec := &konfluxv1alpha1.ControllerManagerDeploymentSpec{}
-		overlay := buildReleaseControllerManagerOverlay(spec)
+		overlay := buildReleaseControllerManagerOverlay(spec, nil)
 		g.Expect(overlay).NotTo(gomega.BeNil())
 	})
 
@@ -83,7 +83,7 @@ func TestBuildReleaseControllerManagerOverlay(t *testing.T) {
 		}
 
 		deployment := getReleaseServiceDeployment(t)
-		overlay := buildReleaseControllerManagerOverlay(spec)
+		overlay := buildReleaseControllerManagerOverlay(spec, nil)
 		err := overlay.ApplyToDeployment(deployment)
 		g.Expect(err).NotTo(gomega.HaveOccurred())
 
@@ -112,7 +112,7 @@ func TestBuildReleaseControllerManagerOverlay(t *testing.T) {
 		g.Expect(managerContainer).NotTo(gomega.BeNil(), "manager container must exist in controller-manager deployment")
 		originalImage := managerContainer.Image
 
-		overlay := buildReleaseControllerManagerOverlay(spec)
+		overlay := buildReleaseControllerManagerOverlay(spec, nil)
 		err := overlay.ApplyToDeployment(deployment)
 		g.Expect(err).NotTo(gomega.HaveOccurred())
 
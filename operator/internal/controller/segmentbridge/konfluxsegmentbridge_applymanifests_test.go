@@ -46,6 +46,10 @@ func (f *fakeManifestSource) GetForComponent(_ manifests.Component) ([]client.Ob
 	return f.objects, f.err
 }
 
+func (f *fakeManifestSource) Provenance(_ manifests.Component) manifests.Provenance {
+	return manifests.Provenance{}
+}
+
 func TestApplyManifestsGetForComponentFailure(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -134,3 +138,61 @@ func TestApplyManifestsApplyOwnedFailure(t *testing.T) {
 	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(cm.Name)))
 	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("simulated apply failure")))
 }
+
+func TestApplyManifestsAppliesPodPlacement(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(batchv1.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(konfluxv1alpha1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	cj := &batchv1.CronJob{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+		ObjectMeta: metav1.ObjectMeta{Name: cronJobName, Namespace: segmentBridgeNamespace},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": cronJobName}},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: cronJobContainerName}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	owner := &konfluxv1alpha1.KonfluxSegmentBridge{ObjectMeta: metav1.ObjectMeta{Name: CRName}}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(owner).Build()
+	tc := tracking.NewClientWithOwnership(cl, tracking.OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     constant.KonfluxOwnerLabel,
+		ComponentLabelKey: constant.KonfluxComponentLabel,
+		Component:         string(manifests.SegmentBridge),
+		FieldManager:      FieldManager,
+	})
+
+	r := &KonfluxSegmentBridgeReconciler{
+		Client:      cl,
+		Scheme:      scheme,
+		ObjectStore: &fakeManifestSource{objects: []client.Object{cj}},
+	}
+
+	spec := konfluxv1alpha1.KonfluxSegmentBridgeSpec{
+		PodPlacement: &konfluxv1alpha1.PodPlacementSpec{
+			NodeSelector:      map[string]string{"node-role.kubernetes.io/infra": ""},
+			Tolerations:       []corev1.Toleration{{Key: "node-role.kubernetes.io/infra", Effect: corev1.TaintEffectNoSchedule}},
+			PriorityClassName: "konflux-critical",
+		},
+	}
+	g.Expect(r.applyManifests(context.Background(), tc, spec)).To(gomega.Succeed())
+
+	applied := &batchv1.CronJob{}
+	g.Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(cj), applied)).To(gomega.Succeed())
+	podSpec := applied.Spec.JobTemplate.Spec.Template.Spec
+	g.Expect(podSpec.NodeSelector).To(gomega.HaveKey("node-role.kubernetes.io/infra"))
+	g.Expect(podSpec.Tolerations).To(gomega.HaveLen(1))
+	g.Expect(podSpec.PriorityClassName).To(gomega.Equal("konflux-critical"))
+}
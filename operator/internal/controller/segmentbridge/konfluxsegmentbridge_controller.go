@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,9 +37,11 @@ import (
 	crpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/common"
 	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
@@ -90,14 +93,18 @@ var SegmentBridgeClusterScopedAllowList tracking.ClusterScopedAllowList = nil
 // being structurally unreachable through the always-succeeding embedded manifests.
 type manifestSource interface {
 	GetForComponent(component manifests.Component) ([]client.Object, error)
+	Provenance(component manifests.Component) manifests.Provenance
 }
 
 // KonfluxSegmentBridgeReconciler reconciles a KonfluxSegmentBridge object
 type KonfluxSegmentBridgeReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore manifestSource
 	ClusterInfo *clusterinfo.Info
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxsegmentbridges,verbs=get;list;watch;create;update;patch;delete
@@ -122,8 +129,13 @@ func (r *KonfluxSegmentBridgeReconciler) Reconcile(ctx context.Context, req ctrl
 	}
 
 	log.Info("Reconciling KonfluxSegmentBridge", "name", segmentBridge.Name)
+	previousReady := recorder.ReadyCondition(segmentBridge)
 
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), segmentBridge, crKind)
+	if paused, err := condition.HandlePaused(ctx, r.Client, segmentBridge, string(manifests.SegmentBridge)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
+
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, segmentBridge, crKind)
 
 	tc := tracking.NewClientWithOwnership(r.Client, tracking.OwnershipConfig{
 		Owner:             segmentBridge,
@@ -131,6 +143,10 @@ func (r *KonfluxSegmentBridgeReconciler) Reconcile(ctx context.Context, req ctrl
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.SegmentBridge),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(segmentBridge),
+		Recorder:          r.Recorder,
 	})
 
 	if err := r.applyManifests(ctx, tc, segmentBridge.Spec); err != nil {
@@ -146,9 +162,18 @@ func (r *KonfluxSegmentBridgeReconciler) Reconcile(ctx context.Context, req ctrl
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, segmentBridge, tc.Plan())
+	}
+
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, segmentBridge); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(segmentBridge, tc.Drifts(), string(manifests.SegmentBridge))
+	condition.RecordCleanup(segmentBridge, tc.CleanupResults())
+	condition.RecordOperatorVersion(segmentBridge)
+	condition.RecordManifestProvenance(segmentBridge, r.ObjectStore.Provenance(manifests.SegmentBridge))
+	recorder.RecordReadyTransition(r.Recorder, segmentBridge, previousReady)
 
 	if err := r.Status().Update(ctx, segmentBridge); err != nil {
 		log.Error(err, "Failed to update status")
@@ -173,6 +198,16 @@ func (r *KonfluxSegmentBridgeReconciler) applyManifests(ctx context.Context, tc
 			}
 		}
 
+		if err := common.ApplyPodPlacement(obj, spec.PodPlacement); err != nil {
+			return fmt.Errorf("failed to apply pod placement to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		if err := customization.ApplyObjectPatches(obj, spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, spec.ImagePull)
+		customization.ApplyProxy(obj, spec.EgressProxy)
+
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s) from %s: %w",
 				obj.GetNamespace(), obj.GetName(), tracking.GetKind(obj), manifests.SegmentBridge, err)
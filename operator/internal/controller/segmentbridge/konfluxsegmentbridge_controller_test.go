@@ -71,6 +71,10 @@ func (f *failingManifestSource) GetForComponent(_ manifests.Component) ([]client
 	return nil, f.err
 }
 
+func (f *failingManifestSource) Provenance(_ manifests.Component) manifests.Provenance {
+	return manifests.Provenance{}
+}
+
 var _ manifestSource = (*failingManifestSource)(nil)
 
 var _ = Describe("KonfluxSegmentBridge Controller", func() {
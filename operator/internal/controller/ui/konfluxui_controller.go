@@ -27,13 +27,16 @@ import (
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	consolev1 "github.com/openshift/api/console/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -48,6 +51,7 @@ import (
 	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
 	"github.com/konflux-ci/konflux-ci/operator/internal/controller/segmentbridge"
 	"github.com/konflux-ci/konflux-ci/operator/internal/predicate"
+	"github.com/konflux-ci/konflux-ci/operator/internal/recorder"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/consolelink"
 	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
@@ -120,6 +124,10 @@ var UICleanupGVKs = append([]schema.GroupVersionKind{
 	{Group: "", Version: "v1", Kind: "ServiceAccount"},
 	// Secret is optional - only created for OpenShift OAuth when configureLoginWithOpenShift is true
 	{Group: "", Version: "v1", Kind: "Secret"},
+	// PodDisruptionBudget is optional - only created when configured for a deployment with several replicas
+	{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"},
+	// HorizontalPodAutoscaler is optional - only created when proxy autoscaling is configured
+	{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
 }, kubernetes.ComponentMetricsOrphanCleanupGVKs...)
 
 // UIClusterScopedAllowList restricts which cluster-scoped resources can be deleted
@@ -146,8 +154,11 @@ var UIClusterScopedAllowList = tracking.ClusterScopedAllowList{
 type KonfluxUIReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    events.EventRecorder
 	ObjectStore *manifests.ObjectStore
 	ClusterInfo *clusterinfo.Info
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply.
+	LiveMetadata *tracking.LiveMetadata
 }
 
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxuis,verbs=get;list;watch;create;update;patch;delete
@@ -155,6 +166,8 @@ type KonfluxUIReconciler struct {
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxuis/finalizers,verbs=update
 // +kubebuilder:rbac:groups=konflux.konflux-ci.dev,resources=konfluxsegmentbridges,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;create;update;list;watch;patch;delete
@@ -188,9 +201,14 @@ func (r *KonfluxUIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	}
 
 	log.Info("Reconciling KonfluxUI", "name", ui.Name)
+	previousReady := recorder.ReadyCondition(ui)
+
+	if paused, err := condition.HandlePaused(ctx, r.Client, ui, string(manifests.UI)); paused || err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Create error handler for consistent error reporting
-	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), ui, crKind)
+	errHandler := condition.NewReconcileErrorHandler(log, r.Status(), r.Recorder, ui, crKind)
 
 	// Create a tracking client for this reconcile.
 	// Resources applied through this client are automatically tracked.
@@ -201,6 +219,10 @@ func (r *KonfluxUIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		ComponentLabelKey: constant.KonfluxComponentLabel,
 		Component:         string(manifests.UI),
 		FieldManager:      FieldManager,
+		DetectDrift:       true,
+		LiveMetadata:      r.LiveMetadata,
+		Plan:              condition.IsPlanRequested(ui),
+		Recorder:          r.Recorder,
 	})
 
 	// Ensure konflux-ui namespace exists
@@ -221,7 +243,7 @@ func (r *KonfluxUIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	// Reconcile Dex ConfigMap first (if configured) to get the ConfigMap name
 	// This must happen before applyManifests so we can set the correct ConfigMap reference
-	dexConfigMapName, err := r.reconcileDexConfigMap(ctx, ui, endpoint)
+	dexConfigMapName, err := r.reconcileDexConfigMap(ctx, tc, ui, endpoint)
 	if err != nil {
 		return errHandler.HandleWithReason(ctx, err, condition.ReasonConfigMapFailed, "reconcile Dex ConfigMap")
 	}
@@ -257,10 +279,19 @@ func (r *KonfluxUIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return errHandler.HandleCleanupError(ctx, err)
 	}
 
+	if tc.Planning() {
+		return ctrl.Result{}, condition.HandlePlan(ctx, r.Client, ui, tc.Plan())
+	}
+
 	// Check the status of owned deployments and update KonfluxUI status
 	if err := condition.UpdateComponentStatuses(ctx, r.Client, ui); err != nil {
 		return errHandler.HandleStatusUpdateError(ctx, err)
 	}
+	condition.RecordDrift(ui, tc.Drifts(), string(manifests.UI))
+	condition.RecordCleanup(ui, tc.CleanupResults())
+	condition.RecordOperatorVersion(ui)
+	condition.RecordManifestProvenance(ui, r.ObjectStore.Provenance(manifests.UI))
+	recorder.RecordReadyTransition(r.Recorder, ui, previousReady)
 
 	// Update ingress status
 	isOnOpenShift := r.ClusterInfo != nil && r.ClusterInfo.IsOpenShift()
@@ -351,6 +382,22 @@ func (r *KonfluxUIReconciler) applyManifests(ctx context.Context, tc *tracking.C
 			applyUIServiceAccountCustomizations(serviceAccount, openShiftLoginEnabled, endpoint)
 		}
 
+		if err := common.ApplyPodPlacement(obj, ui.Spec.PodPlacement); err != nil {
+			return fmt.Errorf("failed to apply pod placement to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		if err := customization.ApplyObjectPatches(obj, ui.Spec.Patches); err != nil {
+			return fmt.Errorf("failed to apply patches to %s %s: %w", tracking.GetKind(obj), obj.GetName(), err)
+		}
+		customization.ApplyImageMirrors(obj, ui.Spec.ImageRegistryMirrors)
+		customization.ApplyImagePull(obj, ui.Spec.ImagePull)
+		customization.ApplyProxy(obj, ui.Spec.EgressProxy)
+
+		if deployment, ok := obj.(*appsv1.Deployment); ok {
+			if err := applyUIAvailability(ctx, tc, deployment, ui); err != nil {
+				return err
+			}
+		}
+
 		if err := tc.ApplyOwned(ctx, obj); err != nil {
 			return fmt.Errorf("failed to apply object %s/%s (%s): %w",
 				obj.GetNamespace(), obj.GetName(), tracking.GetKind(obj), err)
@@ -390,6 +437,20 @@ func applyUIDeploymentCustomizations(deployment *appsv1.Deployment, ui *konfluxv
 	return nil
 }
 
+// applyUIAvailability applies the PodDisruptionBudget and HorizontalPodAutoscaler configured
+// for the proxy and dex deployments. Only the stateless proxy can be autoscaled.
+func applyUIAvailability(ctx context.Context, tc *tracking.Client, deployment *appsv1.Deployment, ui *konfluxv1alpha1.KonfluxUI) error {
+	switch deployment.Name {
+	case proxyDeploymentName:
+		proxySpec := ui.Spec.GetProxy()
+		return common.ApplyAvailability(ctx, tc, deployment, proxySpec.PodDisruptionBudget, proxySpec.Autoscaling)
+	case dexDeploymentName:
+		dexSpec := ui.Spec.GetDex()
+		return common.ApplyAvailability(ctx, tc, deployment, dexSpec.PodDisruptionBudget, nil)
+	}
+	return nil
+}
+
 // applyUIServiceCustomizations applies user-defined customizations to UI services.
 func applyUIServiceCustomizations(service *corev1.Service, ui *konfluxv1alpha1.KonfluxUI) {
 	if service.Name != proxyServiceName {
@@ -705,7 +766,7 @@ func generateRandomBytes(length int, urlSafe bool) ([]byte, error) {
 // It generates a content-based hash suffix for the ConfigMap name (like kustomize),
 // cleans up old ConfigMaps, and returns the new ConfigMap name.
 // endpoint is used for the dex issuer URL configuration.
-func (r *KonfluxUIReconciler) reconcileDexConfigMap(ctx context.Context, ui *konfluxv1alpha1.KonfluxUI, endpoint *url.URL) (string, error) {
+func (r *KonfluxUIReconciler) reconcileDexConfigMap(ctx context.Context, tc *tracking.Client, ui *konfluxv1alpha1.KonfluxUI, endpoint *url.URL) (string, error) {
 	// Resolve whether OpenShift login should be enabled
 	openShiftLoginEnabled := isOpenShiftLoginEnabled(ui, r.ClusterInfo)
 
@@ -728,6 +789,8 @@ func (r *KonfluxUIReconciler) reconcileDexConfigMap(ctx context.Context, ui *kon
 		)
 	}
 
+	dexConfig.Logger = dexLogger(ui.Spec.Logging)
+
 	configYAML, err := dexConfig.ToYAML()
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal Dex config to YAML: %w", err)
@@ -735,7 +798,7 @@ func (r *KonfluxUIReconciler) reconcileDexConfigMap(ctx context.Context, ui *kon
 
 	// Use hashedconfigmap to apply the ConfigMap with content-based hash suffix
 	hcm := hashedconfigmap.New(
-		r.Client,
+		tc.Writer(r.Client),
 		r.Scheme,
 		dexConfigMapBaseName,
 		uiNamespace,
@@ -752,6 +815,24 @@ func (r *KonfluxUIReconciler) reconcileDexConfigMap(ctx context.Context, ui *kon
 	return result.ConfigMapName, nil
 }
 
+// dexLogFormats maps a LogEncoder to the format of the Dex logger.
+var dexLogFormats = map[konfluxv1alpha1.LogEncoder]string{
+	konfluxv1alpha1.LogEncoderJSON:    "json",
+	konfluxv1alpha1.LogEncoderConsole: "text",
+}
+
+// dexLogger translates the logging settings of the UI into the Dex logger configuration.
+// It returns nil, which keeps the Dex defaults, when logging is not set.
+func dexLogger(logging *konfluxv1alpha1.LoggingSpec) *dex.Logger {
+	if logging == nil {
+		return nil
+	}
+	return &dex.Logger{
+		Level:  string(logging.Level),
+		Format: dexLogFormats[logging.Encoder],
+	}
+}
+
 // reconcileSegmentSecret creates a content-hashed Secret in the konflux-ui namespace
 // containing the Segment write key and API URL. The Secret is mounted into the
 // reverse proxy container.
@@ -850,6 +931,8 @@ func (r *KonfluxUIReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		// Use predicates to filter out unnecessary updates and prevent reconcile loops
 		// Deployments: watch spec changes AND readiness status changes
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(predicate.DeploymentReadinessPredicate)).
+		Owns(&policyv1.PodDisruptionBudget{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}, builder.WithPredicates(predicate.IgnoreStatusUpdatesPredicate)).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.Secret{}).
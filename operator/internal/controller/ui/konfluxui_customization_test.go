@@ -493,6 +493,19 @@ func TestBuildDexOverlay(t *testing.T) {
 	})
 }
 
+func TestDexLogger(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(dexLogger(nil)).To(gomega.BeNil())
+	g.Expect(dexLogger(&konfluxv1alpha1.LoggingSpec{
+		Level:   konfluxv1alpha1.LogLevelDebug,
+		Encoder: konfluxv1alpha1.LogEncoderConsole,
+	})).To(gomega.Equal(&dex.Logger{Level: "debug", Format: "text"}))
+	g.Expect(dexLogger(&konfluxv1alpha1.LoggingSpec{
+		Encoder: konfluxv1alpha1.LogEncoderJSON,
+	})).To(gomega.Equal(&dex.Logger{Format: "json"}))
+}
+
 func TestApplyUIServiceAccountCustomizations(t *testing.T) {
 	endpoint, err := url.Parse("https://dex.example.com:9443")
 	if err != nil {
@@ -30,8 +30,18 @@ var konfluxUp = prometheus.NewGauge(prometheus.GaugeOpts{
 	},
 })
 
+var componentPaused = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "konflux_component_paused",
+	Help: "Whether reconciliation of a Konflux component is paused (1) or not (0) via the paused annotation.",
+}, []string{"component"})
+
+var componentDrift = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "konflux_component_drifted_resources_total",
+	Help: "Number of times a resource managed for a Konflux component was found changed outside the operator and restored.",
+}, []string{"component"})
+
 func init() {
-	ctrlmetrics.Registry.MustRegister(konfluxUp)
+	ctrlmetrics.Registry.MustRegister(konfluxUp, componentPaused, componentDrift)
 }
 
 // SetKonfluxUp updates the konflux_up gauge based on the Konflux CR readiness.
@@ -47,3 +57,28 @@ func SetKonfluxUp(ready bool) {
 func KonfluxUpGauge() prometheus.Gauge {
 	return konfluxUp
 }
+
+// SetComponentPaused updates the konflux_component_paused gauge for the given component.
+func SetComponentPaused(component string, paused bool) {
+	if paused {
+		componentPaused.WithLabelValues(component).Set(1)
+	} else {
+		componentPaused.WithLabelValues(component).Set(0)
+	}
+}
+
+// ComponentPausedGauge returns the konflux_component_paused gauge for the given component for use in tests.
+func ComponentPausedGauge(component string) prometheus.Gauge {
+	return componentPaused.WithLabelValues(component)
+}
+
+// AddComponentDrift adds the number of drifted resources restored for the given component.
+func AddComponentDrift(component string, count int) {
+	componentDrift.WithLabelValues(component).Add(float64(count))
+}
+
+// ComponentDriftCounter returns the konflux_component_drifted_resources_total counter for the
+// given component for use in tests.
+func ComponentDriftCounter(component string) prometheus.Counter {
+	return componentDrift.WithLabelValues(component)
+}
@@ -33,3 +33,28 @@ func TestSetKonfluxUp(t *testing.T) {
 		t.Errorf("SetKonfluxUp(false): expected gauge value 0, got %f", v)
 	}
 }
+
+func TestSetComponentPaused(t *testing.T) {
+	SetComponentPaused("build-service", true)
+	if v := testutil.ToFloat64(ComponentPausedGauge("build-service")); v != 1 {
+		t.Errorf("SetComponentPaused(true): expected gauge value 1, got %f", v)
+	}
+	if v := testutil.ToFloat64(ComponentPausedGauge("ui")); v != 0 {
+		t.Errorf("expected other components to be unaffected, got %f", v)
+	}
+
+	SetComponentPaused("build-service", false)
+	if v := testutil.ToFloat64(ComponentPausedGauge("build-service")); v != 0 {
+		t.Errorf("SetComponentPaused(false): expected gauge value 0, got %f", v)
+	}
+}
+
+func TestAddComponentDrift(t *testing.T) {
+	before := testutil.ToFloat64(ComponentDriftCounter("release"))
+
+	AddComponentDrift("release", 2)
+	AddComponentDrift("release", 0)
+	if v := testutil.ToFloat64(ComponentDriftCounter("release")); v != before+2 {
+		t.Errorf("AddComponentDrift: expected counter value %f, got %f", before+2, v)
+	}
+}
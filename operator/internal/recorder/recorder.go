@@ -0,0 +1,164 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package recorder emits Kubernetes Events on Konflux CRs, so that `kubectl describe` shows
+// what the operator did to them and to the resources they own.
+package recorder
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/tracking"
+)
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// Name is the reporting controller of the Events emitted by the operator.
+const Name = "konflux-operator"
+
+// DefaultDedupWindow is how long an identical Event on the same object is suppressed.
+const DefaultDedupWindow = 5 * time.Minute
+
+// Event reasons emitted in addition to the condition reasons of failed reconciles.
+const (
+	// ReasonOrphanDeleted is emitted when a resource the operator no longer applies is deleted.
+	ReasonOrphanDeleted = tracking.EventReasonOrphanDeleted
+	// ReasonSecretRotated is emitted when the operator replaces a generated credential.
+	ReasonSecretRotated = "SecretRotated"
+	// ReasonBecameReady is emitted when the Ready condition turns True.
+	ReasonBecameReady = "BecameReady"
+	// ReasonBecameNotReady is emitted when the Ready condition turns False.
+	ReasonBecameNotReady = "BecameNotReady"
+)
+
+// Event actions, describing what the operator did or failed to do.
+const (
+	ActionReconcile = "Reconcile"
+	ActionDelete    = "Delete"
+	ActionRotate    = "Rotate"
+	ActionUpgrade   = "Upgrade"
+)
+
+// Deduplicating is an EventRecorder that drops an Event identical to one already emitted for
+// the same object within the window. Reconcilers retry failures with backoff and reconcile on
+// every watch event, so without it a persistent failure floods the object's Events.
+type Deduplicating struct {
+	delegate events.EventRecorder
+	window   time.Duration
+	clock    clock.PassiveClock
+
+	mu   sync.Mutex
+	seen map[eventKey]time.Time
+}
+
+type eventKey struct {
+	object    string
+	eventType string
+	reason    string
+	note      string
+}
+
+var _ events.EventRecorder = &Deduplicating{}
+
+// NewDeduplicating wraps delegate, suppressing repeats within window.
+func NewDeduplicating(delegate events.EventRecorder, window time.Duration) *Deduplicating {
+	return &Deduplicating{
+		delegate: delegate,
+		window:   window,
+		clock:    clock.RealClock{},
+		seen:     make(map[eventKey]time.Time),
+	}
+}
+
+// Eventf implements events.EventRecorder.
+func (d *Deduplicating) Eventf(
+	regarding runtime.Object,
+	related runtime.Object,
+	eventtype, reason, action, note string,
+	args ...interface{},
+) {
+	key := eventKey{eventType: eventtype, reason: reason, note: fmt.Sprintf(note, args...)}
+	if accessor, err := meta.Accessor(regarding); err == nil {
+		key.object = fmt.Sprintf("%s/%s/%s", accessor.GetNamespace(), accessor.GetName(), accessor.GetUID())
+	}
+
+	now := d.clock.Now()
+	d.mu.Lock()
+	for k, at := range d.seen {
+		if now.Sub(at) >= d.window {
+			delete(d.seen, k)
+		}
+	}
+	_, duplicate := d.seen[key]
+	if !duplicate {
+		d.seen[key] = now
+	}
+	d.mu.Unlock()
+
+	if !duplicate {
+		d.delegate.Eventf(regarding, related, eventtype, reason, action, "%s", key.note)
+	}
+}
+
+// Normal emits a Normal Event on obj. A nil recorder is ignored, so that reconcilers built
+// without one (e.g. in tests) do not need to check.
+func Normal(recorder events.EventRecorder, obj runtime.Object, reason, action, message string) {
+	if recorder != nil {
+		recorder.Eventf(obj, nil, corev1.EventTypeNormal, reason, action, "%s", message)
+	}
+}
+
+// Warning emits a Warning Event on obj. A nil recorder is ignored.
+func Warning(recorder events.EventRecorder, obj runtime.Object, reason, action, message string) {
+	if recorder != nil {
+		recorder.Eventf(obj, nil, corev1.EventTypeWarning, reason, action, "%s", message)
+	}
+}
+
+// ReadyCondition returns a copy of obj's Ready condition, to pass to RecordReadyTransition
+// once the reconcile has updated the conditions.
+func ReadyCondition(obj konfluxv1alpha1.ConditionAccessor) *metav1.Condition {
+	return meta.FindStatusCondition(obj.GetConditions(), constant.ConditionTypeReady).DeepCopy()
+}
+
+// RecordReadyTransition emits an Event when obj's Ready condition changed status since previous.
+func RecordReadyTransition(
+	recorder events.EventRecorder,
+	obj konfluxv1alpha1.ConditionAccessor,
+	previous *metav1.Condition,
+) {
+	current := meta.FindStatusCondition(obj.GetConditions(), constant.ConditionTypeReady)
+	if current == nil || current.Status == metav1.ConditionUnknown ||
+		(previous != nil && previous.Status == current.Status) {
+		return
+	}
+	if current.Status == metav1.ConditionTrue {
+		Normal(recorder, obj, ReasonBecameReady, ActionReconcile, current.Message)
+		return
+	}
+	Warning(recorder, obj, ReasonBecameNotReady, ActionReconcile, current.Reason+": "+current.Message)
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recorder
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+)
+
+func newDeduplicating(window time.Duration) (*Deduplicating, *events.FakeRecorder, *clocktesting.FakeClock) {
+	fake := events.NewFakeRecorder(10)
+	clk := clocktesting.NewFakeClock(time.Now())
+	d := NewDeduplicating(fake, window)
+	d.clock = clk
+	return d, fake, clk
+}
+
+func newRBAC(name string) *konfluxv1alpha1.KonfluxRBAC {
+	return &konfluxv1alpha1.KonfluxRBAC{
+		ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-uid")},
+	}
+}
+
+func setReady(obj *konfluxv1alpha1.KonfluxRBAC, status metav1.ConditionStatus, reason, message string) {
+	obj.SetConditions([]metav1.Condition{{
+		Type:    constant.ConditionTypeReady,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}})
+}
+
+func TestDeduplicating_SuppressesRepeatsWithinWindow(t *testing.T) {
+	g := NewWithT(t)
+	d, fake, clk := newDeduplicating(time.Minute)
+	obj := newRBAC("rbac")
+
+	Warning(d, obj, "ApplyFailed", ActionReconcile, "boom")
+	Warning(d, obj, "ApplyFailed", ActionReconcile, "boom")
+	g.Expect(fake.Events).To(Receive(Equal("Warning ApplyFailed boom")))
+	g.Expect(fake.Events).NotTo(Receive())
+
+	clk.Step(time.Minute)
+	Warning(d, obj, "ApplyFailed", ActionReconcile, "boom")
+	g.Expect(fake.Events).To(Receive(Equal("Warning ApplyFailed boom")))
+}
+
+func TestDeduplicating_KeepsDistinctEvents(t *testing.T) {
+	g := NewWithT(t)
+	d, fake, _ := newDeduplicating(time.Minute)
+	obj := newRBAC("rbac")
+
+	Warning(d, obj, "ApplyFailed", ActionReconcile, "boom")
+	Warning(d, obj, "ApplyFailed", ActionReconcile, "bang")
+	Warning(d, obj, "CleanupFailed", ActionReconcile, "boom")
+	Normal(d, obj, "ApplyFailed", ActionReconcile, "boom")
+	Warning(d, newRBAC("other"), "ApplyFailed", ActionReconcile, "boom")
+
+	g.Expect(fake.Events).To(HaveLen(5))
+}
+
+func TestNilRecorder(t *testing.T) {
+	g := NewWithT(t)
+	obj := newRBAC("rbac")
+	setReady(obj, metav1.ConditionTrue, "AllComponentsReady", "ready")
+
+	g.Expect(func() {
+		Normal(nil, obj, ReasonBecameReady, ActionReconcile, "ready")
+		Warning(nil, obj, ReasonBecameNotReady, ActionReconcile, "not ready")
+		RecordReadyTransition(nil, obj, nil)
+	}).NotTo(Panic())
+}
+
+func TestRecordReadyTransition(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous *metav1.Condition
+		status   metav1.ConditionStatus
+		want     []string
+	}{
+		{
+			name:   "first Ready condition",
+			status: metav1.ConditionTrue,
+			want:   []string{"Normal BecameReady All components are ready"},
+		},
+		{
+			name:     "became ready",
+			previous: &metav1.Condition{Status: metav1.ConditionFalse},
+			status:   metav1.ConditionTrue,
+			want:     []string{"Normal BecameReady All components are ready"},
+		},
+		{
+			name:     "became not ready",
+			previous: &metav1.Condition{Status: metav1.ConditionTrue},
+			status:   metav1.ConditionFalse,
+			want:     []string{"Warning BecameNotReady ComponentsNotReady: build-service is not ready"},
+		},
+		{
+			name:     "unchanged",
+			previous: &metav1.Condition{Status: metav1.ConditionTrue},
+			status:   metav1.ConditionTrue,
+		},
+		{
+			name:     "unknown",
+			previous: &metav1.Condition{Status: metav1.ConditionTrue},
+			status:   metav1.ConditionUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fake := events.NewFakeRecorder(10)
+			obj := newRBAC("rbac")
+			if tt.status == metav1.ConditionFalse {
+				setReady(obj, tt.status, "ComponentsNotReady", "build-service is not ready")
+			} else {
+				setReady(obj, tt.status, "AllComponentsReady", "All components are ready")
+			}
+
+			RecordReadyTransition(fake, obj, tt.previous)
+
+			close(fake.Events)
+			var got []string
+			for e := range fake.Events {
+				got = append(got, e)
+			}
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReadyCondition_ReturnsCopy(t *testing.T) {
+	g := NewWithT(t)
+	obj := newRBAC("rbac")
+	g.Expect(ReadyCondition(obj)).To(BeNil())
+
+	setReady(obj, metav1.ConditionTrue, "AllComponentsReady", "ready")
+	previous := ReadyCondition(obj)
+	setReady(obj, metav1.ConditionFalse, "ComponentsNotReady", "not ready")
+
+	g.Expect(previous.Status).To(Equal(metav1.ConditionTrue))
+}
@@ -0,0 +1,554 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render computes the objects the operator would apply for a Konflux CR without a
+// cluster. It runs the reconcilers of the operator against an in-memory client that simulates
+// a cluster of the requested platform, and collects every object they write.
+package render
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	konfluxv1beta1 "github.com/konflux-ci/konflux-ci/operator/api/v1beta1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/condition"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/applicationapi"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/buildservice"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/certmanager"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/cli"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/defaulttenant"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/enterprisecontract"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/imagecontroller"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/info"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/integrationservice"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/internalregistry"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/konflux"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/namespacelister"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/rbac"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/releaseservice"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/segmentbridge"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/ui"
+	webhookv1alpha1 "github.com/konflux-ci/konflux-ci/operator/internal/webhook/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+const (
+	// DefaultIngressDomain is the ingress domain of a simulated OpenShift cluster.
+	DefaultIngressDomain = "apps.example.com"
+	// redactedValue replaces the values of Secrets in the rendered objects.
+	redactedValue = "(redacted)"
+	// maxRounds bounds the reconcile rounds; a round rolls out at least one more phase of
+	// components, so it only has to exceed the depth of the component dependencies.
+	maxRounds = 10
+	// simulatedClusterID is the cluster ID of the simulated cluster.
+	simulatedClusterID = "00000000-0000-0000-0000-000000000000"
+)
+
+// Options configure Render.
+type Options struct {
+	// Platform is the platform of the simulated cluster.
+	Platform clusterinfo.Platform
+	// IngressDomain is the ingress domain of a simulated OpenShift cluster; DefaultIngressDomain
+	// when empty.
+	IngressDomain string
+	// ObjectStore provides the manifests of the components.
+	ObjectStore *manifests.ObjectStore
+}
+
+// Render returns the objects the operator would apply for konflux on a cluster of the platform
+// of opts, sorted by component, kind, namespace and name. The spec of konflux is defaulted like
+// the admission webhook does. The values of Secrets are redacted, and fields the API server sets
+// are left out.
+//
+// The simulated cluster has every dependency of Konflux installed and reports every component
+// Ready, so that all enabled components are rendered. Secrets that the CR references, such as
+// spec.imagePullSecrets, exist but are empty.
+func Render(
+	ctx context.Context, scheme *runtime.Scheme, konfluxCR *konfluxv1alpha1.Konflux, opts Options,
+) ([]*unstructured.Unstructured, error) {
+	konfluxCR = konfluxCR.DeepCopy()
+	if err := (&webhookv1alpha1.KonfluxDefaulter{}).Default(ctx, konfluxCR); err != nil {
+		return nil, fmt.Errorf("failed to default %s: %w", konfluxCR.Name, err)
+	}
+	// A paused or planned CR would not apply anything; render what it applies once resumed.
+	annotations := konfluxCR.GetAnnotations()
+	delete(annotations, constant.KonfluxPausedAnnotation)
+	delete(annotations, constant.KonfluxPlanAnnotation)
+	konfluxCR.SetAnnotations(annotations)
+
+	written := &writeLog{keys: make(map[objectKey]struct{})}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(clusterObjects(konfluxCR, opts)...).
+		WithStatusSubresource(statusObjects()...).
+		WithInterceptorFuncs(written.funcs()).
+		// The schema of client-go fails server-side applies of some built-in kinds, such as
+		// NetworkPolicies with several peers, in the fake client; a schema deduced from the
+		// objects applies every kind, with lists replaced as a whole.
+		WithTypeConverters(managedfields.NewDeducedTypeConverter()).
+		Build()
+
+	clusterInfo := clusterinfo.NewSimulated(opts.Platform)
+	konfluxReconciler := &konflux.KonfluxReconciler{
+		Client:      c,
+		Scheme:      scheme,
+		ClusterInfo: clusterInfo,
+		ObjectStore: opts.ObjectStore,
+	}
+	components := componentReconcilers(c, scheme, clusterInfo, opts.ObjectStore)
+
+	// Every round reconciles the Konflux CR and then the component CRs it created, and reports
+	// the components Ready so that the next round rolls out the components that depend on them.
+	// The rendering is complete once a round writes no new object and every reconcile succeeds.
+	var errs []error
+	for range maxRounds {
+		before := len(written.keys)
+		errs = nil
+		if _, err := konfluxReconciler.Reconcile(ctx, request(konfluxCR)); err != nil {
+			errs = append(errs, fmt.Errorf("Konflux %s: %w", konfluxCR.Name, err))
+		}
+		for _, component := range components {
+			cr := component.newObject()
+			if err := c.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			if _, err := component.Reconcile(ctx, request(cr)); err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: %w", component.kind, cr.GetName(), err))
+				continue
+			}
+			if err := markReady(ctx, c, cr); err != nil {
+				return nil, err
+			}
+		}
+		if len(errs) == 0 && len(written.keys) == before {
+			return written.objects(ctx, c, konfluxCR)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return nil, fmt.Errorf("the rendered objects did not settle after %d reconcile rounds", maxRounds)
+}
+
+// componentReconciler is the reconciler of a component CR kind.
+type componentReconciler struct {
+	reconcile.Reconciler
+	kind string
+	// newObject returns an empty component CR with only its name set.
+	newObject func() konfluxv1alpha1.ConditionAccessor
+}
+
+// componentReconcilers wires the reconcilers of the component CRs like the operator does,
+// without the metrics scrape tokens that need a cluster.
+func componentReconcilers(
+	c client.Client, scheme *runtime.Scheme, clusterInfo *clusterinfo.Info, store *manifests.ObjectStore,
+) []componentReconciler {
+	named := func(name string) metav1.ObjectMeta { return metav1.ObjectMeta{Name: name} }
+	return []componentReconciler{
+		{
+			Reconciler: &applicationapi.KonfluxApplicationAPIReconciler{Client: c, Scheme: scheme, ObjectStore: store},
+			kind:       "KonfluxApplicationAPI",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxApplicationAPI{ObjectMeta: named(applicationapi.CRName)}
+			},
+		},
+		{
+			Reconciler: &certmanager.KonfluxCertManagerReconciler{Client: c, Scheme: scheme, ObjectStore: store},
+			kind:       "KonfluxCertManager",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxCertManager{ObjectMeta: named(certmanager.CRName)}
+			},
+		},
+		{
+			Reconciler: &ui.KonfluxUIReconciler{Client: c, Scheme: scheme, ObjectStore: store, ClusterInfo: clusterInfo},
+			kind:       "KonfluxUI",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxUI{ObjectMeta: named(ui.CRName)}
+			},
+		},
+		{
+			Reconciler: &buildservice.KonfluxBuildServiceReconciler{
+				Client: c, Scheme: scheme, ObjectStore: store, ClusterInfo: clusterInfo, SecretReader: c,
+			},
+			kind: "KonfluxBuildService",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxBuildService{ObjectMeta: named(buildservice.CRName)}
+			},
+		},
+		{
+			Reconciler: &integrationservice.KonfluxIntegrationServiceReconciler{
+				Client: c, Scheme: scheme, ObjectStore: store, ClusterInfo: clusterInfo, SecretReader: c,
+			},
+			kind: "KonfluxIntegrationService",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxIntegrationService{ObjectMeta: named(integrationservice.CRName)}
+			},
+		},
+		{
+			Reconciler: &releaseservice.KonfluxReleaseServiceReconciler{
+				Client: c, Scheme: scheme, ObjectStore: store, SecretReader: c,
+			},
+			kind: "KonfluxReleaseService",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxReleaseService{ObjectMeta: named(releaseservice.CRName)}
+			},
+		},
+		{
+			Reconciler: &rbac.KonfluxRBACReconciler{Client: c, Scheme: scheme, ObjectStore: store},
+			kind:       "KonfluxRBAC",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxRBAC{ObjectMeta: named(rbac.CRName)}
+			},
+		},
+		{
+			Reconciler: &info.KonfluxInfoReconciler{Client: c, Scheme: scheme, ObjectStore: store, ClusterInfo: clusterInfo},
+			kind:       "KonfluxInfo",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxInfo{ObjectMeta: named(info.CRName)}
+			},
+		},
+		{
+			Reconciler: &namespacelister.KonfluxNamespaceListerReconciler{Client: c, Scheme: scheme, ObjectStore: store},
+			kind:       "KonfluxNamespaceLister",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxNamespaceLister{ObjectMeta: named(namespacelister.CRName)}
+			},
+		},
+		{
+			Reconciler: &enterprisecontract.KonfluxEnterpriseContractReconciler{Client: c, Scheme: scheme, ObjectStore: store},
+			kind:       "KonfluxEnterpriseContract",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxEnterpriseContract{ObjectMeta: named(enterprisecontract.CRName)}
+			},
+		},
+		{
+			Reconciler: &imagecontroller.KonfluxImageControllerReconciler{
+				Client: c, Scheme: scheme, ObjectStore: store, ClusterInfo: clusterInfo, SecretReader: c,
+			},
+			kind: "KonfluxImageController",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxImageController{ObjectMeta: named(imagecontroller.CRName)}
+			},
+		},
+		{
+			Reconciler: &internalregistry.KonfluxInternalRegistryReconciler{Client: c, Scheme: scheme, ObjectStore: store},
+			kind:       "KonfluxInternalRegistry",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxInternalRegistry{ObjectMeta: named(internalregistry.CRName)}
+			},
+		},
+		{
+			Reconciler: &defaulttenant.KonfluxDefaultTenantReconciler{Client: c, Scheme: scheme, ObjectStore: store},
+			kind:       "KonfluxDefaultTenant",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxDefaultTenant{ObjectMeta: named(defaulttenant.CRName)}
+			},
+		},
+		{
+			Reconciler: &segmentbridge.KonfluxSegmentBridgeReconciler{
+				Client: c, Scheme: scheme, ObjectStore: store, ClusterInfo: clusterInfo,
+			},
+			kind: "KonfluxSegmentBridge",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxSegmentBridge{ObjectMeta: named(segmentbridge.CRName)}
+			},
+		},
+		{
+			Reconciler: &cli.KonfluxCLIReconciler{Client: c, Scheme: scheme, ObjectStore: store},
+			kind:       "KonfluxCLI",
+			newObject: func() konfluxv1alpha1.ConditionAccessor {
+				return &konfluxv1alpha1.KonfluxCLI{ObjectMeta: named(cli.CRName)}
+			},
+		},
+	}
+}
+
+// statusObjects lists the kinds whose status is a subresource, so that the reconcilers'
+// status updates do not overwrite their specs.
+func statusObjects() []client.Object {
+	return []client.Object{
+		&konfluxv1alpha1.Konflux{},
+		&konfluxv1alpha1.KonfluxApplicationAPI{},
+		&konfluxv1alpha1.KonfluxBuildService{},
+		&konfluxv1alpha1.KonfluxCertManager{},
+		&konfluxv1alpha1.KonfluxCLI{},
+		&konfluxv1alpha1.KonfluxDefaultTenant{},
+		&konfluxv1alpha1.KonfluxEnterpriseContract{},
+		&konfluxv1alpha1.KonfluxImageController{},
+		&konfluxv1alpha1.KonfluxInfo{},
+		&konfluxv1alpha1.KonfluxIntegrationService{},
+		&konfluxv1alpha1.KonfluxInternalRegistry{},
+		&konfluxv1alpha1.KonfluxNamespaceLister{},
+		&konfluxv1alpha1.KonfluxRBAC{},
+		&konfluxv1alpha1.KonfluxReleaseService{},
+		&konfluxv1alpha1.KonfluxSegmentBridge{},
+		&konfluxv1alpha1.KonfluxUI{},
+	}
+}
+
+// clusterObjects returns the objects of the simulated cluster: the Konflux CR, what the
+// operator reads to identify the cluster, and the Secrets the CR references.
+func clusterObjects(konfluxCR *konfluxv1alpha1.Konflux, opts Options) []client.Object {
+	objects := []client.Object{
+		konfluxCR,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system", UID: simulatedClusterID}},
+	}
+	if opts.Platform.IsOpenShift() {
+		ingressDomain := opts.IngressDomain
+		if ingressDomain == "" {
+			ingressDomain = DefaultIngressDomain
+		}
+		objects = append(objects,
+			&configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: "version"},
+				Spec:       configv1.ClusterVersionSpec{ClusterID: simulatedClusterID},
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{{State: configv1.CompletedUpdate, Version: clusterinfo.UnknownVersion}},
+				},
+			},
+			&configv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Spec:       configv1.IngressSpec{Domain: ingressDomain},
+			},
+		)
+	}
+	for _, ref := range konfluxCR.Spec.ImagePullSecrets {
+		objects = append(objects, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name},
+			Type:       corev1.SecretTypeDockerConfigJson,
+		})
+	}
+	return objects
+}
+
+// markReady reports a component CR Ready, as its reconciler would once its deployments are
+// available.
+func markReady(ctx context.Context, c client.Client, cr konfluxv1alpha1.ConditionAccessor) error {
+	if err := c.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+		return err
+	}
+	condition.SetCondition(cr, metav1.Condition{
+		Type:    condition.TypeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Rendered",
+		Message: "Reported Ready by render",
+	})
+	return c.Status().Update(ctx, cr)
+}
+
+func request(obj client.Object) reconcile.Request {
+	return reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+}
+
+// objectKey identifies an object written by a reconciler.
+type objectKey struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+// writeLog records the objects written through a client, except status updates and dry runs.
+type writeLog struct {
+	keys map[objectKey]struct{}
+}
+
+func (w *writeLog) funcs() interceptor.Funcs {
+	return interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if err := c.Create(ctx, obj, opts...); err != nil {
+				return err
+			}
+			if len((&client.CreateOptions{}).ApplyOptions(opts).DryRun) == 0 {
+				return w.add(c, obj)
+			}
+			return nil
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if err := c.Update(ctx, obj, opts...); err != nil {
+				return err
+			}
+			if len((&client.UpdateOptions{}).ApplyOptions(opts).DryRun) == 0 {
+				return w.add(c, obj)
+			}
+			return nil
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if err := c.Patch(ctx, obj, patch, opts...); err != nil {
+				return err
+			}
+			if len((&client.PatchOptions{}).ApplyOptions(opts).DryRun) == 0 {
+				return w.add(c, obj)
+			}
+			return nil
+		},
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			if err := c.Delete(ctx, obj, opts...); err != nil {
+				return err
+			}
+			key, err := keyOf(c, obj)
+			if err != nil {
+				return err
+			}
+			delete(w.keys, key)
+			return nil
+		},
+	}
+}
+
+func (w *writeLog) add(c client.Client, obj client.Object) error {
+	key, err := keyOf(c, obj)
+	if err != nil {
+		return err
+	}
+	w.keys[key] = struct{}{}
+	return nil
+}
+
+func keyOf(c client.Client, obj client.Object) (objectKey, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return objectKey{}, fmt.Errorf("failed to determine GVK: %w", err)
+	}
+	return objectKey{gvk: gvk, namespace: obj.GetNamespace(), name: obj.GetName()}, nil
+}
+
+// objects reads the current state of the written objects, other than the Konflux CR itself,
+// and strips what the API server manages.
+func (w *writeLog) objects(ctx context.Context, c client.Client, konfluxCR *konfluxv1alpha1.Konflux) ([]*unstructured.Unstructured, error) {
+	konfluxKey, err := keyOf(c, konfluxCR)
+	if err != nil {
+		return nil, err
+	}
+	var objects []*unstructured.Unstructured
+	for key := range w.keys {
+		if key == konfluxKey {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(key.gvk)
+		if err := c.Get(ctx, types.NamespacedName{Namespace: key.namespace, Name: key.name}, obj); err != nil {
+			return nil, fmt.Errorf("failed to read rendered %s %s: %w", key.gvk.Kind, key.name, err)
+		}
+		clean(obj)
+		objects = append(objects, obj)
+	}
+	slices.SortFunc(objects, compareObjects)
+	return objects, nil
+}
+
+// clean removes the fields the API server sets and redacts the values of Secrets.
+func clean(obj *unstructured.Unstructured) {
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "managedFields"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	if refs, found, _ := unstructured.NestedSlice(obj.Object, "metadata", "ownerReferences"); found {
+		for _, ref := range refs {
+			if ref, ok := ref.(map[string]any); ok {
+				delete(ref, "uid")
+			}
+		}
+		_ = unstructured.SetNestedSlice(obj.Object, refs, "metadata", "ownerReferences")
+	}
+
+	if obj.GroupVersionKind().GroupKind() == (schema.GroupKind{Kind: "Secret"}) {
+		for _, field := range []string{"data", "stringData"} {
+			values, found, _ := unstructured.NestedMap(obj.Object, field)
+			if !found {
+				continue
+			}
+			for key := range values {
+				values[key] = redactedValue
+			}
+			_ = unstructured.SetNestedMap(obj.Object, values, field)
+		}
+	}
+}
+
+// compareObjects orders objects by component label, then kind, namespace and name. Objects
+// without a component label come first.
+func compareObjects(a, b *unstructured.Unstructured) int {
+	return slices.Compare(sortKey(a), sortKey(b))
+}
+
+func sortKey(obj *unstructured.Unstructured) []string {
+	gvk := obj.GroupVersionKind()
+	return []string{
+		obj.GetLabels()[constant.KonfluxComponentLabel], gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName(),
+	}
+}
+
+// WriteYAML writes objects to w as a stream of YAML documents.
+func WriteYAML(w io.Writer, objects []*unstructured.Unstructured) error {
+	for _, obj := range objects {
+		content, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeKonflux decodes a Konflux CR of any served version from YAML or JSON and converts it to
+// v1alpha1.
+func DecodeKonflux(scheme *runtime.Scheme, content []byte) (*konfluxv1alpha1.Konflux, error) {
+	obj, gvk, err := serializer.NewCodecFactory(scheme).UniversalDeserializer().Decode(content, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch cr := obj.(type) {
+	case *konfluxv1alpha1.Konflux:
+		return cr, nil
+	case *konfluxv1beta1.Konflux:
+		hub := &konfluxv1alpha1.Konflux{}
+		if err := cr.ConvertTo(hub); err != nil {
+			return nil, fmt.Errorf("failed to convert %s to v1alpha1: %w", gvk.Version, err)
+		}
+		return hub, nil
+	default:
+		return nil, fmt.Errorf("expected a Konflux CR, got %s", gvk.Kind)
+	}
+}
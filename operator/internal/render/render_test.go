@@ -0,0 +1,183 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	consolev1 "github.com/openshift/api/console/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	konfluxv1beta1 "github.com/konflux-ci/konflux-ci/operator/api/v1beta1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+const konfluxCRYAML = `apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: Konflux
+metadata:
+  name: konflux
+  annotations:
+    konflux.konflux-ci.dev/paused: "true"
+spec:
+  imagePullSecrets:
+  - name: mirror-credentials
+    namespace: konflux-operator
+  ui:
+    spec:
+      proxy:
+        replicas: 3
+`
+
+// newScheme registers the same types as the operator.
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+	utilruntime.Must(certmanagerv1.AddToScheme(scheme))
+	utilruntime.Must(configv1.Install(scheme))
+	utilruntime.Must(consolev1.AddToScheme(scheme))
+	utilruntime.Must(securityv1.Install(scheme))
+	utilruntime.Must(konfluxv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(konfluxv1beta1.AddToScheme(scheme))
+	return scheme
+}
+
+func renderCR(t *testing.T, content string, platform clusterinfo.Platform) []*unstructured.Unstructured {
+	t.Helper()
+	g := gomega.NewWithT(t)
+	scheme := newScheme()
+	store, err := manifests.NewObjectStore(scheme)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	konfluxCR, err := DecodeKonflux(scheme, []byte(content))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	objects, err := Render(context.Background(), scheme, konfluxCR, Options{Platform: platform, ObjectStore: store})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	return objects
+}
+
+func find(objects []*unstructured.Unstructured, kind, namespace, name string) *unstructured.Unstructured {
+	for _, obj := range objects {
+		if obj.GetKind() == kind && obj.GetNamespace() == namespace && obj.GetName() == name {
+			return obj
+		}
+	}
+	return nil
+}
+
+func TestRender(t *testing.T) {
+	g := gomega.NewWithT(t)
+	objects := renderCR(t, konfluxCRYAML, clusterinfo.Default)
+
+	// The paused annotation is ignored: the component CRs and the objects of their reconcilers
+	// are rendered, but not the Konflux CR itself.
+	g.Expect(find(objects, "Konflux", "", "konflux")).To(gomega.BeNil())
+	g.Expect(find(objects, "KonfluxBuildService", "", "konflux-build-service")).NotTo(gomega.BeNil())
+	deployment := find(objects, "Deployment", "build-service", "build-service-controller-manager")
+	g.Expect(deployment).NotTo(gomega.BeNil())
+	g.Expect(deployment.GetLabels()).To(gomega.HaveKeyWithValue(constant.KonfluxComponentLabel, "build-service"))
+	g.Expect(deployment.GetAnnotations()).To(gomega.HaveKey(manifests.ContentHashAnnotation))
+
+	// Components that depend on others are rolled out too.
+	g.Expect(find(objects, "KonfluxIntegrationService", "", "konflux-integration-service")).NotTo(gomega.BeNil())
+	// Disabled components are not.
+	g.Expect(find(objects, "KonfluxImageController", "", "konflux-image-controller")).To(gomega.BeNil())
+
+	// The CR's customizations are applied.
+	proxy := find(objects, "Deployment", "konflux-ui", "proxy")
+	g.Expect(proxy).NotTo(gomega.BeNil())
+	replicas, _, _ := unstructured.NestedInt64(proxy.Object, "spec", "replicas")
+	g.Expect(replicas).To(gomega.Equal(int64(3)))
+
+	// No ingress is managed by default outside OpenShift.
+	g.Expect(slices.ContainsFunc(objects, func(obj *unstructured.Unstructured) bool {
+		return obj.GetKind() == "Ingress" || obj.GetKind() == "ConsoleLink"
+	})).To(gomega.BeFalse())
+
+	// Server-set fields are stripped, and Secrets are redacted.
+	for _, obj := range objects {
+		g.Expect(obj.GetResourceVersion()).To(gomega.BeEmpty())
+		g.Expect(obj.Object).NotTo(gomega.HaveKey("status"))
+		for _, ref := range obj.GetOwnerReferences() {
+			g.Expect(ref.UID).To(gomega.BeEmpty())
+		}
+		if obj.GetKind() == "Secret" {
+			data, _, _ := unstructured.NestedMap(obj.Object, "data")
+			for _, value := range data {
+				g.Expect(value).To(gomega.Equal(redactedValue))
+			}
+		}
+	}
+	pullSecret := find(objects, "Secret", "build-service", "mirror-credentials")
+	g.Expect(pullSecret).NotTo(gomega.BeNil())
+
+	// The output is sorted and stable.
+	g.Expect(slices.IsSortedFunc(objects, compareObjects)).To(gomega.BeTrue())
+	var first, second bytes.Buffer
+	g.Expect(WriteYAML(&first, objects)).To(gomega.Succeed())
+	g.Expect(WriteYAML(&second, renderCR(t, konfluxCRYAML, clusterinfo.Default))).To(gomega.Succeed())
+	g.Expect(first.String()).To(gomega.Equal(second.String()))
+}
+
+func TestRender_OpenShift(t *testing.T) {
+	g := gomega.NewWithT(t)
+	objects := renderCR(t, konfluxCRYAML, clusterinfo.OpenShift)
+
+	ingress := find(objects, "Ingress", "konflux-ui", "konflux-ui")
+	g.Expect(ingress).NotTo(gomega.BeNil())
+	rules, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "rules")
+	g.Expect(rules).To(gomega.HaveLen(1))
+	g.Expect(rules[0]).To(gomega.HaveKeyWithValue("host", "konflux-ui-konflux-ui."+DefaultIngressDomain))
+	g.Expect(slices.ContainsFunc(objects, func(obj *unstructured.Unstructured) bool {
+		return obj.GetKind() == "ConsoleLink"
+	})).To(gomega.BeTrue())
+}
+
+func TestDecodeKonflux(t *testing.T) {
+	g := gomega.NewWithT(t)
+	scheme := newScheme()
+
+	konfluxCR, err := DecodeKonflux(scheme, []byte(`apiVersion: konflux.konflux-ci.dev/v1beta1
+kind: Konflux
+metadata:
+  name: konflux
+spec: {}
+`))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(konfluxCR.Name).To(gomega.Equal("konflux"))
+
+	_, err = DecodeKonflux(scheme, []byte(`apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: KonfluxUI
+metadata:
+  name: konflux-ui
+`))
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("expected a Konflux CR, got KonfluxUI")))
+}
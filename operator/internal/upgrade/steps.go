@@ -0,0 +1,130 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Steps is the registry of migration steps, in the order they run within a phase.
+// Add a step when a release renames or removes a resource that the operator no longer
+// applies, or changes the storage version of a CRD, for example:
+//
+//	{
+//		Name:    "remove-renamed-build-service-webhook",
+//		Version: "v0.3.0",
+//		Phase:   PreUpgrade,
+//		Run: DeleteObjects(&admissionregistrationv1.MutatingWebhookConfiguration{
+//			ObjectMeta: metav1.ObjectMeta{Name: "build-service-mutating-webhook"},
+//		}),
+//	},
+//
+// The operator must already have RBAC for the resources a step touches.
+var Steps = []Step{}
+
+// DeleteObjects returns a step that deletes the given objects, ignoring the ones that are
+// already gone. Only the name, namespace and type of each object are used.
+func DeleteObjects(objs ...client.Object) func(ctx context.Context, c client.Client) error {
+	return func(ctx context.Context, c client.Client) error {
+		for _, obj := range objs {
+			if err := c.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("delete %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+		return nil
+	}
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=update
+
+// MigrateStorageVersion returns a step that rewrites every object of the named CRD in its
+// current storage version and then drops the older versions from status.storedVersions,
+// so that a later release can stop serving them. It does nothing if the CRD does not exist.
+func MigrateStorageVersion(crdName string) func(ctx context.Context, c client.Client) error {
+	return func(ctx context.Context, c client.Client) error {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := c.Get(ctx, client.ObjectKey{Name: crdName}, crd); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("get CRD %s: %w", crdName, err)
+		}
+
+		var storageVersion string
+		for _, v := range crd.Spec.Versions {
+			if v.Storage {
+				storageVersion = v.Name
+			}
+		}
+		if storageVersion == "" {
+			return fmt.Errorf("CRD %s has no storage version", crdName)
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   crd.Spec.Group,
+			Version: storageVersion,
+			Kind:    crd.Spec.Names.ListKind,
+		})
+		if err := c.List(ctx, list); err != nil {
+			return fmt.Errorf("list %s: %w", crdName, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if err := rewrite(ctx, c, obj); err != nil {
+				return fmt.Errorf("rewrite %s %s/%s: %w", crd.Spec.Names.Kind, obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+
+		// Every object is now stored in storageVersion, so the older versions can be dropped.
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			if err := c.Get(ctx, client.ObjectKey{Name: crdName}, crd); err != nil {
+				return err
+			}
+			crd.Status.StoredVersions = []string{storageVersion}
+			return c.Status().Update(ctx, crd)
+		})
+		if err != nil {
+			return fmt.Errorf("update stored versions of CRD %s: %w", crdName, err)
+		}
+		return nil
+	}
+}
+
+// rewrite makes the API server write obj again, encoded in the storage version, with an
+// update without changes. On a conflict the object is read again and the update retried,
+// so that it is certain to be rewritten. An object deleted in the meantime needs no rewrite.
+func rewrite(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := c.Update(ctx, obj)
+		if apierrors.IsConflict(err) {
+			if getErr := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); getErr != nil {
+				return getErr
+			}
+		}
+		return err
+	})
+	return client.IgnoreNotFound(err)
+}
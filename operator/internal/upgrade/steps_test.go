@@ -0,0 +1,172 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func newFakeClient(g *WithT, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(konfluxv1alpha1.AddToScheme(scheme)).To(Succeed())
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&apiextensionsv1.CustomResourceDefinition{}).
+		Build()
+}
+
+func TestDeleteObjects(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	renamed := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "old-name", Namespace: "build-service"}}
+	c := newFakeClient(g, renamed)
+
+	run := DeleteObjects(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "old-name", Namespace: "build-service"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "already-gone", Namespace: "build-service"}},
+	)
+	g.Expect(run(ctx, c)).To(Succeed())
+
+	err := c.Get(ctx, client.ObjectKeyFromObject(renamed), &corev1.ConfigMap{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	// Running the step again is a no-op.
+	g.Expect(run(ctx, c)).To(Succeed())
+}
+
+func newMultiVersionCRD() *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "konfluxes.konflux.konflux-ci.dev"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: konfluxv1alpha1.GroupVersion.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Konflux", ListKind: "KonfluxList"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true, Storage: true},
+				{Name: "v1beta1", Served: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			StoredVersions: []string{"v1beta1", "v1alpha1"},
+		},
+	}
+}
+
+func TestMigrateStorageVersion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	crd := newMultiVersionCRD()
+	konflux := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: "konflux"}}
+	c := newFakeClient(g, crd, konflux)
+
+	before := &konfluxv1alpha1.Konflux{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(konflux), before)).To(Succeed())
+
+	g.Expect(MigrateStorageVersion(crd.Name)(ctx, c)).To(Succeed())
+
+	after := &konfluxv1alpha1.Konflux{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(konflux), after)).To(Succeed())
+	g.Expect(after.ResourceVersion).NotTo(Equal(before.ResourceVersion), "object should be rewritten")
+
+	updated := &apiextensionsv1.CustomResourceDefinition{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(crd), updated)).To(Succeed())
+	g.Expect(updated.Status.StoredVersions).To(Equal([]string{"v1alpha1"}))
+}
+
+func TestMigrateStorageVersion_MissingCRD(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(MigrateStorageVersion("missing.example.com")(context.Background(), newFakeClient(g))).To(Succeed())
+}
+
+func TestMigrateStorageVersion_Conflict(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	crd := newMultiVersionCRD()
+	konflux := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: "konflux"}}
+	conflicts := 0
+	c := fake.NewClientBuilder().
+		WithScheme(newFakeClient(g).Scheme()).
+		WithObjects(crd, konflux).
+		WithStatusSubresource(&apiextensionsv1.CustomResourceDefinition{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				// Someone else updates the object between the list and the first rewrite.
+				if obj.GetName() == konflux.Name && conflicts == 0 {
+					conflicts++
+					return apierrors.NewConflict(schema.GroupResource{}, obj.GetName(), nil)
+				}
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	before := &konfluxv1alpha1.Konflux{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(konflux), before)).To(Succeed())
+
+	g.Expect(MigrateStorageVersion(crd.Name)(ctx, c)).To(Succeed())
+
+	after := &konfluxv1alpha1.Konflux{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(konflux), after)).To(Succeed())
+	g.Expect(after.ResourceVersion).NotTo(Equal(before.ResourceVersion), "object should be rewritten after the conflict")
+
+	updated := &apiextensionsv1.CustomResourceDefinition{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(crd), updated)).To(Succeed())
+	g.Expect(updated.Status.StoredVersions).To(Equal([]string{"v1alpha1"}))
+}
+
+func TestMigrateStorageVersion_RewriteFails(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	crd := newMultiVersionCRD()
+	konflux := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: "konflux"}}
+	c := fake.NewClientBuilder().
+		WithScheme(newFakeClient(g).Scheme()).
+		WithObjects(crd, konflux).
+		WithStatusSubresource(&apiextensionsv1.CustomResourceDefinition{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				if obj.GetName() == konflux.Name {
+					return apierrors.NewForbidden(schema.GroupResource{}, obj.GetName(), nil)
+				}
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	g.Expect(MigrateStorageVersion(crd.Name)(ctx, c)).NotTo(Succeed())
+
+	// The old version may still be in storage, so it must stay in storedVersions.
+	updated := &apiextensionsv1.CustomResourceDefinition{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(crd), updated)).To(Succeed())
+	g.Expect(updated.Status.StoredVersions).To(Equal([]string{"v1beta1", "v1alpha1"}))
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package upgrade plans the migration steps that run when the operator moves a Konflux
+// installation from one version to another.
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+)
+
+// Phase tells when a Step runs relative to rolling out the new manifests.
+type Phase string
+
+const (
+	// PreUpgrade steps run before any component of the new version is applied.
+	PreUpgrade Phase = "PreUpgrade"
+	// PostUpgrade steps run once every component of the new version is Ready.
+	PostUpgrade Phase = "PostUpgrade"
+)
+
+// Step is a migration that runs once when an installation is upgraded across Version.
+// Steps may be retried after a failure or a lost status update, so Run must be idempotent.
+type Step struct {
+	// Name identifies the step in status and logs; it must be unique.
+	Name string
+	// Version is the first operator version that needs the step. The step runs when
+	// upgrading from an older version to Version or a newer one.
+	Version string
+	// Phase selects whether the step runs before or after the rollout.
+	Phase Phase
+	// Run performs the migration.
+	Run func(ctx context.Context, c client.Client) error
+}
+
+// Plan is the set of steps needed to move an installation from one version to another.
+type Plan struct {
+	From string
+	To   string
+	Pre  []Step
+	Post []Step
+}
+
+// NewPlan selects the steps that apply to an upgrade from "from" to "to", keeping their order.
+// Versions that are not valid semantic versions (e.g. development builds, which carry a git
+// SHA) do not exclude any step, so that an upgrade from an unknown version runs every
+// migration up to "to". Steps are idempotent, so running them again between builds is safe.
+func NewPlan(steps []Step, from, to string) Plan {
+	plan := Plan{From: from, To: to}
+	from, to = semanticVersion(from), semanticVersion(to)
+	for _, step := range steps {
+		if to != "" && semver.Compare(step.Version, to) > 0 {
+			continue
+		}
+		if from != "" && semver.Compare(step.Version, from) <= 0 {
+			continue
+		}
+		switch step.Phase {
+		case PreUpgrade:
+			plan.Pre = append(plan.Pre, step)
+		case PostUpgrade:
+			plan.Post = append(plan.Post, step)
+		}
+	}
+	return plan
+}
+
+// Comparable reports whether the order of two versions is known, that is whether both are valid
+// semantic versions as release builds are tagged. Builds of an untagged commit carry its git SHA
+// instead, so neither a downgrade to nor from such a build can be detected.
+func Comparable(from, to string) bool {
+	return semanticVersion(from) != "" && semanticVersion(to) != ""
+}
+
+// IsDowngrade reports whether "to" is an older version than "from".
+// It is false when the versions are not Comparable.
+func IsDowngrade(from, to string) bool {
+	return Comparable(from, to) && semver.Compare(semanticVersion(to), semanticVersion(from)) < 0
+}
+
+// semanticVersion returns v in the form golang.org/x/mod/semver expects, adding the leading
+// "v" that versions set without it (like the Makefile's VERSION) lack. It returns "" when v is
+// not a full MAJOR.MINOR.PATCH version: the shorthands semver accepts, such as "v1", are
+// rejected because a short git SHA made only of digits would otherwise pass for one.
+func semanticVersion(v string) string {
+	if !strings.HasPrefix(v, "v") {
+		v = "v" + v
+	}
+	if !semver.IsValid(v) {
+		return ""
+	}
+	core := strings.TrimSuffix(strings.TrimSuffix(v, semver.Build(v)), semver.Prerelease(v))
+	if strings.Count(core, ".") != 2 {
+		return ""
+	}
+	return v
+}
+
+// DowngradeBlocked reports whether the running operator version is older than the version
+// installed on the cluster and the downgrade was not allowed with the allow-downgrade annotation.
+func DowngradeBlocked(konflux *konfluxv1alpha1.Konflux, running string) bool {
+	return IsDowngrade(konflux.Status.InstalledVersion, running) &&
+		konflux.GetAnnotations()[constant.KonfluxAllowDowngradeAnnotation] != "true"
+}
+
+// Validate checks that every step has a unique name, a valid version and a known phase.
+func Validate(steps []Step) error {
+	seen := make(map[string]bool, len(steps))
+	for _, step := range steps {
+		if step.Name == "" {
+			return fmt.Errorf("upgrade step without a name")
+		}
+		if seen[step.Name] {
+			return fmt.Errorf("duplicate upgrade step %q", step.Name)
+		}
+		seen[step.Name] = true
+		if !semver.IsValid(step.Version) {
+			return fmt.Errorf("upgrade step %q: invalid version %q", step.Name, step.Version)
+		}
+		if step.Phase != PreUpgrade && step.Phase != PostUpgrade {
+			return fmt.Errorf("upgrade step %q: unknown phase %q", step.Name, step.Phase)
+		}
+		if step.Run == nil {
+			return fmt.Errorf("upgrade step %q: Run is not set", step.Name)
+		}
+	}
+	return nil
+}
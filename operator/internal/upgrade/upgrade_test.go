@@ -0,0 +1,199 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/constant"
+)
+
+func noop(context.Context, client.Client) error { return nil }
+
+func stepNames(steps []Step) []string {
+	names := make([]string, 0, len(steps))
+	for _, step := range steps {
+		names = append(names, step.Name)
+	}
+	return names
+}
+
+func TestNewPlan(t *testing.T) {
+	steps := []Step{
+		{Name: "pre-0.2", Version: "v0.2.0", Phase: PreUpgrade, Run: noop},
+		{Name: "post-0.2", Version: "v0.2.0", Phase: PostUpgrade, Run: noop},
+		{Name: "pre-0.3", Version: "v0.3.0", Phase: PreUpgrade, Run: noop},
+		{Name: "pre-0.4", Version: "v0.4.0", Phase: PreUpgrade, Run: noop},
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		wantPre  []string
+		wantPost []string
+	}{
+		{
+			name:     "runs the steps introduced after the installed version",
+			from:     "v0.1.0",
+			to:       "v0.3.0",
+			wantPre:  []string{"pre-0.2", "pre-0.3"},
+			wantPost: []string{"post-0.2"},
+		},
+		{
+			name:    "skips the steps the installed version already needed",
+			from:    "v0.2.0",
+			to:      "v0.4.1",
+			wantPre: []string{"pre-0.3", "pre-0.4"},
+		},
+		{
+			name:     "runs every step up to the target from an unknown version",
+			from:     "unknown",
+			to:       "v0.2.0",
+			wantPre:  []string{"pre-0.2"},
+			wantPost: []string{"post-0.2"},
+		},
+		{
+			name:     "runs every step up to the target from a development build",
+			from:     "3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39",
+			to:       "v0.3.0",
+			wantPre:  []string{"pre-0.2", "pre-0.3"},
+			wantPost: []string{"post-0.2"},
+		},
+		{
+			name:     "runs every step between development builds",
+			from:     "3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39",
+			to:       "8e4b7d0c1a2f3e4d5c6b7a8f9e0d1c2b3a4f5e6d",
+			wantPre:  []string{"pre-0.2", "pre-0.3", "pre-0.4"},
+			wantPost: []string{"post-0.2"},
+		},
+		{
+			name:    "orders versions without the leading v",
+			from:    "0.2.0",
+			to:      "0.4.1",
+			wantPre: []string{"pre-0.3", "pre-0.4"},
+		},
+		{
+			name: "runs nothing on a downgrade",
+			from: "v0.4.0",
+			to:   "v0.3.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			plan := NewPlan(steps, tt.from, tt.to)
+			g.Expect(plan.From).To(Equal(tt.from))
+			g.Expect(plan.To).To(Equal(tt.to))
+			g.Expect(stepNames(plan.Pre)).To(HaveExactElements(tt.wantPre))
+			g.Expect(stepNames(plan.Post)).To(HaveExactElements(tt.wantPost))
+		})
+	}
+}
+
+func TestIsDowngrade(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(IsDowngrade("v0.3.0", "v0.2.9")).To(BeTrue())
+	g.Expect(IsDowngrade("v0.3.0", "v0.3.0")).To(BeFalse())
+	g.Expect(IsDowngrade("v0.3.0", "v0.4.0")).To(BeFalse())
+	g.Expect(IsDowngrade("v0.3.0", "unknown")).To(BeFalse())
+	g.Expect(IsDowngrade("", "v0.1.0")).To(BeFalse())
+	// Builds of untagged commits are versioned by their git SHA, which has no order.
+	g.Expect(IsDowngrade("v0.3.0", "3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39")).To(BeFalse())
+	g.Expect(IsDowngrade("3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39", "v0.2.0")).To(BeFalse())
+	// Builds from the Makefile are versioned without the leading "v".
+	g.Expect(IsDowngrade("0.0.1", "0.0.0")).To(BeTrue())
+	g.Expect(IsDowngrade("v0.3.0", "0.2.9")).To(BeTrue())
+	g.Expect(IsDowngrade("0.0.1", "0.0.2")).To(BeFalse())
+}
+
+func TestComparable(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(Comparable("v0.2.0", "v0.3.0")).To(BeTrue())
+	g.Expect(Comparable("v0.2.0", "3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39")).To(BeFalse())
+	g.Expect(Comparable("unknown", "v0.3.0")).To(BeFalse())
+	g.Expect(Comparable("0.0.1", "v0.3.0")).To(BeTrue())
+	// A short git SHA made only of digits is not a version.
+	g.Expect(Comparable("v0.2.0", "1234567")).To(BeFalse())
+}
+
+func TestDowngradeBlocked(t *testing.T) {
+	g := NewWithT(t)
+	konflux := &konfluxv1alpha1.Konflux{
+		Status: konfluxv1alpha1.KonfluxStatus{InstalledVersion: "v0.3.0"},
+	}
+	g.Expect(DowngradeBlocked(konflux, "v0.2.0")).To(BeTrue())
+	g.Expect(DowngradeBlocked(konflux, "v0.3.1")).To(BeFalse())
+
+	konflux.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{constant.KonfluxAllowDowngradeAnnotation: "true"},
+	}
+	g.Expect(DowngradeBlocked(konflux, "v0.2.0")).To(BeFalse())
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   []Step
+		wantErr string
+	}{
+		{
+			name:    "missing name",
+			steps:   []Step{{Version: "v0.1.0", Phase: PreUpgrade, Run: noop}},
+			wantErr: "without a name",
+		},
+		{
+			name: "duplicate name",
+			steps: []Step{
+				{Name: "a", Version: "v0.1.0", Phase: PreUpgrade, Run: noop},
+				{Name: "a", Version: "v0.2.0", Phase: PostUpgrade, Run: noop},
+			},
+			wantErr: "duplicate",
+		},
+		{
+			name:    "invalid version",
+			steps:   []Step{{Name: "a", Version: "0.1", Phase: PreUpgrade, Run: noop}},
+			wantErr: "invalid version",
+		},
+		{
+			name:    "unknown phase",
+			steps:   []Step{{Name: "a", Version: "v0.1.0", Phase: "During", Run: noop}},
+			wantErr: "unknown phase",
+		},
+		{
+			name:    "missing Run",
+			steps:   []Step{{Name: "a", Version: "v0.1.0", Phase: PreUpgrade}},
+			wantErr: "Run is not set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(Validate(tt.steps)).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestSteps_AreValid(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(Validate(Steps)).To(Succeed())
+}
@@ -0,0 +1,423 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validate checks Konflux CRs without a cluster, the way the API server admits them:
+// against the OpenAPI schema and CEL rules of the generated CRDs, then with the operator's
+// admission webhooks.
+package validate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/listtype"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	apiextensionsvalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/yaml"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/config/crd"
+	webhookv1alpha1 "github.com/konflux-ci/konflux-ci/operator/internal/webhook/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+const (
+	// parseErrorType is the Type of a violation for a document that is not a Kubernetes object.
+	parseErrorType = "ParseError"
+	// unknownFieldType is the Type of a violation for a field the schema does not define,
+	// which the API server rejects when fields are validated strictly, as kubectl does.
+	unknownFieldType = "FieldValueUnknown"
+)
+
+// Violation is a reason for the API server to reject a document.
+type Violation struct {
+	// Field is the path of the offending field, such as spec.ui.spec.ingress.fqdn. It is empty
+	// when the document as a whole is rejected.
+	Field string `json:"field,omitempty"`
+	// Type is the type of the violation, such as FieldValueInvalid or FieldValueRequired.
+	Type string `json:"type"`
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// Result is the outcome of validating one YAML document of a file.
+type Result struct {
+	File string `json:"file"`
+	// Line is the line of the file the document starts at.
+	Line       int    `json:"line"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Name       string `json:"name,omitempty"`
+	// Skipped is set for objects outside the konflux.konflux-ci.dev group, which are not
+	// validated.
+	Skipped    bool        `json:"skipped,omitempty"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Options configures the checks that depend on the cluster.
+type Options struct {
+	// Platform selects the platform-dependent checks, such as whether the UI ingress is
+	// enabled by default. Every dependency of Konflux is assumed to be installed.
+	Platform clusterinfo.Platform
+	// ObjectStore provides the manifests that the build pipeline configuration is checked
+	// against. When nil, that check is skipped.
+	ObjectStore *manifests.ObjectStore
+	// ConversionWebhook accepts the versions that convert to the hub version, such as v1beta1.
+	// The generated CRDs do not serve them, but installs with the operator's webhooks serve
+	// them through the conversion webhook (see
+	// config/default/manager-webhook/crd_conversion_patch.yaml).
+	ConversionWebhook bool
+}
+
+// Validator validates documents against the embedded CRDs and the admission webhooks.
+type Validator struct {
+	scheme      *runtime.Scheme
+	versions    map[schema.GroupVersionKind]*crdVersion
+	clusterInfo *clusterinfo.Info
+	objectStore *manifests.ObjectStore
+}
+
+// crdVersion holds the validators of one version of a CRD.
+type crdVersion struct {
+	served          bool
+	namespaced      bool
+	structural      *structuralschema.Structural
+	schemaValidator apiextensionsvalidation.SchemaValidator
+	// celValidator is nil when the version has no CEL rules.
+	celValidator *cel.Validator
+}
+
+// New returns a Validator for the CRDs embedded in the operator. The scheme must have the
+// Konflux types registered.
+func New(scheme *runtime.Scheme, opts Options) (*Validator, error) {
+	v := &Validator{
+		scheme:      scheme,
+		versions:    map[schema.GroupVersionKind]*crdVersion{},
+		clusterInfo: clusterinfo.NewSimulated(opts.Platform),
+		objectStore: opts.ObjectStore,
+	}
+	files, err := fs.Glob(crd.Bases, "bases/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		content, err := fs.ReadFile(crd.Bases, file)
+		if err != nil {
+			return nil, err
+		}
+		definition := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(content, definition); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", file, err)
+		}
+		for _, version := range definition.Spec.Versions {
+			parsed, err := newCRDVersion(definition, &version)
+			if err != nil {
+				return nil, fmt.Errorf("%s version %s: %w", definition.Name, version.Name, err)
+			}
+			gvk := schema.GroupVersionKind{
+				Group:   definition.Spec.Group,
+				Version: version.Name,
+				Kind:    definition.Spec.Names.Kind,
+			}
+			parsed.served = parsed.served || (opts.ConversionWebhook && v.convertible(gvk))
+			v.versions[gvk] = parsed
+		}
+	}
+	return v, nil
+}
+
+// convertible reports whether gvk converts to the hub version, and so is served by the
+// conversion webhook.
+func (v *Validator) convertible(gvk schema.GroupVersionKind) bool {
+	obj, err := v.scheme.New(gvk)
+	if err != nil {
+		return false
+	}
+	_, ok := obj.(conversion.Convertible)
+	return ok
+}
+
+func newCRDVersion(
+	definition *apiextensionsv1.CustomResourceDefinition, version *apiextensionsv1.CustomResourceDefinitionVersion,
+) (*crdVersion, error) {
+	if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+		return nil, errors.New("no schema")
+	}
+	props := &apiextensions.JSONSchemaProps{}
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(
+		version.Schema.OpenAPIV3Schema, props, nil); err != nil {
+		return nil, err
+	}
+	structural, err := structuralschema.NewStructural(props)
+	if err != nil {
+		return nil, err
+	}
+	schemaValidator, _, err := apiextensionsvalidation.NewSchemaValidator(props)
+	if err != nil {
+		return nil, err
+	}
+	return &crdVersion{
+		served:          version.Served,
+		namespaced:      definition.Spec.Scope == apiextensionsv1.NamespaceScoped,
+		structural:      structural,
+		schemaValidator: schemaValidator,
+		celValidator:    cel.NewValidator(structural, true, celconfig.PerCallLimit),
+	}, nil
+}
+
+// Validate validates every YAML document of the content of a file. Empty documents are
+// left out of the results.
+func (v *Validator) Validate(ctx context.Context, file string, content []byte) []Result {
+	var results []Result
+	for _, doc := range splitDocuments(content) {
+		if result, ok := v.validateDocument(ctx, file, doc); ok {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// document is a YAML document of a file and the line it starts at.
+type document struct {
+	line    int
+	content []byte
+}
+
+// splitDocuments splits content at the "---" separator lines.
+func splitDocuments(content []byte) []document {
+	var docs []document
+	current := document{line: 1}
+	for i, line := range bytes.SplitAfter(content, []byte("\n")) {
+		if bytes.HasPrefix(line, []byte("---")) && strings.TrimSpace(string(line[3:])) == "" {
+			docs = append(docs, current)
+			current = document{line: i + 2}
+			continue
+		}
+		current.content = append(current.content, line...)
+	}
+	return append(docs, current)
+}
+
+// validateDocument validates one document. It returns false for an empty document.
+func (v *Validator) validateDocument(ctx context.Context, file string, doc document) (Result, bool) {
+	result := Result{File: file, Line: doc.line}
+	content, err := yaml.YAMLToJSON(doc.content)
+	if err != nil {
+		result.Violations = []Violation{{Type: parseErrorType, Message: err.Error()}}
+		return result, true
+	}
+	if string(bytes.TrimSpace(content)) == "null" {
+		return result, false
+	}
+	var object map[string]any
+	if err := utiljson.Unmarshal(content, &object); err != nil {
+		result.Violations = []Violation{{Type: parseErrorType, Message: "not a Kubernetes object: " + err.Error()}}
+		return result, true
+	}
+	obj := &unstructured.Unstructured{Object: object}
+	result.APIVersion, result.Kind, result.Name = obj.GetAPIVersion(), obj.GetKind(), obj.GetName()
+	var errs field.ErrorList
+	if obj.GetAPIVersion() == "" {
+		errs = append(errs, field.Required(field.NewPath("apiVersion"), ""))
+	}
+	if obj.GetKind() == "" {
+		errs = append(errs, field.Required(field.NewPath("kind"), ""))
+	}
+	if len(errs) > 0 {
+		result.Violations = fieldViolations(errs)
+		return result, true
+	}
+
+	gvk := obj.GroupVersionKind()
+	if gvk.Group != konfluxv1alpha1.GroupVersion.Group {
+		result.Skipped = true
+		return result, true
+	}
+	version, errs := v.lookup(gvk)
+	if version != nil {
+		// The status of a created object is dropped by the API server, and the namespace of a
+		// cluster-scoped one by clients, so neither is validated.
+		delete(obj.Object, "status")
+		if !version.namespaced {
+			obj.SetNamespace("")
+		}
+		result.Violations = unknownFieldViolations(obj, version)
+		errs = v.validateSchema(ctx, obj, version)
+	}
+	result.Violations = append(result.Violations, fieldViolations(errs)...)
+	// Like the API server, only objects that match the schema are passed to the webhooks.
+	if len(result.Violations) == 0 {
+		result.Violations = v.validateAdmission(ctx, obj)
+	}
+	return result, true
+}
+
+// lookup returns the CRD version of gvk, or why the API server would not serve it.
+func (v *Validator) lookup(gvk schema.GroupVersionKind) (*crdVersion, field.ErrorList) {
+	var kinds, servedVersions []string
+	for known, version := range v.versions {
+		kinds = append(kinds, known.Kind)
+		if known.Kind == gvk.Kind && version.served {
+			servedVersions = append(servedVersions, known.GroupVersion().String())
+		}
+	}
+	if !slices.Contains(kinds, gvk.Kind) {
+		slices.Sort(kinds)
+		return nil, field.ErrorList{field.NotSupported(field.NewPath("kind"), gvk.Kind, slices.Compact(kinds))}
+	}
+	version, ok := v.versions[gvk]
+	if !ok || !version.served {
+		slices.Sort(servedVersions)
+		return nil, field.ErrorList{field.NotSupported(field.NewPath("apiVersion"), gvk.GroupVersion().String(), servedVersions)}
+	}
+	return version, nil
+}
+
+// validateSchema applies the schema defaults of its CRD version to obj, and validates it
+// against the schema and CEL rules.
+func (v *Validator) validateSchema(ctx context.Context, obj *unstructured.Unstructured, version *crdVersion) field.ErrorList {
+	defaulting.Default(obj.Object, version.structural)
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(
+		obj, version.namespaced, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apiextensionsvalidation.ValidateCustomResource(nil, obj.Object, version.schemaValidator)...)
+	allErrs = append(allErrs, listtype.ValidateListSetsAndMaps(nil, version.structural, obj.Object)...)
+	if version.celValidator != nil {
+		celErrs, _ := version.celValidator.Validate(ctx, nil, version.structural, obj.Object, nil, celconfig.RuntimeCELCostBudget)
+		allErrs = append(allErrs, celErrs...)
+	}
+	return allErrs
+}
+
+// unknownFieldViolations prunes the fields of obj that its CRD version does not define, and
+// reports them.
+func unknownFieldViolations(obj *unstructured.Unstructured, version *crdVersion) []Violation {
+	var violations []Violation
+	paths := pruning.PruneWithOptions(obj.Object, version.structural, true,
+		structuralschema.UnknownFieldPathOptions{TrackUnknownFieldPaths: true})
+	for _, path := range paths {
+		violations = append(violations, Violation{
+			Field:   path,
+			Type:    unknownFieldType,
+			Message: fmt.Sprintf("unknown field %q", path),
+		})
+	}
+	return violations
+}
+
+// validateAdmission runs the operator's admission webhooks on obj, converted to the version
+// they are registered for.
+func (v *Validator) validateAdmission(ctx context.Context, obj *unstructured.Unstructured) []Violation {
+	typed, err := v.scheme.New(obj.GroupVersionKind())
+	if err == nil {
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typed)
+	}
+	if err != nil {
+		return []Violation{{Type: string(field.ErrorTypeInternal), Message: err.Error()}}
+	}
+	if convertible, ok := typed.(conversion.Convertible); ok {
+		hub, err := v.scheme.New(konfluxv1alpha1.GroupVersion.WithKind(obj.GetKind()))
+		if err == nil {
+			err = convertible.ConvertTo(hub.(conversion.Hub))
+		}
+		if err != nil {
+			return []Violation{{Type: string(field.ErrorTypeInternal), Message: err.Error()}}
+		}
+		typed = hub
+	}
+
+	err = webhookv1alpha1.ValidateObject(ctx, typed, v.clusterInfo, v.objectStore)
+	if err == nil {
+		return nil
+	}
+	var status apierrors.APIStatus
+	if errors.As(err, &status) && status.Status().Details != nil && len(status.Status().Details.Causes) > 0 {
+		var violations []Violation
+		for _, cause := range status.Status().Details.Causes {
+			violations = append(violations, Violation{Field: cause.Field, Type: string(cause.Type), Message: cause.Message})
+		}
+		return violations
+	}
+	return []Violation{{Type: string(field.ErrorTypeInternal), Message: err.Error()}}
+}
+
+func fieldViolations(errs field.ErrorList) []Violation {
+	var violations []Violation
+	for _, err := range errs {
+		violation := Violation{Field: err.Field, Type: string(err.Type), Message: err.ErrorBody()}
+		// CEL rules on the object itself have no path.
+		if violation.Field == "<nil>" {
+			violation.Field = ""
+		}
+		violations = append(violations, violation)
+	}
+	return violations
+}
+
+// WriteText writes a line per violation, prefixed with the file, line and object it belongs to.
+func WriteText(w io.Writer, results []Result) error {
+	for _, result := range results {
+		object := result.Kind
+		if result.Name != "" {
+			object += "/" + result.Name
+		}
+		for _, violation := range result.Violations {
+			var parts []string
+			for _, part := range []string{fmt.Sprintf("%s:%d", result.File, result.Line), object, violation.Field, violation.Message} {
+				if part != "" {
+					parts = append(parts, part)
+				}
+			}
+			if _, err := fmt.Fprintln(w, strings.Join(parts, ": ")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the results as a JSON array, including the valid and skipped documents.
+func WriteJSON(w io.Writer, results []Result) error {
+	if results == nil {
+		results = []Result{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	konfluxv1beta1 "github.com/konflux-ci/konflux-ci/operator/api/v1beta1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+func newValidator(t *testing.T, platform clusterinfo.Platform) *Validator {
+	t.Helper()
+	return newValidatorWithOptions(t, Options{Platform: platform})
+}
+
+// newValidatorWithOptions is newValidator with the given options and the embedded manifests.
+func newValidatorWithOptions(t *testing.T, opts Options) *Validator {
+	t.Helper()
+	g := gomega.NewWithT(t)
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(konfluxv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(konfluxv1beta1.AddToScheme(scheme))
+	store, err := manifests.NewObjectStore(scheme)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	opts.ObjectStore = store
+	v, err := New(scheme, opts)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	return v
+}
+
+// violations returns the violations of the single document in content.
+func violations(t *testing.T, v *Validator, content string) []Violation {
+	t.Helper()
+	results := v.Validate(context.Background(), "konflux.yaml", []byte(content))
+	gomega.NewWithT(t).Expect(results).To(gomega.HaveLen(1))
+	return results[0].Violations
+}
+
+func TestValidate_Samples(t *testing.T) {
+	g := gomega.NewWithT(t)
+	v := newValidator(t, clusterinfo.Default)
+
+	files, err := filepath.Glob("../../config/samples/konflux*.yaml")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(files).NotTo(gomega.BeEmpty())
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		for _, result := range v.Validate(context.Background(), file, content) {
+			g.Expect(result.Violations).To(gomega.BeEmpty(), "%s:%d", file, result.Line)
+		}
+	}
+}
+
+func TestValidate_Schema(t *testing.T) {
+	v := newValidator(t, clusterinfo.Default)
+
+	t.Run("singleton name CEL rule", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(violations(t, v, `apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: KonfluxUI
+metadata:
+  name: ui
+`)).To(gomega.ConsistOf(gomega.And(
+			gomega.HaveField("Field", ""),
+			gomega.HaveField("Message", gomega.ContainSubstring("KonfluxUI CR must be named 'konflux-ui'")),
+		)))
+	})
+
+	t.Run("FQDN pattern", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(violations(t, v, `apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: Konflux
+metadata:
+  name: konflux
+spec:
+  ui:
+    spec:
+      ingress:
+        fqdn: https://konflux.example.com
+`)).To(gomega.ConsistOf(gomega.And(
+			gomega.HaveField("Field", "spec.ui.spec.ingress.fqdn"),
+			gomega.HaveField("Type", "FieldValueInvalid"),
+		)))
+	})
+
+	t.Run("pipeline config CEL rule", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(violations(t, v, `apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: Konflux
+metadata:
+  name: konflux
+spec:
+  buildService:
+    spec:
+      pipelineConfig:
+        pipelines:
+        - name: docker-build
+          removed: true
+          bundle: quay.io/example/docker-build:latest
+`)).To(gomega.ConsistOf(gomega.And(
+			gomega.HaveField("Field", "spec.buildService.spec.pipelineConfig.pipelines[0]"),
+			gomega.HaveField("Message", gomega.ContainSubstring("bundle must not be set when removed is true")),
+		)))
+	})
+
+	t.Run("snapshot counts are bounded to int32", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(violations(t, v, `apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: KonfluxIntegrationService
+metadata:
+  name: konflux-integration-service
+spec:
+  prSnapshotsToKeep: "2147483647"
+  nonPRSnapshotsToKeep: "4294967296"
+`)).To(gomega.ConsistOf(gomega.And(
+			gomega.HaveField("Field", "spec.nonPRSnapshotsToKeep"),
+			gomega.HaveField("Message", gomega.ContainSubstring("must be at most 2147483647")),
+		)))
+	})
+
+	t.Run("unknown fields are rejected and status is ignored", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(violations(t, v, `apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: Konflux
+metadata:
+  name: konflux
+spec:
+  buildServce: {}
+status:
+  conditions: []
+`)).To(gomega.ConsistOf(Violation{
+			Field:   "spec.buildServce",
+			Type:    unknownFieldType,
+			Message: `unknown field "spec.buildServce"`,
+		}))
+	})
+
+	t.Run("unknown kinds are rejected", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(violations(t, v, `apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: KonfluxUi
+metadata:
+  name: konflux-ui
+`)).To(gomega.ConsistOf(gomega.HaveField("Field", "kind")))
+		g.Expect(violations(t, v, `apiVersion: konflux.konflux-ci.dev/v2
+kind: Konflux
+metadata:
+  name: konflux
+`)).To(gomega.ConsistOf(gomega.And(
+			gomega.HaveField("Field", "apiVersion"),
+			gomega.HaveField("Message", gomega.ContainSubstring(`"konflux.konflux-ci.dev/v1alpha1"`)),
+		)))
+	})
+
+	t.Run("versions served through the conversion webhook are opt-in", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		const v1beta1CR = `apiVersion: konflux.konflux-ci.dev/v1beta1
+kind: Konflux
+metadata:
+  name: konflux
+`
+		g.Expect(violations(t, v, v1beta1CR)).To(gomega.ConsistOf(gomega.HaveField("Field", "apiVersion")))
+
+		withWebhooks := newValidatorWithOptions(t, Options{Platform: clusterinfo.Default, ConversionWebhook: true})
+		g.Expect(violations(t, withWebhooks, v1beta1CR)).To(gomega.BeEmpty())
+		g.Expect(violations(t, withWebhooks, v1beta1CR+`spec:
+  buildServce: {}
+`)).To(gomega.ConsistOf(gomega.HaveField("Field", "spec.buildServce")))
+	})
+}
+
+func TestValidate_Admission(t *testing.T) {
+	const konfluxCR = `apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: Konflux
+metadata:
+  name: konflux
+spec:
+  ui:
+    spec:
+      ingress:
+        fqdn: konflux.example.com:8443
+  integrationService:
+    spec:
+      pipelineTimeout: 0h
+`
+
+	t.Run("webhook checks", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(violations(t, newValidator(t, clusterinfo.Default), konfluxCR)).To(gomega.ConsistOf(
+			Violation{
+				Field:   "spec.integrationService.spec.pipelineTimeout",
+				Type:    "FieldValueInvalid",
+				Message: `Invalid value: "0h": must be greater than zero`,
+			},
+		))
+	})
+
+	t.Run("platform-dependent checks", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		// The ingress is enabled by default on OpenShift, where the FQDN must not have a port.
+		g.Expect(violations(t, newValidator(t, clusterinfo.OpenShift), konfluxCR)).To(gomega.ContainElement(
+			gomega.HaveField("Field", "spec.ui.spec.ingress.fqdn"),
+		))
+	})
+}
+
+func TestValidate_Documents(t *testing.T) {
+	g := gomega.NewWithT(t)
+	v := newValidator(t, clusterinfo.Default)
+
+	results := v.Validate(context.Background(), "all.yaml", []byte(`# Konflux
+---
+apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: Konflux
+metadata:
+  name: konflux
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+---
+resources:
+- konflux.yaml
+---
+apiVersion: konflux.konflux-ci.dev/v1alpha1
+kind: Konflux
+metadata: [
+`))
+	g.Expect(results).To(gomega.HaveLen(4))
+	g.Expect(results[0]).To(gomega.Equal(Result{
+		File: "all.yaml", Line: 3, APIVersion: "konflux.konflux-ci.dev/v1alpha1", Kind: "Konflux", Name: "konflux",
+	}))
+	g.Expect(results[1].Line).To(gomega.Equal(8))
+	g.Expect(results[1].Skipped).To(gomega.BeTrue())
+	g.Expect(results[1].Violations).To(gomega.BeEmpty())
+	g.Expect(results[2].Violations).To(gomega.ConsistOf(
+		gomega.HaveField("Field", "apiVersion"),
+		gomega.HaveField("Field", "kind"),
+	))
+	g.Expect(results[3].Line).To(gomega.Equal(16))
+	g.Expect(results[3].Violations).To(gomega.ConsistOf(gomega.HaveField("Type", parseErrorType)))
+
+	var text bytes.Buffer
+	g.Expect(WriteText(&text, results[2:3])).To(gomega.Succeed())
+	g.Expect(text.String()).To(gomega.Equal(
+		"all.yaml:13: apiVersion: Required value\nall.yaml:13: kind: Required value\n"))
+
+	var output bytes.Buffer
+	g.Expect(WriteJSON(&output, results)).To(gomega.Succeed())
+	var decoded []Result
+	g.Expect(json.Unmarshal(output.Bytes(), &decoded)).To(gomega.Succeed())
+	g.Expect(decoded).To(gomega.Equal(results))
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+// SetupKonfluxWebhookWithManager registers the validating and defaulting webhooks for Konflux.
+func SetupKonfluxWebhookWithManager(mgr ctrl.Manager, clusterInfo *clusterinfo.Info, store *manifests.ObjectStore) error {
+	return ctrl.NewWebhookManagedBy(mgr, &konfluxv1alpha1.Konflux{}).
+		WithValidator(&KonfluxValidator{ClusterInfo: clusterInfo, ObjectStore: store}).
+		WithDefaulter(&KonfluxDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-konflux-konflux-ci-dev-v1alpha1-konflux,mutating=true,failurePolicy=fail,sideEffects=None,groups=konflux.konflux-ci.dev,resources=konfluxes,verbs=create;update,versions=v1alpha1,name=mkonflux-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// KonfluxDefaulter fills the documented defaults of the Konflux spec so that the stored
+// object reflects the effective configuration.
+type KonfluxDefaulter struct{}
+
+var _ admission.Defaulter[*konfluxv1alpha1.Konflux] = &KonfluxDefaulter{}
+
+// Default implements admission.Defaulter.
+// Only defaults that are fixed regardless of the platform are filled: ui.spec.ingress.enabled
+// depends on the cluster and telemetry.enabled will follow the OpenShift console setting once
+// that detection lands, so both are left unset.
+func (d *KonfluxDefaulter) Default(_ context.Context, konflux *konfluxv1alpha1.Konflux) error {
+	spec := &konflux.Spec
+
+	if spec.ImageController == nil {
+		spec.ImageController = &konfluxv1alpha1.ImageControllerConfig{}
+	}
+	if spec.ImageController.Enabled == nil {
+		spec.ImageController.Enabled = ptr.To(false)
+	}
+
+	if spec.InternalRegistry == nil {
+		spec.InternalRegistry = &konfluxv1alpha1.InternalRegistryConfig{}
+	}
+	if spec.InternalRegistry.Enabled == nil {
+		spec.InternalRegistry.Enabled = ptr.To(false)
+	}
+
+	if spec.DefaultTenant == nil {
+		spec.DefaultTenant = &konfluxv1alpha1.DefaultTenantConfig{}
+	}
+	if spec.DefaultTenant.Enabled == nil {
+		spec.DefaultTenant.Enabled = ptr.To(true)
+	}
+
+	if spec.CertManager == nil {
+		spec.CertManager = &konfluxv1alpha1.CertManagerConfig{}
+	}
+	if spec.CertManager.CreateClusterIssuer == nil {
+		spec.CertManager.CreateClusterIssuer = ptr.To(true)
+	}
+
+	if spec.ComponentMetrics == nil {
+		spec.ComponentMetrics = &konfluxv1alpha1.ComponentMetricsConfig{}
+	}
+	if spec.ComponentMetrics.Enabled == nil {
+		spec.ComponentMetrics.Enabled = ptr.To(true)
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-konflux-konflux-ci-dev-v1alpha1-konflux,mutating=false,failurePolicy=fail,sideEffects=None,groups=konflux.konflux-ci.dev,resources=konfluxes,verbs=create;update,versions=v1alpha1,name=vkonflux-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// KonfluxValidator validates the Konflux spec, including the nested component specs that
+// the Konflux reconciler copies into the sub-CRs.
+type KonfluxValidator struct {
+	// ClusterInfo is used for platform-dependent checks. When nil, those checks are skipped.
+	ClusterInfo *clusterinfo.Info
+	// ObjectStore provides the embedded manifests used to validate merges against defaults.
+	// When nil, those checks are skipped.
+	ObjectStore *manifests.ObjectStore
+}
+
+var _ admission.Validator[*konfluxv1alpha1.Konflux] = &KonfluxValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *KonfluxValidator) ValidateCreate(_ context.Context, konflux *konfluxv1alpha1.Konflux) (admission.Warnings, error) {
+	return nil, v.validate(nil, konflux)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *KonfluxValidator) ValidateUpdate(
+	_ context.Context, oldKonflux, newKonflux *konfluxv1alpha1.Konflux,
+) (admission.Warnings, error) {
+	return nil, v.validate(oldKonflux, newKonflux)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *KonfluxValidator) ValidateDelete(_ context.Context, _ *konfluxv1alpha1.Konflux) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate runs all Konflux spec checks. oldKonflux is nil on create.
+func (v *KonfluxValidator) validate(oldKonflux, konflux *konfluxv1alpha1.Konflux) error {
+	specPath := field.NewPath("spec")
+	spec := &konflux.Spec
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateObjectPatches(specPath.Child("patches"), spec.Patches)...)
+	if spec.KonfluxUI != nil {
+		allErrs = append(allErrs, validateUIConfig(specPath.Child("ui", "spec"), spec.KonfluxUI.Spec, isOnOpenShift(v.ClusterInfo))...)
+	}
+	if spec.KonfluxBuildService != nil {
+		allErrs = append(allErrs, validateBuildServiceConfig(specPath.Child("buildService", "spec"), spec.KonfluxBuildService.Spec, v.ObjectStore)...)
+	}
+	if spec.KonfluxIntegrationService != nil {
+		allErrs = append(allErrs, validateIntegrationServiceConfig(specPath.Child("integrationService", "spec"), spec.KonfluxIntegrationService.Spec)...)
+	}
+	if spec.NamespaceLister != nil {
+		allErrs = append(allErrs, validateNamespaceListerConfig(specPath.Child("namespaceLister", "spec"), spec.NamespaceLister.Spec)...)
+	}
+
+	// Only check trust-manager when the registry is being turned on, so that an unrelated
+	// update is not blocked if trust-manager is removed from a running installation.
+	if spec.IsInternalRegistryEnabled() && (oldKonflux == nil || !oldKonflux.Spec.IsInternalRegistryEnabled()) {
+		allErrs = append(allErrs, validateTrustManagerInstalled(specPath.Child("internalRegistry", "enabled"), v.ClusterInfo)...)
+	}
+
+	return toInvalidError("Konflux", konflux.Name, allErrs)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func TestKonfluxDefaulter(t *testing.T) {
+	t.Run("fills documented defaults on an empty spec", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{ObjectMeta: metav1.ObjectMeta{Name: "konflux"}}
+
+		g.Expect((&KonfluxDefaulter{}).Default(context.Background(), konflux)).To(gomega.Succeed())
+
+		g.Expect(konflux.Spec.ImageController.Enabled).To(gomega.HaveValue(gomega.BeFalse()))
+		g.Expect(konflux.Spec.InternalRegistry.Enabled).To(gomega.HaveValue(gomega.BeFalse()))
+		g.Expect(konflux.Spec.DefaultTenant.Enabled).To(gomega.HaveValue(gomega.BeTrue()))
+		g.Expect(konflux.Spec.CertManager.CreateClusterIssuer).To(gomega.HaveValue(gomega.BeTrue()))
+		g.Expect(konflux.Spec.ComponentMetrics.Enabled).To(gomega.HaveValue(gomega.BeTrue()))
+		g.Expect(konflux.Spec.Telemetry).To(gomega.BeNil())
+		g.Expect(konflux.Spec.KonfluxUI).To(gomega.BeNil())
+	})
+
+	t.Run("preserves explicit values", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{
+			Spec: konfluxv1alpha1.KonfluxSpec{
+				ImageController: &konfluxv1alpha1.ImageControllerConfig{Enabled: ptr.To(true)},
+				DefaultTenant:   &konfluxv1alpha1.DefaultTenantConfig{Enabled: ptr.To(false)},
+			},
+		}
+
+		g.Expect((&KonfluxDefaulter{}).Default(context.Background(), konflux)).To(gomega.Succeed())
+
+		g.Expect(konflux.Spec.ImageController.Enabled).To(gomega.HaveValue(gomega.BeTrue()))
+		g.Expect(konflux.Spec.DefaultTenant.Enabled).To(gomega.HaveValue(gomega.BeFalse()))
+	})
+
+	t.Run("defaulted spec keeps the same effective configuration", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{}
+		before := konflux.Spec.DeepCopy()
+
+		g.Expect((&KonfluxDefaulter{}).Default(context.Background(), konflux)).To(gomega.Succeed())
+
+		g.Expect(konflux.Spec.IsImageControllerEnabled()).To(gomega.Equal(before.IsImageControllerEnabled()))
+		g.Expect(konflux.Spec.IsInternalRegistryEnabled()).To(gomega.Equal(before.IsInternalRegistryEnabled()))
+		g.Expect(konflux.Spec.IsDefaultTenantEnabled()).To(gomega.Equal(before.IsDefaultTenantEnabled()))
+		g.Expect(konflux.Spec.IsComponentMetricsEnabled()).To(gomega.Equal(before.IsComponentMetricsEnabled()))
+	})
+}
+
+func TestKonfluxValidator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("aggregates errors from nested component specs", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		v := &KonfluxValidator{ClusterInfo: newClusterInfo(t, false, true), ObjectStore: newObjectStore(t)}
+		konflux := &konfluxv1alpha1.Konflux{
+			ObjectMeta: metav1.ObjectMeta{Name: "konflux"},
+			Spec: konfluxv1alpha1.KonfluxSpec{
+				KonfluxUI: &konfluxv1alpha1.KonfluxUIConfig{Spec: &konfluxv1alpha1.KonfluxUIConfigSpec{
+					Ingress: &konfluxv1alpha1.IngressSpec{Enabled: ptr.To(true), FQDN: "konflux.example.com:8443"},
+				}},
+				KonfluxIntegrationService: &konfluxv1alpha1.IntegrationServiceConfig{
+					Spec: &konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec{PipelineTimeout: "0m"},
+				},
+			},
+		}
+
+		_, err := v.ValidateCreate(ctx, konflux)
+		g.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		g.Expect(err.Error()).To(gomega.ContainSubstring("spec.ui.spec.ingress.fqdn"))
+		g.Expect(err.Error()).To(gomega.ContainSubstring("spec.integrationService.spec.pipelineTimeout"))
+	})
+
+	t.Run("rejects enabling the internal registry without trust-manager", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		v := &KonfluxValidator{ClusterInfo: newClusterInfo(t, false, false)}
+		konflux := &konfluxv1alpha1.Konflux{
+			ObjectMeta: metav1.ObjectMeta{Name: "konflux"},
+			Spec: konfluxv1alpha1.KonfluxSpec{
+				InternalRegistry: &konfluxv1alpha1.InternalRegistryConfig{Enabled: ptr.To(true)},
+			},
+		}
+
+		_, err := v.ValidateCreate(ctx, konflux)
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(err.Error()).To(gomega.ContainSubstring("spec.internalRegistry.enabled"))
+
+		// An update that leaves an already-enabled registry alone is not blocked.
+		_, err = v.ValidateUpdate(ctx, konflux.DeepCopy(), konflux)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	t.Run("accepts a valid spec", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		v := &KonfluxValidator{ClusterInfo: newClusterInfo(t, true, true), ObjectStore: newObjectStore(t)}
+		konflux := &konfluxv1alpha1.Konflux{
+			ObjectMeta: metav1.ObjectMeta{Name: "konflux"},
+			Spec: konfluxv1alpha1.KonfluxSpec{
+				InternalRegistry: &konfluxv1alpha1.InternalRegistryConfig{Enabled: ptr.To(true)},
+				KonfluxUI: &konfluxv1alpha1.KonfluxUIConfig{Spec: &konfluxv1alpha1.KonfluxUIConfigSpec{
+					Ingress: &konfluxv1alpha1.IngressSpec{FQDN: "konflux.example.com"},
+				}},
+			},
+		}
+
+		warnings, err := v.ValidateCreate(ctx, konflux)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(warnings).To(gomega.BeEmpty())
+	})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+// SetupKonfluxBuildServiceWebhookWithManager registers the validating webhook for KonfluxBuildService.
+func SetupKonfluxBuildServiceWebhookWithManager(mgr ctrl.Manager, store *manifests.ObjectStore) error {
+	return ctrl.NewWebhookManagedBy(mgr, &konfluxv1alpha1.KonfluxBuildService{}).
+		WithValidator(&KonfluxBuildServiceValidator{ObjectStore: store}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-konflux-konflux-ci-dev-v1alpha1-konfluxbuildservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=konflux.konflux-ci.dev,resources=konfluxbuildservices,verbs=create;update,versions=v1alpha1,name=vkonfluxbuildservice-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// KonfluxBuildServiceValidator validates KonfluxBuildService specs.
+type KonfluxBuildServiceValidator struct {
+	// ObjectStore provides the embedded build-pipeline-config defaults. When nil, the
+	// pipeline config check is skipped.
+	ObjectStore *manifests.ObjectStore
+}
+
+var _ admission.Validator[*konfluxv1alpha1.KonfluxBuildService] = &KonfluxBuildServiceValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *KonfluxBuildServiceValidator) ValidateCreate(_ context.Context, buildService *konfluxv1alpha1.KonfluxBuildService) (admission.Warnings, error) {
+	return nil, v.validate(buildService)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *KonfluxBuildServiceValidator) ValidateUpdate(_ context.Context, _, buildService *konfluxv1alpha1.KonfluxBuildService) (admission.Warnings, error) {
+	return nil, v.validate(buildService)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *KonfluxBuildServiceValidator) ValidateDelete(_ context.Context, _ *konfluxv1alpha1.KonfluxBuildService) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *KonfluxBuildServiceValidator) validate(buildService *konfluxv1alpha1.KonfluxBuildService) error {
+	allErrs := validateBuildServiceConfig(field.NewPath("spec"), &buildService.Spec.KonfluxBuildServiceConfigSpec, v.ObjectStore)
+	return toInvalidError("KonfluxBuildService", buildService.Name, allErrs)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// SetupKonfluxIntegrationServiceWebhookWithManager registers the validating webhook for KonfluxIntegrationService.
+func SetupKonfluxIntegrationServiceWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &konfluxv1alpha1.KonfluxIntegrationService{}).
+		WithValidator(&KonfluxIntegrationServiceValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-konflux-konflux-ci-dev-v1alpha1-konfluxintegrationservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=konflux.konflux-ci.dev,resources=konfluxintegrationservices,verbs=create;update,versions=v1alpha1,name=vkonfluxintegrationservice-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// KonfluxIntegrationServiceValidator validates KonfluxIntegrationService specs.
+type KonfluxIntegrationServiceValidator struct{}
+
+var _ admission.Validator[*konfluxv1alpha1.KonfluxIntegrationService] = &KonfluxIntegrationServiceValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *KonfluxIntegrationServiceValidator) ValidateCreate(_ context.Context, integrationService *konfluxv1alpha1.KonfluxIntegrationService) (admission.Warnings, error) {
+	return nil, v.validate(integrationService)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *KonfluxIntegrationServiceValidator) ValidateUpdate(_ context.Context, _, integrationService *konfluxv1alpha1.KonfluxIntegrationService) (admission.Warnings, error) {
+	return nil, v.validate(integrationService)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *KonfluxIntegrationServiceValidator) ValidateDelete(_ context.Context, _ *konfluxv1alpha1.KonfluxIntegrationService) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *KonfluxIntegrationServiceValidator) validate(integrationService *konfluxv1alpha1.KonfluxIntegrationService) error {
+	allErrs := validateIntegrationServiceConfig(field.NewPath("spec"), &integrationService.Spec.KonfluxIntegrationServiceConfigSpec)
+	return toInvalidError("KonfluxIntegrationService", integrationService.Name, allErrs)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+)
+
+// SetupKonfluxInternalRegistryWebhookWithManager registers the validating webhook for KonfluxInternalRegistry.
+func SetupKonfluxInternalRegistryWebhookWithManager(mgr ctrl.Manager, clusterInfo *clusterinfo.Info) error {
+	return ctrl.NewWebhookManagedBy(mgr, &konfluxv1alpha1.KonfluxInternalRegistry{}).
+		WithValidator(&KonfluxInternalRegistryValidator{ClusterInfo: clusterInfo}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-konflux-konflux-ci-dev-v1alpha1-konfluxinternalregistry,mutating=false,failurePolicy=fail,sideEffects=None,groups=konflux.konflux-ci.dev,resources=konfluxinternalregistries,verbs=create,versions=v1alpha1,name=vkonfluxinternalregistry-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// KonfluxInternalRegistryValidator rejects creating a KonfluxInternalRegistry on clusters
+// without trust-manager. The existence of the CR is what enables the registry, so only
+// creation is checked.
+type KonfluxInternalRegistryValidator struct {
+	// ClusterInfo is used to detect trust-manager. When nil, the check is skipped.
+	ClusterInfo *clusterinfo.Info
+}
+
+var _ admission.Validator[*konfluxv1alpha1.KonfluxInternalRegistry] = &KonfluxInternalRegistryValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *KonfluxInternalRegistryValidator) ValidateCreate(
+	_ context.Context, registry *konfluxv1alpha1.KonfluxInternalRegistry,
+) (admission.Warnings, error) {
+	allErrs := validateTrustManagerInstalled(field.NewPath("spec"), v.ClusterInfo)
+	return nil, toInvalidError("KonfluxInternalRegistry", registry.Name, allErrs)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *KonfluxInternalRegistryValidator) ValidateUpdate(
+	_ context.Context, _, _ *konfluxv1alpha1.KonfluxInternalRegistry,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *KonfluxInternalRegistryValidator) ValidateDelete(
+	_ context.Context, _ *konfluxv1alpha1.KonfluxInternalRegistry,
+) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// SetupKonfluxNamespaceListerWebhookWithManager registers the validating webhook for KonfluxNamespaceLister.
+func SetupKonfluxNamespaceListerWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &konfluxv1alpha1.KonfluxNamespaceLister{}).
+		WithValidator(&KonfluxNamespaceListerValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-konflux-konflux-ci-dev-v1alpha1-konfluxnamespacelister,mutating=false,failurePolicy=fail,sideEffects=None,groups=konflux.konflux-ci.dev,resources=konfluxnamespacelisters,verbs=create;update,versions=v1alpha1,name=vkonfluxnamespacelister-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// KonfluxNamespaceListerValidator validates KonfluxNamespaceLister specs.
+type KonfluxNamespaceListerValidator struct{}
+
+var _ admission.Validator[*konfluxv1alpha1.KonfluxNamespaceLister] = &KonfluxNamespaceListerValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *KonfluxNamespaceListerValidator) ValidateCreate(_ context.Context, namespaceLister *konfluxv1alpha1.KonfluxNamespaceLister) (admission.Warnings, error) {
+	return nil, v.validate(namespaceLister)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *KonfluxNamespaceListerValidator) ValidateUpdate(_ context.Context, _, namespaceLister *konfluxv1alpha1.KonfluxNamespaceLister) (admission.Warnings, error) {
+	return nil, v.validate(namespaceLister)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *KonfluxNamespaceListerValidator) ValidateDelete(_ context.Context, _ *konfluxv1alpha1.KonfluxNamespaceLister) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *KonfluxNamespaceListerValidator) validate(namespaceLister *konfluxv1alpha1.KonfluxNamespaceLister) error {
+	allErrs := validateNamespaceListerConfig(field.NewPath("spec"), &namespaceLister.Spec)
+	return toInvalidError("KonfluxNamespaceLister", namespaceLister.Name, allErrs)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+)
+
+// SetupKonfluxUIWebhookWithManager registers the validating webhook for KonfluxUI.
+func SetupKonfluxUIWebhookWithManager(mgr ctrl.Manager, clusterInfo *clusterinfo.Info) error {
+	return ctrl.NewWebhookManagedBy(mgr, &konfluxv1alpha1.KonfluxUI{}).
+		WithValidator(&KonfluxUIValidator{ClusterInfo: clusterInfo}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-konflux-konflux-ci-dev-v1alpha1-konfluxui,mutating=false,failurePolicy=fail,sideEffects=None,groups=konflux.konflux-ci.dev,resources=konfluxuis,verbs=create;update,versions=v1alpha1,name=vkonfluxui-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// KonfluxUIValidator validates KonfluxUI specs.
+type KonfluxUIValidator struct {
+	// ClusterInfo is used to resolve whether ingress is enabled by default. When nil, the
+	// cluster is treated as non-OpenShift.
+	ClusterInfo *clusterinfo.Info
+}
+
+var _ admission.Validator[*konfluxv1alpha1.KonfluxUI] = &KonfluxUIValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *KonfluxUIValidator) ValidateCreate(_ context.Context, ui *konfluxv1alpha1.KonfluxUI) (admission.Warnings, error) {
+	return nil, v.validate(ui)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *KonfluxUIValidator) ValidateUpdate(_ context.Context, _, ui *konfluxv1alpha1.KonfluxUI) (admission.Warnings, error) {
+	return nil, v.validate(ui)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *KonfluxUIValidator) ValidateDelete(_ context.Context, _ *konfluxv1alpha1.KonfluxUI) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *KonfluxUIValidator) validate(ui *konfluxv1alpha1.KonfluxUI) error {
+	allErrs := validateUIConfig(field.NewPath("spec"), &ui.Spec.KonfluxUIConfigSpec, isOnOpenShift(v.ClusterInfo))
+	return toInvalidError("KonfluxUI", ui.Name, allErrs)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the admission webhooks for the konflux.konflux-ci.dev/v1alpha1 API.
+// The validators reject cross-field mistakes that the CRD schema cannot express, reusing the
+// same checks the reconcilers run so that users see identical messages at apply time.
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/internal/controller/buildservice"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/customization"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/ingress"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+// errTrustManagerNotInstalled is reported when the internal registry is enabled on a cluster
+// without trust-manager; the registry CA Bundle cannot be distributed without it.
+const errTrustManagerNotInstalled = "internal registry requires trust-manager " +
+	"(trust.cert-manager.io/v1alpha1 Bundle) to be installed"
+
+// isOnOpenShift reports whether the cluster is OpenShift. A nil ClusterInfo is treated as
+// a non-OpenShift cluster, matching the reconcilers.
+func isOnOpenShift(clusterInfo *clusterinfo.Info) bool {
+	return clusterInfo != nil && clusterInfo.IsOpenShift()
+}
+
+// validateUIConfig validates the user-configurable KonfluxUI settings.
+func validateUIConfig(path *field.Path, spec *konfluxv1alpha1.KonfluxUIConfigSpec, onOpenShift bool) field.ErrorList {
+	if spec == nil || spec.Ingress == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if err := ingress.ValidateFQDN(*spec.Ingress, onOpenShift); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("ingress", "fqdn"), spec.Ingress.FQDN, err.Error()))
+	}
+	return allErrs
+}
+
+// validateBuildServiceConfig validates the user-configurable KonfluxBuildService settings.
+// The pipeline config check needs the embedded build-pipeline-config defaults and is
+// skipped when no ObjectStore is available.
+func validateBuildServiceConfig(
+	path *field.Path,
+	spec *konfluxv1alpha1.KonfluxBuildServiceConfigSpec,
+	store *manifests.ObjectStore,
+) field.ErrorList {
+	if spec == nil || spec.PipelineConfig == nil || store == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if err := buildservice.ValidatePipelineConfig(store, spec.PipelineConfig); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("pipelineConfig"), spec.PipelineConfig.DefaultPipelineName, err.Error()))
+	}
+	return allErrs
+}
+
+// validateIntegrationServiceConfig validates the user-configurable KonfluxIntegrationService settings.
+// The CRD pattern accepts strings such as "0h" that parse but would disable the timeout
+// entirely in integration-service, so timeouts must also be strictly positive.
+func validateIntegrationServiceConfig(
+	path *field.Path,
+	spec *konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec,
+) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validatePositiveDuration(path.Child("pipelineTimeout"), spec.PipelineTimeout)...)
+	allErrs = append(allErrs, validatePositiveDuration(path.Child("tasksTimeout"), spec.TasksTimeout)...)
+	allErrs = append(allErrs, validatePositiveDuration(path.Child("finallyTimeout"), spec.FinallyTimeout)...)
+	return allErrs
+}
+
+// validateNamespaceListerConfig validates the user-configurable KonfluxNamespaceLister settings.
+func validateNamespaceListerConfig(path *field.Path, spec *konfluxv1alpha1.KonfluxNamespaceListerSpec) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	return validatePositiveDuration(path.Child("cacheResyncPeriod"), spec.CacheResyncPeriod)
+}
+
+// validateTrustManagerInstalled rejects enabling the internal registry when trust-manager
+// is not installed. The check is skipped when ClusterInfo is nil; a discovery failure is
+// reported as an internal error so the request is retried rather than silently admitted.
+func validateTrustManagerInstalled(path *field.Path, clusterInfo *clusterinfo.Info) field.ErrorList {
+	if clusterInfo == nil {
+		return nil
+	}
+	hasTrustManager, err := clusterInfo.HasTrustManager()
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+	if !hasTrustManager {
+		return field.ErrorList{field.Forbidden(path, errTrustManagerNotInstalled)}
+	}
+	return nil
+}
+
+// validateObjectPatches rejects patches that cannot be parsed for their type. Whether a
+// patch applies cleanly to its target is only known when the manifests are rendered.
+func validateObjectPatches(path *field.Path, patches []konfluxv1alpha1.ObjectPatch) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, patch := range patches {
+		if _, err := customization.ParseObjectPatch(patch); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("patch"), patch.Patch, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// validatePositiveDuration validates that an optional duration string parses and is greater than zero.
+func validatePositiveDuration(path *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, value, fmt.Sprintf("must be a valid duration: %v", err))}
+	}
+	if d <= 0 {
+		return field.ErrorList{field.Invalid(path, value, "must be greater than zero")}
+	}
+	return nil
+}
+
+// toInvalidError converts a field.ErrorList into the API error returned by the webhook,
+// or nil when the list is empty.
+func toInvalidError(kind, name string, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: konfluxv1alpha1.GroupVersion.Group, Kind: kind},
+		name,
+		allErrs,
+	)
+}
+
+// ValidateObject runs the webhooks registered for the kind of obj as for a create request,
+// defaulting obj in place first, so that CRs can be checked without a cluster. Kinds without
+// a validating webhook are accepted.
+func ValidateObject(
+	ctx context.Context, obj runtime.Object, clusterInfo *clusterinfo.Info, store *manifests.ObjectStore,
+) error {
+	var err error
+	switch obj := obj.(type) {
+	case *konfluxv1alpha1.Konflux:
+		if err := (&KonfluxDefaulter{}).Default(ctx, obj); err != nil {
+			return err
+		}
+		_, err = (&KonfluxValidator{ClusterInfo: clusterInfo, ObjectStore: store}).ValidateCreate(ctx, obj)
+	case *konfluxv1alpha1.KonfluxUI:
+		_, err = (&KonfluxUIValidator{ClusterInfo: clusterInfo}).ValidateCreate(ctx, obj)
+	case *konfluxv1alpha1.KonfluxBuildService:
+		_, err = (&KonfluxBuildServiceValidator{ObjectStore: store}).ValidateCreate(ctx, obj)
+	case *konfluxv1alpha1.KonfluxIntegrationService:
+		_, err = (&KonfluxIntegrationServiceValidator{}).ValidateCreate(ctx, obj)
+	case *konfluxv1alpha1.KonfluxNamespaceLister:
+		_, err = (&KonfluxNamespaceListerValidator{}).ValidateCreate(ctx, obj)
+	case *konfluxv1alpha1.KonfluxInternalRegistry:
+		_, err = (&KonfluxInternalRegistryValidator{ClusterInfo: clusterInfo}).ValidateCreate(ctx, obj)
+	}
+	return err
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/version"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/clusterinfo"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/ingress"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/manifests"
+)
+
+// fakeDiscovery serves a fixed set of API resources for clusterinfo detection.
+type fakeDiscovery struct {
+	resources map[string]*metav1.APIResourceList
+}
+
+func (f *fakeDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	if list, ok := f.resources[groupVersion]; ok {
+		return list, nil
+	}
+	return nil, apierrors.NewNotFound(metav1.SchemeGroupVersion.WithResource("").GroupResource(), groupVersion)
+}
+
+func (f *fakeDiscovery) ServerVersion() (*version.Info, error) {
+	return &version.Info{GitVersion: "v1.30.0"}, nil
+}
+
+func newClusterInfo(t *testing.T, openShift, trustManager bool) *clusterinfo.Info {
+	t.Helper()
+	resources := map[string]*metav1.APIResourceList{}
+	if openShift {
+		resources["config.openshift.io/v1"] = &metav1.APIResourceList{
+			APIResources: []metav1.APIResource{{Kind: "ClusterVersion"}},
+		}
+	}
+	if trustManager {
+		resources["trust.cert-manager.io/v1alpha1"] = &metav1.APIResourceList{
+			APIResources: []metav1.APIResource{{Kind: "Bundle"}},
+		}
+	}
+	info, err := clusterinfo.DetectWithClient(&fakeDiscovery{resources: resources})
+	gomega.NewWithT(t).Expect(err).NotTo(gomega.HaveOccurred())
+	return info
+}
+
+func newObjectStore(t *testing.T) *manifests.ObjectStore {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	store, err := manifests.NewObjectStore(scheme)
+	gomega.NewWithT(t).Expect(err).NotTo(gomega.HaveOccurred())
+	return store
+}
+
+func TestValidateUIConfig(t *testing.T) {
+	path := field.NewPath("spec")
+
+	t.Run("FQDN with port and ingress enabled is rejected with the reconciler message", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateUIConfig(path, &konfluxv1alpha1.KonfluxUIConfigSpec{
+			Ingress: &konfluxv1alpha1.IngressSpec{Enabled: ptr.To(true), FQDN: "konflux.example.com:8443"},
+		}, false)
+		g.Expect(errs).To(gomega.HaveLen(1))
+		g.Expect(errs[0].Field).To(gomega.Equal("spec.ingress.fqdn"))
+		g.Expect(errs[0].Detail).To(gomega.Equal(ingress.ErrFQDNPortWithManagedIngress.Error()))
+	})
+
+	t.Run("FQDN with port is rejected when ingress defaults to enabled on OpenShift", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateUIConfig(path, &konfluxv1alpha1.KonfluxUIConfigSpec{
+			Ingress: &konfluxv1alpha1.IngressSpec{FQDN: "konflux.example.com:8443"},
+		}, true)
+		g.Expect(errs).To(gomega.HaveLen(1))
+	})
+
+	t.Run("FQDN with port is allowed when ingress is disabled", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateUIConfig(path, &konfluxv1alpha1.KonfluxUIConfigSpec{
+			Ingress: &konfluxv1alpha1.IngressSpec{Enabled: ptr.To(false), FQDN: "localhost:9443"},
+		}, true)
+		g.Expect(errs).To(gomega.BeEmpty())
+	})
+
+	t.Run("nil spec is valid", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(validateUIConfig(path, nil, true)).To(gomega.BeEmpty())
+	})
+}
+
+func TestValidateBuildServiceConfig(t *testing.T) {
+	path := field.NewPath("spec")
+	store := newObjectStore(t)
+
+	t.Run("removing the default pipeline without a replacement is rejected", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateBuildServiceConfig(path, &konfluxv1alpha1.KonfluxBuildServiceConfigSpec{
+			PipelineConfig: &konfluxv1alpha1.PipelineConfigSpec{
+				Pipelines: []konfluxv1alpha1.PipelineSpec{{Name: "docker-build-oci-ta-min", Removed: true}},
+			},
+		}, store)
+		g.Expect(errs).To(gomega.HaveLen(1))
+		g.Expect(errs[0].Field).To(gomega.Equal("spec.pipelineConfig"))
+		g.Expect(errs[0].Detail).To(gomega.ContainSubstring("docker-build-oci-ta-min"))
+	})
+
+	t.Run("removing a non-default pipeline is allowed", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateBuildServiceConfig(path, &konfluxv1alpha1.KonfluxBuildServiceConfigSpec{
+			PipelineConfig: &konfluxv1alpha1.PipelineConfigSpec{
+				Pipelines: []konfluxv1alpha1.PipelineSpec{{Name: "fbc-builder", Removed: true}},
+			},
+		}, store)
+		g.Expect(errs).To(gomega.BeEmpty())
+	})
+
+	t.Run("check is skipped without an object store", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateBuildServiceConfig(path, &konfluxv1alpha1.KonfluxBuildServiceConfigSpec{
+			PipelineConfig: &konfluxv1alpha1.PipelineConfigSpec{
+				Pipelines: []konfluxv1alpha1.PipelineSpec{{Name: "docker-build-oci-ta-min", Removed: true}},
+			},
+		}, nil)
+		g.Expect(errs).To(gomega.BeEmpty())
+	})
+}
+
+func TestValidateIntegrationServiceConfig(t *testing.T) {
+	path := field.NewPath("spec")
+
+	t.Run("valid timeouts are accepted", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateIntegrationServiceConfig(path, &konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec{
+			PipelineTimeout: "6h",
+			TasksTimeout:    "1h30m",
+			FinallyTimeout:  "90m",
+		})
+		g.Expect(errs).To(gomega.BeEmpty())
+	})
+
+	t.Run("zero and unparsable timeouts are rejected", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateIntegrationServiceConfig(path, &konfluxv1alpha1.KonfluxIntegrationServiceConfigSpec{
+			PipelineTimeout: "0h",
+			TasksTimeout:    "h",
+		})
+		g.Expect(errs).To(gomega.HaveLen(2))
+		g.Expect(errs[0].Field).To(gomega.Equal("spec.pipelineTimeout"))
+		g.Expect(errs[0].Detail).To(gomega.Equal("must be greater than zero"))
+		g.Expect(errs[1].Field).To(gomega.Equal("spec.tasksTimeout"))
+		g.Expect(errs[1].Detail).To(gomega.ContainSubstring("must be a valid duration"))
+	})
+}
+
+func TestValidateNamespaceListerConfig(t *testing.T) {
+	g := gomega.NewWithT(t)
+	path := field.NewPath("spec")
+
+	g.Expect(validateNamespaceListerConfig(path, &konfluxv1alpha1.KonfluxNamespaceListerSpec{
+		CacheResyncPeriod: "10m",
+	})).To(gomega.BeEmpty())
+	g.Expect(validateNamespaceListerConfig(path, &konfluxv1alpha1.KonfluxNamespaceListerSpec{
+		CacheResyncPeriod: "0s",
+	})).To(gomega.HaveLen(1))
+}
+
+func TestValidateObjectPatches(t *testing.T) {
+	g := gomega.NewWithT(t)
+	path := field.NewPath("spec", "patches")
+
+	errs := validateObjectPatches(path, []konfluxv1alpha1.ObjectPatch{
+		{Patch: "metadata:\n  annotations:\n    a: b\n"},
+		{Type: konfluxv1alpha1.PatchTypeJSON6902, Patch: `[{"op": "remove", "path": "/spec/replicas"}]`},
+		{Patch: "- op: remove"},
+		{Type: konfluxv1alpha1.PatchTypeJSON6902, Patch: "metadata: {}"},
+	})
+	g.Expect(errs).To(gomega.HaveLen(2))
+	g.Expect(errs[0].Field).To(gomega.Equal("spec.patches[2].patch"))
+	g.Expect(errs[1].Field).To(gomega.Equal("spec.patches[3].patch"))
+}
+
+func TestValidateTrustManagerInstalled(t *testing.T) {
+	path := field.NewPath("spec")
+
+	t.Run("rejected without trust-manager", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		errs := validateTrustManagerInstalled(path, newClusterInfo(t, false, false))
+		g.Expect(errs).To(gomega.HaveLen(1))
+		g.Expect(errs[0].Type).To(gomega.Equal(field.ErrorTypeForbidden))
+	})
+
+	t.Run("accepted with trust-manager", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(validateTrustManagerInstalled(path, newClusterInfo(t, false, true))).To(gomega.BeEmpty())
+	})
+
+	t.Run("skipped without cluster info", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(validateTrustManagerInstalled(path, nil)).To(gomega.BeEmpty())
+	})
+}
+
+func TestValidateObject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Konflux is defaulted and validated", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		konflux := &konfluxv1alpha1.Konflux{
+			ObjectMeta: metav1.ObjectMeta{Name: "konflux"},
+			Spec: konfluxv1alpha1.KonfluxSpec{
+				NamespaceLister: &konfluxv1alpha1.NamespaceListerConfig{
+					Spec: &konfluxv1alpha1.KonfluxNamespaceListerSpec{CacheResyncPeriod: "0s"},
+				},
+			},
+		}
+		err := ValidateObject(ctx, konflux, nil, nil)
+		g.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("spec.namespaceLister.spec.cacheResyncPeriod")))
+		g.Expect(konflux.Spec.ImageController.Enabled).To(gomega.HaveValue(gomega.BeFalse()))
+	})
+
+	t.Run("component CRs are validated by their own webhook", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		registry := &konfluxv1alpha1.KonfluxInternalRegistry{ObjectMeta: metav1.ObjectMeta{Name: "konflux-internal-registry"}}
+		g.Expect(ValidateObject(ctx, registry, newClusterInfo(t, false, false), nil)).To(gomega.MatchError(
+			gomega.ContainSubstring(errTrustManagerNotInstalled)))
+		g.Expect(ValidateObject(ctx, registry, newClusterInfo(t, false, true), nil)).To(gomega.Succeed())
+	})
+
+	t.Run("kinds without a validating webhook are accepted", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(ValidateObject(ctx, &konfluxv1alpha1.KonfluxRBAC{}, nil, nil)).To(gomega.Succeed())
+	})
+}
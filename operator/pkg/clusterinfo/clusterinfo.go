@@ -234,6 +234,12 @@ func (i *Info) HasCertManager() (bool, error) {
 	return i.HasAllResources("cert-manager.io/v1", []string{"Certificate", "Issuer", "ClusterIssuer"})
 }
 
+// HasTrustManager checks if trust-manager is installed by verifying that the
+// Bundle resource (trust.cert-manager.io/v1alpha1) exists.
+func (i *Info) HasTrustManager() (bool, error) {
+	return i.HasResource("trust.cert-manager.io/v1alpha1", "Bundle")
+}
+
 // detectOpenShift checks if the operator is running on OpenShift by
 // verifying that the ClusterVersion resource exists in the config.openshift.io API group.
 func detectOpenShift(discoveryClient DiscoveryClient) (bool, error) {
@@ -527,6 +527,60 @@ func TestInfo_HasCertManager(t *testing.T) {
 	}
 }
 
+func TestInfo_HasTrustManager(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources map[string]*metav1.APIResourceList
+		expected  bool
+	}{
+		{
+			name: "trust-manager installed",
+			resources: map[string]*metav1.APIResourceList{
+				"trust.cert-manager.io/v1alpha1": {
+					APIResources: []metav1.APIResource{
+						{Kind: "Bundle"},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name:      "trust-manager not installed",
+			resources: map[string]*metav1.APIResourceList{},
+			expected:  false,
+		},
+		{
+			name: "trust group without Bundle",
+			resources: map[string]*metav1.APIResourceList{
+				"trust.cert-manager.io/v1alpha1": {
+					APIResources: []metav1.APIResource{
+						{Kind: "SomethingElse"},
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			mock := &mockDiscoveryClient{
+				resources:     tt.resources,
+				serverVersion: &version.Info{GitVersion: "v1.29.0"},
+			}
+
+			info, err := DetectWithClient(mock)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			result, err := info.HasTrustManager()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(result).To(gomega.Equal(tt.expected))
+		})
+	}
+}
+
 func TestPlatform_IsOpenShift(t *testing.T) {
 	tests := []struct {
 		platform Platform
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterinfo
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// ParsePlatform parses the name of a platform, "openshift" or "default".
+func ParsePlatform(name string) (Platform, error) {
+	switch platform := Platform(name); platform {
+	case OpenShift, Default:
+		return platform, nil
+	default:
+		return "", fmt.Errorf("unknown platform %q, expected %q or %q", name, OpenShift, Default)
+	}
+}
+
+// simulatedResources are the optional APIs a simulated cluster serves: the dependencies that
+// a Konflux installation expects to be installed.
+var simulatedResources = map[string][]string{
+	"cert-manager.io/v1":             {"Certificate", "Issuer", "ClusterIssuer"},
+	"trust.cert-manager.io/v1alpha1": {"Bundle"},
+	"tekton.dev/v1":                  {"Pipeline"},
+}
+
+// NewSimulated returns the Info of a cluster of the given platform that has every dependency
+// of Konflux installed, for rendering manifests without a cluster. Its Kubernetes version is
+// UnknownVersion.
+func NewSimulated(platform Platform) *Info {
+	discoveryClient := &simulatedDiscoveryClient{resources: simulatedResources}
+	if platform.IsOpenShift() {
+		discoveryClient.resources = map[string][]string{"config.openshift.io/v1": {"ClusterVersion"}}
+		for groupVersion, kinds := range simulatedResources {
+			discoveryClient.resources[groupVersion] = kinds
+		}
+	}
+	return &Info{client: discoveryClient, platform: platform}
+}
+
+// simulatedDiscoveryClient serves a fixed set of resource kinds per group version.
+type simulatedDiscoveryClient struct {
+	resources map[string][]string
+}
+
+func (c *simulatedDiscoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	kinds, ok := c.resources[groupVersion]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: groupVersion}, "")
+	}
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, kind := range kinds {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Kind: kind})
+	}
+	return list, nil
+}
+
+func (c *simulatedDiscoveryClient) ServerVersion() (*version.Info, error) {
+	return &version.Info{GitVersion: UnknownVersion}, nil
+}
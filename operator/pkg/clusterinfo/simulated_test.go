@@ -0,0 +1,62 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterinfo
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestParsePlatform(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	platform, err := ParsePlatform("openshift")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(platform).To(gomega.Equal(OpenShift))
+
+	platform, err = ParsePlatform("default")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(platform).To(gomega.Equal(Default))
+
+	_, err = ParsePlatform("kind")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(`unknown platform "kind"`)))
+}
+
+func TestNewSimulated(t *testing.T) {
+	for _, platform := range []Platform{OpenShift, Default} {
+		t.Run(string(platform), func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			info := NewSimulated(platform)
+
+			g.Expect(info.Platform()).To(gomega.Equal(platform))
+			g.Expect(info.HasCertManager()).To(gomega.BeTrue())
+			g.Expect(info.HasTrustManager()).To(gomega.BeTrue())
+			g.Expect(info.HasTekton()).To(gomega.BeTrue())
+			g.Expect(info.HasResource("networking.k8s.io/v1", "ServiceCIDR")).To(gomega.BeFalse())
+
+			v, err := info.K8sVersion()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(v.GitVersion).To(gomega.Equal(UnknownVersion))
+
+			// The simulated cluster must be detected as the same platform.
+			detected, err := DetectWithClient(info.client)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(detected.Platform()).To(gomega.Equal(platform))
+		})
+	}
+}
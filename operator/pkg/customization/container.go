@@ -53,6 +53,24 @@ func FromContainerSpec(spec *konfluxv1alpha1.ContainerSpec) ContainerOption {
 		if len(spec.Env) > 0 {
 			c.Env = append(c.Env, spec.Env...)
 		}
+		if spec.LivenessProbe != nil {
+			c.LivenessProbe = spec.LivenessProbe.DeepCopy()
+		}
+		if spec.ReadinessProbe != nil {
+			c.ReadinessProbe = spec.ReadinessProbe.DeepCopy()
+		}
+		if spec.StartupProbe != nil {
+			c.StartupProbe = spec.StartupProbe.DeepCopy()
+		}
+		if spec.SecurityContext != nil {
+			c.SecurityContext = spec.SecurityContext.DeepCopy()
+		}
+		if len(spec.VolumeMounts) > 0 {
+			c.VolumeMounts = append(c.VolumeMounts, spec.VolumeMounts...)
+		}
+		if len(spec.Args) > 0 {
+			c.Args = append(c.Args, spec.Args...)
+		}
 	}
 }
 
@@ -154,6 +154,31 @@ func TestFromContainerSpec(t *testing.T) {
 		g.Expect(c.Env[0].ValueFrom.SecretKeyRef.Name).To(gomega.Equal("my-secret"))
 		g.Expect(c.Env[0].ValueFrom.SecretKeyRef.Key).To(gomega.Equal("password"))
 	})
+
+	t.Run("probes, security context, volume mounts and args are copied from spec", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		readOnly := true
+		spec := &konfluxv1alpha1.ContainerSpec{
+			LivenessProbe:   &corev1.Probe{InitialDelaySeconds: 60},
+			ReadinessProbe:  &corev1.Probe{PeriodSeconds: 5},
+			StartupProbe:    &corev1.Probe{FailureThreshold: 30},
+			SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: &readOnly},
+			VolumeMounts:    []corev1.VolumeMount{{Name: "tmp", MountPath: "/tmp"}},
+			Args:            []string{"--lease-duration=60s"},
+		}
+
+		c := NewContainerOverlay(ctx, WithArgs("--existing"), FromContainerSpec(spec))
+
+		g.Expect(c.LivenessProbe.InitialDelaySeconds).To(gomega.Equal(int32(60)))
+		g.Expect(c.ReadinessProbe.PeriodSeconds).To(gomega.Equal(int32(5)))
+		g.Expect(c.StartupProbe.FailureThreshold).To(gomega.Equal(int32(30)))
+		g.Expect(*c.SecurityContext.ReadOnlyRootFilesystem).To(gomega.BeTrue())
+		g.Expect(c.VolumeMounts).To(gomega.Equal(spec.VolumeMounts))
+		g.Expect(c.Args).To(gomega.Equal([]string{"--existing", "--lease-duration=60s"}))
+
+		spec.LivenessProbe.InitialDelaySeconds = 1
+		g.Expect(c.LivenessProbe.InitialDelaySeconds).To(gomega.Equal(int32(60)), "probes must be copied")
+	})
 }
 
 func TestWithArgs(t *testing.T) {
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customization
+
+import (
+	"regexp"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+	"github.com/konflux-ci/konflux-ci/operator/pkg/imageref"
+)
+
+// imageRefPattern finds image references, such as pipeline bundles, in ConfigMap data.
+var imageRefPattern = regexp.MustCompile(`[A-Za-z0-9][\w.-]*(?::[0-9]+)?(?:/[\w.-]+)+(?::[\w.-]+)?(?:@[a-z0-9]+:[a-fA-F0-9]+)?`)
+
+// MirrorImage replaces the longest source of mirrors that image lies below with its mirror,
+// keeping the tag and digest. It reports whether a mirror matched.
+func MirrorImage(image string, mirrors []konfluxv1alpha1.ImageRegistryMirror) (string, bool) {
+	var best *konfluxv1alpha1.ImageRegistryMirror
+	for i := range mirrors {
+		if imageref.HasPrefix(image, mirrors[i].Source) && (best == nil || len(mirrors[i].Source) > len(best.Source)) {
+			best = &mirrors[i]
+		}
+	}
+	if best == nil {
+		return image, false
+	}
+	return imageref.ReplacePrefix(image, best.Source, best.Mirror), true
+}
+
+// ApplyImageMirrors rewrites the container images of workloads and the image references in
+// ConfigMap data to their mirrors. Images that match no mirror are left as they are.
+func ApplyImageMirrors(obj client.Object, mirrors []konfluxv1alpha1.ImageRegistryMirror) {
+	if len(mirrors) == 0 {
+		return
+	}
+	if podSpec := podSpecOf(obj); podSpec != nil {
+		forEachContainerImage(podSpec, func(image *string) {
+			*image, _ = MirrorImage(*image, mirrors)
+		})
+	}
+	if configMap, ok := obj.(*corev1.ConfigMap); ok {
+		for key, value := range configMap.Data {
+			configMap.Data[key] = mirrorImageRefs(value, mirrors)
+		}
+	}
+}
+
+// UnmirroredImages returns the container images of obj that match no mirror. Image references
+// in ConfigMap data are not reported, as they cannot be told apart from other text reliably.
+func UnmirroredImages(obj client.Object, mirrors []konfluxv1alpha1.ImageRegistryMirror) []string {
+	podSpec := podSpecOf(obj)
+	if podSpec == nil {
+		return nil
+	}
+	var unmirrored []string
+	forEachContainerImage(podSpec, func(image *string) {
+		if _, ok := MirrorImage(*image, mirrors); !ok {
+			unmirrored = append(unmirrored, *image)
+		}
+	})
+	return unmirrored
+}
+
+// mirrorImageRefs rewrites the image references in text. References that follow a slash or
+// colon are part of a URL and are left alone.
+func mirrorImageRefs(text string, mirrors []konfluxv1alpha1.ImageRegistryMirror) string {
+	matches := imageRefPattern.FindAllStringIndex(text, -1)
+	if matches == nil {
+		return text
+	}
+	out := make([]byte, 0, len(text))
+	last := 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		if start > 0 && (text[start-1] == '/' || text[start-1] == ':') {
+			continue
+		}
+		mirrored, ok := MirrorImage(text[start:end], mirrors)
+		if !ok {
+			continue
+		}
+		out = append(out, text[last:start]...)
+		out = append(out, mirrored...)
+		last = end
+	}
+	return string(append(out, text[last:]...))
+}
+
+// RunsPods reports whether obj is a workload with a pod template.
+func RunsPods(obj client.Object) bool {
+	return podSpecOf(obj) != nil
+}
+
+func podSpecOf(obj client.Object) *corev1.PodSpec {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return &o.Spec.Template.Spec
+	case *appsv1.StatefulSet:
+		return &o.Spec.Template.Spec
+	case *appsv1.DaemonSet:
+		return &o.Spec.Template.Spec
+	case *batchv1.Job:
+		return &o.Spec.Template.Spec
+	case *batchv1.CronJob:
+		return &o.Spec.JobTemplate.Spec.Template.Spec
+	}
+	return nil
+}
+
+func forEachContainerImage(podSpec *corev1.PodSpec, fn func(image *string)) {
+	for i := range podSpec.InitContainers {
+		fn(&podSpec.InitContainers[i].Image)
+	}
+	for i := range podSpec.Containers {
+		fn(&podSpec.Containers[i].Image)
+	}
+	for i := range podSpec.EphemeralContainers {
+		fn(&podSpec.EphemeralContainers[i].Image)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customization
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+var testMirrors = []konfluxv1alpha1.ImageRegistryMirror{
+	{Source: "quay.io", Mirror: "mirror.example.com/quay"},
+	{Source: "quay.io/konflux-ci", Mirror: "mirror.example.com/konflux"},
+}
+
+func TestMirrorImage(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	image, ok := MirrorImage("quay.io/konflux-ci/build-service@sha256:abcd", testMirrors)
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(image).To(gomega.Equal("mirror.example.com/konflux/build-service@sha256:abcd"), "longest source wins")
+
+	image, ok = MirrorImage("quay.io/other/app:v1", testMirrors)
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(image).To(gomega.Equal("mirror.example.com/quay/other/app:v1"))
+
+	image, ok = MirrorImage("registry.redhat.io/rhel9/python-312", testMirrors)
+	g.Expect(ok).To(gomega.BeFalse())
+	g.Expect(image).To(gomega.Equal("registry.redhat.io/rhel9/python-312"))
+}
+
+func TestApplyImageMirrors(t *testing.T) {
+	t.Run("rewrites workload containers and reports unmirrored images", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		cronJob := &batchv1.CronJob{
+			Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init", Image: "quay.io/konflux-ci/init:v1"}},
+					Containers:     []corev1.Container{{Name: "main", Image: "registry.redhat.io/ubi9/ubi:latest"}},
+				}},
+			}}},
+		}
+
+		g.Expect(UnmirroredImages(cronJob, testMirrors)).To(gomega.Equal([]string{"registry.redhat.io/ubi9/ubi:latest"}))
+
+		ApplyImageMirrors(cronJob, testMirrors)
+		podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+		g.Expect(podSpec.InitContainers[0].Image).To(gomega.Equal("mirror.example.com/konflux/init:v1"))
+		g.Expect(podSpec.Containers[0].Image).To(gomega.Equal("registry.redhat.io/ubi9/ubi:latest"))
+	})
+
+	t.Run("rewrites image references in ConfigMap data", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "build-pipeline-config"},
+			Data: map[string]string{
+				"config.yaml": "pipelines:\n" +
+					"- name: docker-build\n" +
+					"  bundle: quay.io/konflux-ci/tekton-catalog/pipeline-docker-build@sha256:b7e4\n" +
+					"docs: https://quay.io/konflux-ci/tekton-catalog\n",
+			},
+		}
+
+		ApplyImageMirrors(configMap, testMirrors)
+		g.Expect(configMap.Data["config.yaml"]).To(gomega.Equal("pipelines:\n" +
+			"- name: docker-build\n" +
+			"  bundle: mirror.example.com/konflux/tekton-catalog/pipeline-docker-build@sha256:b7e4\n" +
+			"docs: https://quay.io/konflux-ci/tekton-catalog\n"))
+	})
+
+	t.Run("no mirrors leave the object unchanged", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newPatchTestDeployment()
+		ApplyImageMirrors(deployment, nil)
+		g.Expect(deployment).To(gomega.Equal(newPatchTestDeployment()))
+	})
+}
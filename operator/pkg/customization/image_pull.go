@@ -0,0 +1,65 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customization
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// ApplyImagePull adds the image pull secrets of pull to the pod templates of workloads and to
+// ServiceAccounts, keeping the secrets they already reference, and replaces the imagePullPolicy
+// of every container when pull sets a policy.
+func ApplyImagePull(obj client.Object, pull *konfluxv1alpha1.ImagePullConfig) {
+	if pull == nil {
+		return
+	}
+	if serviceAccount, ok := obj.(*corev1.ServiceAccount); ok {
+		serviceAccount.ImagePullSecrets = appendPullSecrets(serviceAccount.ImagePullSecrets, pull.Secrets)
+		return
+	}
+	podSpec := podSpecOf(obj)
+	if podSpec == nil {
+		return
+	}
+	podSpec.ImagePullSecrets = appendPullSecrets(podSpec.ImagePullSecrets, pull.Secrets)
+	if pull.Policy == "" {
+		return
+	}
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].ImagePullPolicy = pull.Policy
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].ImagePullPolicy = pull.Policy
+	}
+	for i := range podSpec.EphemeralContainers {
+		podSpec.EphemeralContainers[i].ImagePullPolicy = pull.Policy
+	}
+}
+
+func appendPullSecrets(existing, secrets []corev1.LocalObjectReference) []corev1.LocalObjectReference {
+	for _, secret := range secrets {
+		if !slices.Contains(existing, secret) {
+			existing = append(existing, secret)
+		}
+	}
+	return existing
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customization
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func TestApplyImagePull(t *testing.T) {
+	pull := &konfluxv1alpha1.ImagePullConfig{
+		Secrets: []corev1.LocalObjectReference{{Name: "mirror-credentials"}, {Name: "existing"}},
+		Policy:  corev1.PullAlways,
+	}
+
+	t.Run("adds secrets and sets the policy of every container", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		cronJob := &batchv1.CronJob{
+			Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					ImagePullSecrets: []corev1.LocalObjectReference{{Name: "existing"}},
+					InitContainers:   []corev1.Container{{Name: "init", ImagePullPolicy: corev1.PullIfNotPresent}},
+					Containers:       []corev1.Container{{Name: "main"}},
+				}},
+			}}},
+		}
+
+		ApplyImagePull(cronJob, pull)
+		podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+		g.Expect(podSpec.ImagePullSecrets).To(gomega.Equal([]corev1.LocalObjectReference{
+			{Name: "existing"}, {Name: "mirror-credentials"},
+		}))
+		g.Expect(podSpec.InitContainers[0].ImagePullPolicy).To(gomega.Equal(corev1.PullAlways))
+		g.Expect(podSpec.Containers[0].ImagePullPolicy).To(gomega.Equal(corev1.PullAlways))
+	})
+
+	t.Run("adds secrets to ServiceAccounts", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		serviceAccount := &corev1.ServiceAccount{}
+		ApplyImagePull(serviceAccount, pull)
+		g.Expect(serviceAccount.ImagePullSecrets).To(gomega.Equal(pull.Secrets))
+	})
+
+	t.Run("keeps the manifest policy without a policy", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newPatchTestDeployment()
+		deployment.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullIfNotPresent
+		ApplyImagePull(deployment, &konfluxv1alpha1.ImagePullConfig{})
+		g.Expect(deployment.Spec.Template.Spec.Containers[0].ImagePullPolicy).To(gomega.Equal(corev1.PullIfNotPresent))
+	})
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customization
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// MatchesPatchTarget reports whether obj is selected by target.
+func MatchesPatchTarget(obj client.Object, target konfluxv1alpha1.PatchTarget) bool {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Group != target.Group || gvk.Kind != target.Kind || obj.GetName() != target.Name {
+		return false
+	}
+	if target.Version != "" && gvk.Version != target.Version {
+		return false
+	}
+	return target.Namespace == "" || obj.GetNamespace() == target.Namespace
+}
+
+// ApplyObjectPatches applies, in order, the patches whose target matches obj.
+// Patches must not change the kind, namespace or name of the object.
+func ApplyObjectPatches(obj client.Object, patches []konfluxv1alpha1.ObjectPatch) error {
+	for i, patch := range patches {
+		if !MatchesPatchTarget(obj, patch.Target) {
+			continue
+		}
+		if err := applyObjectPatch(obj, patch); err != nil {
+			return fmt.Errorf("patch %d for %s %s: %w", i, patch.Target.Kind, patch.Target.Name, err)
+		}
+	}
+	return nil
+}
+
+// ParseObjectPatch converts the YAML or JSON patch to JSON and checks that it is well formed
+// for its type.
+func ParseObjectPatch(patch konfluxv1alpha1.ObjectPatch) ([]byte, error) {
+	data, err := yaml.YAMLToJSON([]byte(patch.Patch))
+	if err != nil {
+		return nil, fmt.Errorf("invalid patch: %w", err)
+	}
+	switch patch.Type {
+	case "", konfluxv1alpha1.PatchTypeStrategicMerge:
+		var fields map[string]any
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("a %s patch must be an object: %w", konfluxv1alpha1.PatchTypeStrategicMerge, err)
+		}
+	case konfluxv1alpha1.PatchTypeJSON6902:
+		if _, err := jsonpatch.DecodePatch(data); err != nil {
+			return nil, fmt.Errorf("a %s patch must be a list of operations: %w", konfluxv1alpha1.PatchTypeJSON6902, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported patch type %q", patch.Type)
+	}
+	return data, nil
+}
+
+func applyObjectPatch(obj client.Object, patch konfluxv1alpha1.ObjectPatch) error {
+	patchJSON, err := ParseObjectPatch(patch)
+	if err != nil {
+		return err
+	}
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	var patched []byte
+	_, isUnstructured := obj.(*unstructured.Unstructured)
+	switch {
+	case patch.Type == konfluxv1alpha1.PatchTypeJSON6902:
+		operations, err := jsonpatch.DecodePatch(patchJSON)
+		if err != nil {
+			return err
+		}
+		patched, err = operations.Apply(original)
+		if err != nil {
+			return err
+		}
+	case isUnstructured:
+		// Without a registered type there are no patch strategies to follow.
+		patched, err = jsonpatch.MergePatch(original, patchJSON)
+		if err != nil {
+			return err
+		}
+	default:
+		patched, err = strategicpatch.StrategicMergePatch(original, patchJSON, obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	gvk, namespace, name := obj.GetObjectKind().GroupVersionKind(), obj.GetNamespace(), obj.GetName()
+	if err := replaceObject(obj, patched); err != nil {
+		return err
+	}
+	if obj.GetObjectKind().GroupVersionKind() != gvk || obj.GetNamespace() != namespace || obj.GetName() != name {
+		return fmt.Errorf("patches must not change the apiVersion, kind, namespace or name of the object")
+	}
+	return nil
+}
+
+// replaceObject decodes data into obj, dropping every field that data does not set.
+func replaceObject(obj client.Object, data []byte) error {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		u.Object = nil
+		return u.UnmarshalJSON(data)
+	}
+	v := reflect.ValueOf(obj).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	return json.Unmarshal(data, obj)
+}
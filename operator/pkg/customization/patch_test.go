@@ -0,0 +1,189 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customization
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func newPatchTestDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "controller-manager", Namespace: "build-service"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "manager", Image: "manager:v1", Env: []corev1.EnvVar{{Name: "A", Value: "1"}}},
+						{Name: "proxy", Image: "proxy:v1"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func deploymentTarget() konfluxv1alpha1.PatchTarget {
+	return konfluxv1alpha1.PatchTarget{Group: "apps", Kind: "Deployment", Name: "controller-manager"}
+}
+
+func TestMatchesPatchTarget(t *testing.T) {
+	g := gomega.NewWithT(t)
+	deployment := newPatchTestDeployment()
+
+	g.Expect(MatchesPatchTarget(deployment, deploymentTarget())).To(gomega.BeTrue())
+
+	target := deploymentTarget()
+	target.Version = "v1"
+	target.Namespace = "build-service"
+	g.Expect(MatchesPatchTarget(deployment, target)).To(gomega.BeTrue())
+
+	target.Namespace = "other"
+	g.Expect(MatchesPatchTarget(deployment, target)).To(gomega.BeFalse())
+
+	target = deploymentTarget()
+	target.Version = "v1beta1"
+	g.Expect(MatchesPatchTarget(deployment, target)).To(gomega.BeFalse())
+
+	target = deploymentTarget()
+	target.Group = ""
+	g.Expect(MatchesPatchTarget(deployment, target)).To(gomega.BeFalse())
+}
+
+func TestApplyObjectPatches(t *testing.T) {
+	t.Run("strategic merge patch merges containers by name", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newPatchTestDeployment()
+
+		err := ApplyObjectPatches(deployment, []konfluxv1alpha1.ObjectPatch{{
+			Target: deploymentTarget(),
+			Patch: `
+metadata:
+  annotations:
+    example.com/owner: platform
+spec:
+  template:
+    spec:
+      containers:
+        - name: manager
+          env:
+            - name: B
+              value: "2"
+`,
+		}})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(deployment.Annotations).To(gomega.HaveKeyWithValue("example.com/owner", "platform"))
+		containers := deployment.Spec.Template.Spec.Containers
+		g.Expect(containers).To(gomega.HaveLen(2))
+		g.Expect(containers[0].Image).To(gomega.Equal("manager:v1"))
+		g.Expect(containers[0].Env).To(gomega.ConsistOf(
+			corev1.EnvVar{Name: "A", Value: "1"},
+			corev1.EnvVar{Name: "B", Value: "2"},
+		))
+		g.Expect(deployment.Kind).To(gomega.Equal("Deployment"))
+	})
+
+	t.Run("JSON6902 patch removes fields", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newPatchTestDeployment()
+
+		err := ApplyObjectPatches(deployment, []konfluxv1alpha1.ObjectPatch{{
+			Target: deploymentTarget(),
+			Type:   konfluxv1alpha1.PatchTypeJSON6902,
+			Patch:  `[{"op": "remove", "path": "/spec/template/spec/containers/1"}]`,
+		}})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(deployment.Spec.Template.Spec.Containers).To(gomega.HaveLen(1))
+		g.Expect(deployment.Spec.Template.Spec.Containers[0].Name).To(gomega.Equal("manager"))
+	})
+
+	t.Run("skips patches for other objects", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newPatchTestDeployment()
+		target := deploymentTarget()
+		target.Name = "other"
+
+		err := ApplyObjectPatches(deployment, []konfluxv1alpha1.ObjectPatch{{
+			Target: target,
+			Patch:  "metadata: {labels: {patched: \"true\"}}",
+		}})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(deployment.Labels).To(gomega.BeEmpty())
+	})
+
+	t.Run("patches unstructured objects as merge patches", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata":   map[string]any{"name": "widget"},
+			"spec":       map[string]any{"size": int64(1), "color": "red"},
+		}}
+
+		err := ApplyObjectPatches(obj, []konfluxv1alpha1.ObjectPatch{{
+			Target: konfluxv1alpha1.PatchTarget{Group: "example.com", Kind: "Widget", Name: "widget"},
+			Patch:  `{"spec": {"size": 3, "color": null}}`,
+		}})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(obj.Object["spec"]).To(gomega.Equal(map[string]any{"size": int64(3)}))
+	})
+
+	t.Run("rejects patches that rename the object", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newPatchTestDeployment()
+
+		err := ApplyObjectPatches(deployment, []konfluxv1alpha1.ObjectPatch{{
+			Target: deploymentTarget(),
+			Patch:  "metadata: {name: renamed}",
+		}})
+		g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("must not change")))
+	})
+
+	t.Run("reports invalid patches", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newPatchTestDeployment()
+
+		err := ApplyObjectPatches(deployment, []konfluxv1alpha1.ObjectPatch{{
+			Target: deploymentTarget(),
+			Type:   konfluxv1alpha1.PatchTypeJSON6902,
+			Patch:  "metadata: {}",
+		}})
+		g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("patch 0 for Deployment controller-manager")))
+	})
+}
+
+func TestParseObjectPatch(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	data, err := ParseObjectPatch(konfluxv1alpha1.ObjectPatch{Patch: "spec:\n  replicas: 2\n"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(data)).To(gomega.Equal(`{"spec":{"replicas":2}}`))
+
+	_, err = ParseObjectPatch(konfluxv1alpha1.ObjectPatch{Patch: "- op: remove"})
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("must be an object")))
+
+	_, err = ParseObjectPatch(konfluxv1alpha1.ObjectPatch{Type: "Merge", Patch: "{}"})
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("unsupported patch type")))
+}
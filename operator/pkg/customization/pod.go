@@ -177,6 +177,31 @@ func WithLeaderElection(containerName string, replicas int32) PodOverlayOption {
 	return WithArgReplace(containerName, "--leader-elect=true")
 }
 
+// zapLogLevels maps a LogLevel to the --zap-log-level value. logr has no warning level, so
+// warn only keeps errors, like error.
+var zapLogLevels = map[konfluxv1alpha1.LogLevel]string{
+	konfluxv1alpha1.LogLevelDebug: "debug",
+	konfluxv1alpha1.LogLevelInfo:  "info",
+	konfluxv1alpha1.LogLevelWarn:  "error",
+	konfluxv1alpha1.LogLevelError: "error",
+}
+
+// WithZapLogging sets the --zap-log-level and --zap-encoder flags of a controller-runtime
+// based container from logging. Fields that are not set keep the flags of the manifest.
+func WithZapLogging(containerName string, logging *konfluxv1alpha1.LoggingSpec) PodOverlayOption {
+	if logging == nil {
+		return func(_ *PodOverlay) {}
+	}
+	var args []string
+	if level, ok := zapLogLevels[logging.Level]; ok {
+		args = append(args, konfluxv1alpha1.ZapLogLevelArg+"="+level)
+	}
+	if logging.Encoder != "" {
+		args = append(args, konfluxv1alpha1.ZapEncoderArg+"="+string(logging.Encoder))
+	}
+	return WithArgReplace(containerName, args...)
+}
+
 // WithServiceAccountName sets the service account name for the pod.
 func WithServiceAccountName(name string) PodOverlayOption {
 	return func(p *PodOverlay) {
@@ -342,16 +367,36 @@ func mergeContainerList(
 			extraEnv := overlay.Env
 			overlay.Env = nil
 
-			if err := StrategicMerge(base, overlay); err != nil {
+			// Probes are merged separately for the same reason: a probe can only have one
+			// handler, so an httpGet overlay on an exec probe must replace the handler.
+			probes := [3]*corev1.Probe{overlay.LivenessProbe, overlay.ReadinessProbe, overlay.StartupProbe}
+			overlay.LivenessProbe, overlay.ReadinessProbe, overlay.StartupProbe = nil, nil, nil
+			restore := func() {
 				overlay.Args = extraArgs
 				overlay.Env = extraEnv
+				overlay.LivenessProbe, overlay.ReadinessProbe, overlay.StartupProbe = probes[0], probes[1], probes[2]
+			}
+
+			if err := StrategicMerge(base, overlay); err != nil {
+				restore()
 				return err
 			}
 
-			overlay.Args = extraArgs
-			overlay.Env = extraEnv
+			restore()
 			base.Args = append(base.Args, extraArgs...)
 			mergeEnvByName(base, extraEnv)
+			for _, probe := range []struct {
+				base    **corev1.Probe
+				overlay *corev1.Probe
+			}{
+				{&base.LivenessProbe, probes[0]},
+				{&base.ReadinessProbe, probes[1]},
+				{&base.StartupProbe, probes[2]},
+			} {
+				if err := mergeProbe(probe.base, probe.overlay); err != nil {
+					return err
+				}
+			}
 		}
 
 		for _, replacement := range argReplacements[base.Name] {
@@ -384,6 +429,24 @@ func argKey(arg string) string {
 	return arg
 }
 
+// mergeProbe merges an overlay probe into a container probe. A handler set on the overlay
+// replaces the handler of the base; the timing fields are merged with StrategicMerge.
+func mergeProbe(base **corev1.Probe, overlay *corev1.Probe) error {
+	if overlay == nil {
+		return nil
+	}
+	if *base == nil {
+		*base = overlay.DeepCopy()
+		return nil
+	}
+	fields := overlay.DeepCopy()
+	if fields.ProbeHandler != (corev1.ProbeHandler{}) {
+		(*base).ProbeHandler = fields.ProbeHandler
+		fields.ProbeHandler = corev1.ProbeHandler{}
+	}
+	return StrategicMerge(*base, fields)
+}
+
 // mergeEnvByName replaces or appends overlay env vars into a container by name.
 // When an overlay env var has the same name as a base env var, the entire EnvVar
 // struct is replaced. This prevents invalid combinations of value and valueFrom
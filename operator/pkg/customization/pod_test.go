@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
 )
@@ -1338,6 +1339,67 @@ func TestWithLeaderElection(t *testing.T) {
 	})
 }
 
+func TestWithZapLogging(t *testing.T) {
+	newDeployment := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{Name: "manager", Args: []string{"--zap-log-level=info", "--metrics-bind-address=:8443"}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("replaces the level and adds the encoder", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newDeployment()
+
+		err := NewPodOverlay(WithZapLogging("manager", &konfluxv1alpha1.LoggingSpec{
+			Level:   konfluxv1alpha1.LogLevelDebug,
+			Encoder: konfluxv1alpha1.LogEncoderConsole,
+		})).ApplyToDeployment(deployment)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		g.Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(gomega.Equal([]string{
+			"--zap-log-level=debug",
+			"--metrics-bind-address=:8443",
+			"--zap-encoder=console",
+		}))
+	})
+
+	t.Run("maps warn to error", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newDeployment()
+
+		err := NewPodOverlay(WithZapLogging("manager", &konfluxv1alpha1.LoggingSpec{
+			Level: konfluxv1alpha1.LogLevelWarn,
+		})).ApplyToDeployment(deployment)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		g.Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(gomega.Equal([]string{
+			"--zap-log-level=error",
+			"--metrics-bind-address=:8443",
+		}))
+	})
+
+	t.Run("nil logging keeps the args", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newDeployment()
+
+		err := NewPodOverlay(WithZapLogging("manager", nil)).ApplyToDeployment(deployment)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		g.Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(gomega.Equal([]string{
+			"--zap-log-level=info",
+			"--metrics-bind-address=:8443",
+		}))
+	})
+}
+
 func TestArgKey(t *testing.T) {
 	tests := []struct {
 		arg  string
@@ -1818,6 +1880,74 @@ func TestMergeContainerList_EnvOverride(t *testing.T) {
 
 // TestMergeEnvByName is a focused unit test for the mergeEnvByName helper,
 // verifying its behavior in isolation from the full mergeContainerList flow.
+func TestMergeContainerList_Probes(t *testing.T) {
+	newDeployment := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: "manager",
+							LivenessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8081)},
+								},
+								InitialDelaySeconds: 15,
+								PeriodSeconds:       20,
+							},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("timing fields are merged into the manifest probe", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newDeployment()
+		p := NewPodOverlay(WithContainerOpts("manager", DeploymentContext{}, FromContainerSpec(&konfluxv1alpha1.ContainerSpec{
+			LivenessProbe: &corev1.Probe{InitialDelaySeconds: 120},
+		})))
+
+		g.Expect(p.ApplyToDeployment(deployment)).To(gomega.Succeed())
+		probe := deployment.Spec.Template.Spec.Containers[0].LivenessProbe
+		g.Expect(probe.InitialDelaySeconds).To(gomega.Equal(int32(120)))
+		g.Expect(probe.PeriodSeconds).To(gomega.Equal(int32(20)))
+		g.Expect(probe.HTTPGet.Path).To(gomega.Equal("/healthz"))
+	})
+
+	t.Run("a handler replaces the manifest handler", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newDeployment()
+		p := NewPodOverlay(WithContainerOpts("manager", DeploymentContext{}, FromContainerSpec(&konfluxv1alpha1.ContainerSpec{
+			LivenessProbe: &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"/bin/check"}}},
+			},
+		})))
+
+		g.Expect(p.ApplyToDeployment(deployment)).To(gomega.Succeed())
+		probe := deployment.Spec.Template.Spec.Containers[0].LivenessProbe
+		g.Expect(probe.HTTPGet).To(gomega.BeNil(), "a probe must have a single handler")
+		g.Expect(probe.Exec.Command).To(gomega.Equal([]string{"/bin/check"}))
+		g.Expect(probe.InitialDelaySeconds).To(gomega.Equal(int32(15)))
+	})
+
+	t.Run("a probe missing from the manifest is added", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := newDeployment()
+		startup := &corev1.Probe{
+			ProbeHandler:     corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8081)}},
+			FailureThreshold: 30,
+		}
+		p := NewPodOverlay(WithContainerOpts("manager", DeploymentContext{}, FromContainerSpec(&konfluxv1alpha1.ContainerSpec{
+			StartupProbe: startup,
+		})))
+
+		g.Expect(p.ApplyToDeployment(deployment)).To(gomega.Succeed())
+		g.Expect(deployment.Spec.Template.Spec.Containers[0].StartupProbe).To(gomega.Equal(startup))
+	})
+}
+
 func TestMergeEnvByName(t *testing.T) {
 	t.Run("nil overlay is a no-op", func(t *testing.T) {
 		g := gomega.NewWithT(t)
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customization
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+// Environment variables that carry the egress proxy settings.
+const (
+	HTTPProxyEnvVar  = "HTTP_PROXY"
+	HTTPSProxyEnvVar = "HTTPS_PROXY"
+	NoProxyEnvVar    = "NO_PROXY"
+)
+
+// ApplyProxy sets the proxy environment variables of proxy on every init and regular
+// container of a workload, replacing the values that the manifests or the container
+// settings define. Variables whose setting is empty are left alone, and NO_PROXY is only
+// set together with a proxy URL.
+func ApplyProxy(obj client.Object, proxy *konfluxv1alpha1.EgressProxySpec) {
+	if proxy == nil || (proxy.HTTPProxy == "" && proxy.HTTPSProxy == "") {
+		return
+	}
+	podSpec := podSpecOf(obj)
+	if podSpec == nil {
+		return
+	}
+	var opts []ContainerOption
+	for _, env := range []struct{ name, value string }{
+		{HTTPProxyEnvVar, proxy.HTTPProxy},
+		{HTTPSProxyEnvVar, proxy.HTTPSProxy},
+		{NoProxyEnvVar, proxy.NoProxy},
+	} {
+		if env.value != "" {
+			opts = append(opts, WithEnvOverride(env.name, env.value))
+		}
+	}
+	for i := range podSpec.InitContainers {
+		for _, opt := range opts {
+			opt(&podSpec.InitContainers[i], DeploymentContext{})
+		}
+	}
+	for i := range podSpec.Containers {
+		for _, opt := range opts {
+			opt(&podSpec.Containers[i], DeploymentContext{})
+		}
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customization
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	konfluxv1alpha1 "github.com/konflux-ci/konflux-ci/operator/api/v1alpha1"
+)
+
+func TestApplyProxy(t *testing.T) {
+	proxy := &konfluxv1alpha1.EgressProxySpec{
+		HTTPProxy:  "http://proxy.example.com:3128",
+		HTTPSProxy: "http://proxy.example.com:3128",
+		NoProxy:    "10.96.0.0/12,.svc",
+	}
+
+	t.Run("sets the variables of every container", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init"}},
+				Containers: []corev1.Container{{
+					Name: "manager",
+					Env: []corev1.EnvVar{
+						{Name: "HTTP_PROXY", Value: "http://old.example.com"},
+						{Name: "LOG_LEVEL", Value: "info"},
+					},
+				}},
+			}}},
+		}
+
+		ApplyProxy(deployment, proxy)
+
+		want := []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: "10.96.0.0/12,.svc"},
+		}
+		podSpec := deployment.Spec.Template.Spec
+		g.Expect(podSpec.InitContainers[0].Env).To(gomega.Equal(want))
+		g.Expect(podSpec.Containers[0].Env).To(gomega.Equal(append(
+			[]corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}}, want...)))
+	})
+
+	t.Run("skips empty settings", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "manager"}},
+			}}},
+		}
+
+		ApplyProxy(deployment, &konfluxv1alpha1.EgressProxySpec{HTTPSProxy: "http://proxy.example.com:3128"})
+
+		g.Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(gomega.Equal([]corev1.EnvVar{
+			{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+		}))
+	})
+
+	t.Run("does nothing without a proxy URL", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		deployment := &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "manager"}},
+			}}},
+		}
+
+		ApplyProxy(deployment, nil)
+		ApplyProxy(deployment, &konfluxv1alpha1.EgressProxySpec{NoProxy: ".svc"})
+
+		g.Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(gomega.BeEmpty())
+	})
+
+	t.Run("ignores objects without pods", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		configMap := &corev1.ConfigMap{Data: map[string]string{"key": "value"}}
+
+		ApplyProxy(configMap, proxy)
+
+		g.Expect(configMap.Data).To(gomega.Equal(map[string]string{"key": "value"}))
+	})
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package imageref parses and rewrites container image references.
+package imageref
+
+import "strings"
+
+// Parse splits a container image reference into kustomize newName and either
+// newTag or digest (OCI image digest: algorithm + hex after first ':').
+func Parse(s string) (name, tag, digest string) {
+	s = strings.TrimSpace(s)
+	if at := strings.Index(s, "@"); at >= 0 {
+		name, rest := s[:at], s[at+1:]
+		if isOCIImageDigest(rest) {
+			return name, "", rest
+		}
+		return name, rest, ""
+	}
+	if i := strings.LastIndex(s, ":"); i > strings.LastIndex(s, "/") {
+		return s[:i], s[i+1:], ""
+	}
+	return s, "latest", ""
+}
+
+func isOCIImageDigest(s string) bool {
+	// OCI manifest digest: sha256:<64 hex> or sha512:<128 hex>, etc.
+	for _, prefix := range []string{"sha256:", "sha512:", "sha384:"} {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPrefix reports whether the repository of image is prefix or lies below it, matching
+// whole path segments: quay.io/konflux-ci/ui:v1 has the prefix quay.io/konflux-ci, while
+// quay.io/konflux-ci-dev/ui does not.
+func HasPrefix(image, prefix string) bool {
+	name, _, _ := Parse(image)
+	prefix = strings.TrimSuffix(prefix, "/")
+	return prefix != "" && (name == prefix || strings.HasPrefix(name, prefix+"/"))
+}
+
+// ReplacePrefix replaces prefix in image with replacement, keeping the rest of the repository,
+// the tag and the digest. The caller must check HasPrefix first.
+func ReplacePrefix(image, prefix, replacement string) string {
+	image = strings.TrimSpace(image)
+	prefix = strings.TrimSuffix(prefix, "/")
+	return strings.TrimSuffix(replacement, "/") + image[len(prefix):]
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageref
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		input      string
+		wantName   string
+		wantTag    string
+		wantDigest string
+	}{
+		{
+			name:       "digest",
+			input:      "quay.io/org/app@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			wantName:   "quay.io/org/app",
+			wantTag:    "",
+			wantDigest: "sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		},
+		{
+			name:       "tag then digest uses digest branch",
+			input:      "quay.io/org/app:mytag@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			wantName:   "quay.io/org/app:mytag",
+			wantTag:    "",
+			wantDigest: "sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		},
+		{
+			name:     "tag only",
+			input:    "registry:5000/ns/img:v1.2",
+			wantName: "registry:5000/ns/img",
+			wantTag:  "v1.2",
+		},
+		{
+			name:     "at without oci digest becomes tag",
+			input:    "quay.io/org/app@edge",
+			wantName: "quay.io/org/app",
+			wantTag:  "edge",
+		},
+		{
+			name:     "bare name defaults latest",
+			input:    "quay.io/org/app",
+			wantName: "quay.io/org/app",
+			wantTag:  "latest",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotName, gotTag, gotDigest := Parse(tc.input)
+			if gotName != tc.wantName || gotTag != tc.wantTag || gotDigest != tc.wantDigest {
+				t.Fatalf("Parse(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					tc.input, gotName, gotTag, gotDigest, tc.wantName, tc.wantTag, tc.wantDigest)
+			}
+		})
+	}
+}
+
+func TestReplacePrefix(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		image       string
+		prefix      string
+		replacement string
+		want        string
+		wantMatch   bool
+	}{
+		{
+			name:        "organization prefix keeps digest",
+			image:       "quay.io/konflux-ci/ui@sha256:aaaa",
+			prefix:      "quay.io/konflux-ci",
+			replacement: "mirror.example.com/konflux",
+			want:        "mirror.example.com/konflux/ui@sha256:aaaa",
+			wantMatch:   true,
+		},
+		{
+			name:        "full repository keeps tag",
+			image:       "quay.io/konflux-ci/ui:v1",
+			prefix:      "quay.io/konflux-ci/ui",
+			replacement: "mirror.example.com/ui/",
+			want:        "mirror.example.com/ui:v1",
+			wantMatch:   true,
+		},
+		{
+			name:        "registry with port",
+			image:       "registry:5000/ns/img:v1.2",
+			prefix:      "registry:5000",
+			replacement: "mirror.example.com",
+			want:        "mirror.example.com/ns/img:v1.2",
+			wantMatch:   true,
+		},
+		{
+			name:      "partial path segment does not match",
+			image:     "quay.io/konflux-ci-dev/ui:v1",
+			prefix:    "quay.io/konflux-ci",
+			wantMatch: false,
+		},
+		{
+			name:      "tag is not part of the repository",
+			image:     "quay.io/konflux-ci/ui:v1",
+			prefix:    "quay.io/konflux-ci/ui:v",
+			wantMatch: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := HasPrefix(tc.image, tc.prefix); got != tc.wantMatch {
+				t.Fatalf("HasPrefix(%q, %q) = %v, want %v", tc.image, tc.prefix, got, tc.wantMatch)
+			}
+			if !tc.wantMatch {
+				return
+			}
+			if got := ReplacePrefix(tc.image, tc.prefix, tc.replacement); got != tc.want {
+				t.Fatalf("ReplacePrefix(%q, %q, %q) = %q, want %q", tc.image, tc.prefix, tc.replacement, got, tc.want)
+			}
+		})
+	}
+}
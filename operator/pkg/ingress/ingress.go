@@ -203,6 +203,26 @@ func GetOpenShiftIngressDomain(ctx context.Context, c client.Client) (string, er
 	return domain, nil
 }
 
+// IsEffectivelyEnabled reports whether the managed Ingress is enabled for the given spec:
+// an explicit true, or unset (nil) on OpenShift.
+func IsEffectivelyEnabled(ingressSpec konfluxv1alpha1.IngressSpec, isOnOpenShift bool) bool {
+	return ptr.Deref(ingressSpec.Enabled, isOnOpenShift)
+}
+
+// ValidateFQDN returns ErrFQDNPortWithManagedIngress when ingress.fqdn carries a :port
+// suffix while ingress is effectively enabled. It is shared by the KonfluxUI reconciler
+// and the admission webhook so both report the same message.
+func ValidateFQDN(ingressSpec konfluxv1alpha1.IngressSpec, isOnOpenShift bool) error {
+	if ingressSpec.FQDN == "" {
+		return nil
+	}
+	endpoint := &url.URL{Scheme: "https", Host: ingressSpec.FQDN}
+	if endpoint.Port() != "" && IsEffectivelyEnabled(ingressSpec, isOnOpenShift) {
+		return ErrFQDNPortWithManagedIngress
+	}
+	return nil
+}
+
 // DetermineEndpointURL determines the endpoint URL for the UI based on ingress configuration.
 //
 // Resolution order:
@@ -228,8 +248,7 @@ func DetermineEndpointURL(
 	log := logf.FromContext(ctx)
 	ingressSpec := ui.Spec.GetIngress()
 	isOnOpenShift := clusterInfo != nil && clusterInfo.IsOpenShift()
-	// Effectively enabled: explicit true, or unset (nil) on OpenShift.
-	ingressEnabled := ptr.Deref(ingressSpec.Enabled, isOnOpenShift)
+	ingressEnabled := IsEffectivelyEnabled(ingressSpec, isOnOpenShift)
 
 	// FQDN takes precedence over Hostname and over the ingress-enabled check.
 	if ingressSpec.FQDN != "" {
@@ -237,14 +256,13 @@ func DetermineEndpointURL(
 			log.Info("Both ingress.fqdn and ingress.hostname are set; using fqdn and ignoring hostname",
 				"fqdn", ingressSpec.FQDN, "hostname", ingressSpec.Hostname)
 		}
-		endpoint := &url.URL{
+		if err := ValidateFQDN(ingressSpec, isOnOpenShift); err != nil {
+			return nil, err
+		}
+		return &url.URL{
 			Scheme: "https",
 			Host:   ingressSpec.FQDN,
-		}
-		if endpoint.Port() != "" && ingressEnabled {
-			return nil, ErrFQDNPortWithManagedIngress
-		}
-		return endpoint, nil
+		}, nil
 	}
 
 	// Short hostname label: compose with cluster ingress domain on OpenShift only.
@@ -842,6 +842,38 @@ func TestDetermineEndpointURL(t *testing.T) {
 	})
 }
 
+func TestValidateFQDN(t *testing.T) {
+	t.Run("allows empty fqdn", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(ValidateFQDN(konfluxv1alpha1.IngressSpec{Enabled: ptr.To(true)}, false)).To(gomega.Succeed())
+	})
+
+	t.Run("allows fqdn without port when ingress is enabled", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		spec := konfluxv1alpha1.IngressSpec{Enabled: ptr.To(true), FQDN: "konflux.example.com"}
+		g.Expect(ValidateFQDN(spec, false)).To(gomega.Succeed())
+	})
+
+	t.Run("allows fqdn with port when ingress is disabled", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		spec := konfluxv1alpha1.IngressSpec{Enabled: ptr.To(false), FQDN: "konflux.example.com:8443"}
+		g.Expect(ValidateFQDN(spec, true)).To(gomega.Succeed())
+	})
+
+	t.Run("rejects fqdn with port when ingress is explicitly enabled", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		spec := konfluxv1alpha1.IngressSpec{Enabled: ptr.To(true), FQDN: "konflux.example.com:8443"}
+		g.Expect(ValidateFQDN(spec, false)).To(gomega.MatchError(ErrFQDNPortWithManagedIngress))
+	})
+
+	t.Run("rejects fqdn with port when ingress is unset on OpenShift", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		spec := konfluxv1alpha1.IngressSpec{FQDN: "konflux.example.com:8443"}
+		g.Expect(ValidateFQDN(spec, true)).To(gomega.MatchError(ErrFQDNPortWithManagedIngress))
+		g.Expect(ValidateFQDN(spec, false)).To(gomega.Succeed())
+	})
+}
+
 func TestConstants(t *testing.T) {
 	t.Run("verifies constant values", func(t *testing.T) {
 		g := gomega.NewWithT(t)
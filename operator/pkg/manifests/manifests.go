@@ -5,16 +5,20 @@ package manifests
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"embed"
 	"fmt"
 	"io"
+	"net/http"
 	"path/filepath"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
 )
@@ -70,6 +74,17 @@ func GetManifest(component Component) ([]byte, error) {
 	return embeddedFS.ReadFile(path)
 }
 
+// UpstreamRevisions returns the upstream repositories and revisions the component's manifests
+// were built from, as "<owner>/<repo>@<ref>" entries. Components built only from local
+// resources have none.
+func UpstreamRevisions(component Component) ([]string, error) {
+	provenance, err := GetProvenance(component)
+	if err != nil {
+		return nil, err
+	}
+	return provenance.UpstreamRevisions(), nil
+}
+
 // GetAllManifests returns a map of component names to their manifest content.
 func GetAllManifests() (map[Component][]byte, error) {
 	manifests := make(map[Component][]byte)
@@ -87,18 +102,57 @@ func GetAllManifests() (map[Component][]byte, error) {
 // It provides methods for retrieving deep copies of objects to prevent
 // mutation of the stored objects during reconciliation.
 type ObjectStore struct {
-	objects map[Component][]client.Object
+	objects    map[Component][]client.Object
+	sources    map[Component]SourceStatus
+	provenance map[Component]Provenance
 }
 
 // NewObjectStore parses all embedded manifests using the provided scheme and
-// returns an ObjectStore containing the parsed objects.
+// returns an ObjectStore containing the parsed objects. Every object is annotated with the
+// provenance of its manifests.
 // Types registered in the scheme are decoded into typed objects (e.g., *appsv1.Deployment).
 // Types not registered in the scheme are decoded as *unstructured.Unstructured.
 func NewObjectStore(scheme *runtime.Scheme) (*ObjectStore, error) {
+	return NewObjectStoreWithSources(context.Background(), scheme, nil, nil)
+}
+
+// NewObjectStoreWithSources is NewObjectStore with the manifests of the components listed in
+// sources loaded from there instead, so that a hotfixed manifest can be shipped without
+// rebuilding the operator. A source that cannot be loaded, fails digest verification or does not
+// parse is logged and the component falls back to its embedded manifests; Source reports which
+// manifests each component uses. OCI artifacts are pulled with httpClient, or
+// http.DefaultClient when it is nil.
+func NewObjectStoreWithSources(
+	ctx context.Context,
+	scheme *runtime.Scheme,
+	sources Sources,
+	httpClient *http.Client,
+) (*ObjectStore, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
 	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
-	objects := make(map[Component][]client.Object)
+	store := &ObjectStore{
+		objects:    make(map[Component][]client.Object),
+		sources:    make(map[Component]SourceStatus),
+		provenance: make(map[Component]Provenance),
+	}
 
 	for _, component := range AllComponents() {
+		status := SourceStatus{Source: Source{Type: SourceEmbedded}}
+		if source, ok := sources[component]; ok {
+			status.Source = source
+			parsed, provenance, err := loadAndParse(ctx, httpClient, decoder, source)
+			if err == nil {
+				logf.FromContext(ctx).Info("Loaded component manifests", "component", component, "source", source.String())
+				store.add(component, parsed, status, provenance)
+				continue
+			}
+			logf.FromContext(ctx).Error(err, "Failed to load component manifests, using the embedded manifests",
+				"component", component, "source", source.String())
+			status.FallbackReason = err.Error()
+		}
+
 		content, err := GetManifest(component)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read manifest for %s: %w", component, err)
@@ -107,10 +161,57 @@ func NewObjectStore(scheme *runtime.Scheme) (*ObjectStore, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse manifest for %s: %w", component, err)
 		}
-		objects[component] = parsed
+		provenance, err := GetProvenance(component)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read provenance for %s: %w", component, err)
+		}
+		store.add(component, parsed, status, provenance)
+	}
+
+	return store, nil
+}
+
+// add stores the objects of component, stamped with their provenance.
+func (s *ObjectStore) add(component Component, objects []client.Object, status SourceStatus, provenance Provenance) {
+	stampProvenance(objects, provenance)
+	s.objects[component] = objects
+	s.sources[component] = status
+	s.provenance[component] = provenance
+}
+
+// loadAndParse loads the manifests of source and parses them. Nothing is known about how
+// they were built, so their provenance only has their content hash.
+func loadAndParse(
+	ctx context.Context,
+	httpClient *http.Client,
+	decoder runtime.Decoder,
+	source Source,
+) ([]client.Object, Provenance, error) {
+	content, err := loadSource(ctx, httpClient, source)
+	if err != nil {
+		return nil, Provenance{}, err
 	}
+	parsed, err := parseManifests(decoder, content)
+	if err != nil {
+		return nil, Provenance{}, err
+	}
+	if len(parsed) == 0 {
+		return nil, Provenance{}, fmt.Errorf("%s holds no objects", source)
+	}
+	return parsed, Provenance{ContentHash: contentDigest(content)}, nil
+}
 
-	return &ObjectStore{objects: objects}, nil
+// Source reports where the manifests of component were loaded from.
+func (s *ObjectStore) Source(component Component) SourceStatus {
+	if status, ok := s.sources[component]; ok {
+		return status
+	}
+	return SourceStatus{Source: Source{Type: SourceEmbedded}}
+}
+
+// Provenance returns the provenance of the manifests the ObjectStore holds for component.
+func (s *ObjectStore) Provenance(component Component) Provenance {
+	return s.provenance[component]
 }
 
 // parseManifests parses YAML content into a slice of client.Object.
@@ -185,6 +286,16 @@ func (s *ObjectStore) GetForComponent(component Component) ([]client.Object, err
 	return deepCopyObjects(objects), nil
 }
 
+// Contains reports whether any object of the component satisfies match. The stored objects
+// are passed to match without copying, so match must not modify them.
+func (s *ObjectStore) Contains(component Component, match func(client.Object) bool) (bool, error) {
+	objects, ok := s.objects[component]
+	if !ok {
+		return false, fmt.Errorf("unknown component: %s", component)
+	}
+	return slices.ContainsFunc(objects, match), nil
+}
+
 // GetCRDNamesForComponent returns the names of CustomResourceDefinitions
 // that are part of the component's manifests. Used to watch CRDs and enqueue
 // the component's CR when a managed CRD is deleted out of band.
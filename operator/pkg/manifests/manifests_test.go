@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestAllComponents(t *testing.T) {
@@ -144,3 +145,56 @@ func TestGetCRDNamesForComponent(t *testing.T) {
 		})
 	}
 }
+
+func TestContains(t *testing.T) {
+	store, err := NewObjectStore(testScheme(t))
+	if err != nil {
+		t.Fatalf("NewObjectStore() error = %v", err)
+	}
+
+	isNamespace := func(name string) func(client.Object) bool {
+		return func(obj client.Object) bool {
+			return obj.GetObjectKind().GroupVersionKind().Kind == "Namespace" && obj.GetName() == name
+		}
+	}
+
+	found, err := store.Contains(BuildService, isNamespace("build-service"))
+	if err != nil || !found {
+		t.Errorf("Contains(BuildService, build-service namespace) = %v, %v; want true, nil", found, err)
+	}
+	found, err = store.Contains(BuildService, isNamespace("release-service"))
+	if err != nil || found {
+		t.Errorf("Contains(BuildService, release-service namespace) = %v, %v; want false, nil", found, err)
+	}
+	if _, err := store.Contains(Component("unknown"), isNamespace("build-service")); err == nil {
+		t.Error("Contains(unknown) expected error")
+	}
+}
+
+func TestUpstreamRevisions(t *testing.T) {
+	for _, component := range AllComponents() {
+		t.Run(string(component), func(t *testing.T) {
+			revisions, err := UpstreamRevisions(component)
+			if err != nil {
+				t.Fatalf("UpstreamRevisions(%s) error = %v", component, err)
+			}
+			for _, rev := range revisions {
+				if !strings.Contains(rev, "/") || !strings.Contains(rev, "@") {
+					t.Errorf("UpstreamRevisions(%s) returned malformed entry %q", component, rev)
+				}
+			}
+		})
+	}
+
+	revisions, err := UpstreamRevisions(BuildService)
+	if err != nil {
+		t.Fatalf("UpstreamRevisions(%s) error = %v", BuildService, err)
+	}
+	if len(revisions) == 0 || !strings.HasPrefix(revisions[0], "konflux-ci/build-service@") {
+		t.Errorf("UpstreamRevisions(%s) = %v, want a konflux-ci/build-service revision", BuildService, revisions)
+	}
+
+	if _, err := UpstreamRevisions(Component("nonexistent")); err == nil {
+		t.Error("UpstreamRevisions(nonexistent) expected an error")
+	}
+}
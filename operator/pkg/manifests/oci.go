@@ -0,0 +1,219 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	// ociTitleAnnotation names the file a layer holds; oras sets it to the pushed file name.
+	ociTitleAnnotation = "org.opencontainers.image.title"
+	// maxOCIManifestSize bounds the OCI manifest of an artifact.
+	maxOCIManifestSize = 4 << 20
+)
+
+// ociManifest is the part of an OCI image manifest needed to find the manifests layer.
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// fetchOCIArtifact pulls the manifests.yaml layer of the OCI artifact of source over the OCI
+// distribution API. Both the artifact manifest and the layer are verified against their
+// digests. Registries that require a token for anonymous pulls are supported; credentials
+// are not, so the artifact must be pullable anonymously, e.g. from a mirror in the cluster.
+func fetchOCIArtifact(ctx context.Context, httpClient *http.Client, source Source) ([]byte, error) {
+	registry, repository, _ := strings.Cut(source.Location, "/")
+	puller := &ociPuller{httpClient: httpClient, registry: registry, repository: repository}
+
+	content, err := puller.get(ctx, "manifests", source.Digest, maxOCIManifestSize,
+		ociManifestMediaType+", "+dockerManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("invalid OCI manifest %s: %w", source, err)
+	}
+	layer, err := manifestsLayer(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	if layer.Size > maxManifestsSize {
+		return nil, fmt.Errorf("%s: layer %s is larger than %d bytes", source, layer.Digest, maxManifestsSize)
+	}
+	if !digestPattern.MatchString(layer.Digest) {
+		return nil, fmt.Errorf("%s: unsupported layer digest %q", source, layer.Digest)
+	}
+	return puller.get(ctx, "blobs", layer.Digest, maxManifestsSize, "")
+}
+
+// manifestsLayer returns the layer titled manifests.yaml, or the only layer of the artifact.
+func manifestsLayer(manifest *ociManifest) (ociDescriptor, error) {
+	for _, layer := range manifest.Layers {
+		if layer.Annotations[ociTitleAnnotation] == manifestsFileName {
+			return layer, nil
+		}
+	}
+	if len(manifest.Layers) == 1 {
+		return manifest.Layers[0], nil
+	}
+	return ociDescriptor{}, fmt.Errorf("artifact has %d layers and none is titled %s", len(manifest.Layers), manifestsFileName)
+}
+
+// ociPuller reads manifests and blobs of one repository, fetching an anonymous bearer token
+// when the registry asks for one.
+type ociPuller struct {
+	httpClient *http.Client
+	registry   string
+	repository string
+	token      string
+}
+
+// get reads the manifest or blob (kind) with the given digest and verifies its content.
+func (p *ociPuller) get(ctx context.Context, kind, digest string, limit int64, accept string) ([]byte, error) {
+	endpoint := fmt.Sprintf("https://%s/v2/%s/%s/%s", p.registry, p.repository, kind, digest)
+	resp, err := p.do(ctx, endpoint, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && p.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		if p.token, err = p.fetchToken(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = p.do(ctx, endpoint, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", endpoint, resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: %w", endpoint, err)
+	}
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("GET %s: larger than %d bytes", endpoint, limit)
+	}
+	if err := verifyDigest(content, digest); err != nil {
+		return nil, fmt.Errorf("GET %s: %w", endpoint, err)
+	}
+	return content, nil
+}
+
+func (p *ociPuller) do(ctx context.Context, endpoint, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	return p.httpClient.Do(req)
+}
+
+// fetchToken gets an anonymous pull token from the realm of a Bearer challenge.
+func (p *ociPuller) fetchToken(ctx context.Context, challenge string) (string, error) {
+	params, ok := parseBearerChallenge(challenge)
+	if !ok || params["realm"] == "" {
+		return "", fmt.Errorf("registry %s requires authentication that is not supported: %q", p.registry, challenge)
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", fmt.Errorf("invalid token realm %q: %w", params["realm"], err)
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", "repository:"+p.repository+":pull")
+	realm.RawQuery = query.Encode()
+
+	resp, err := p.do(ctx, realm.String(), "")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GET %s: %s", realm.Redacted(), resp.Status)
+	}
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return "", fmt.Errorf("invalid token response from %s: %w", realm.Redacted(), err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", fmt.Errorf("token response from %s has no token", realm.Redacted())
+}
+
+// parseBearerChallenge parses a `Bearer realm="...",service="..."` WWW-Authenticate value.
+func parseBearerChallenge(challenge string) (map[string]string, bool) {
+	scheme, rest, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return nil, false
+	}
+	params := map[string]string{}
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			return nil, false
+		}
+		key = strings.TrimSpace(key)
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				return nil, false
+			}
+			params[key] = value[1 : end+1]
+			rest = value[end+2:]
+		} else {
+			value, rest, _ = strings.Cut(value, ",")
+			params[key] = strings.TrimSpace(value)
+		}
+		rest = strings.TrimLeft(rest, ", ")
+	}
+	return params, true
+}
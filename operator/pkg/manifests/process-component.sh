@@ -83,6 +83,9 @@ else
     exit 1
 fi
 set -e
+# provenance.sh reads the previous provenance.json, so write it through a temporary file.
+bash ./provenance.sh "${WORKSPACE_ROOT}" "${COMPONENT}" > "${output_subdir}/provenance.json.tmp"
+mv "${output_subdir}/provenance.json.tmp" "${output_subdir}/provenance.json"
 
 # Extract upstream-derived envtest CRDs for components that use Owns() watches.
 # Must stay in sync with rebuild-upstream-manifests.sh extraction logic.
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Annotations stamped on every object of the ObjectStore, so that an object in the cluster
+// tells which build of the manifests it was applied from.
+const (
+	// UpstreamRevisionsAnnotation lists the "<owner>/<repo>@<ref>" upstream revisions of the
+	// manifests, comma-separated. It is not set on objects of components without upstreams.
+	UpstreamRevisionsAnnotation = "konflux.konflux-ci.dev/upstream-revisions"
+	// ContentHashAnnotation is the sha256 digest of the manifests the object is part of.
+	ContentHashAnnotation = "konflux.konflux-ci.dev/manifests-content-hash"
+	// BuildTimeAnnotation is when the manifests were built, in RFC 3339 format.
+	BuildTimeAnnotation = "konflux.konflux-ci.dev/manifests-build-time"
+)
+
+// provenanceFileName is the file provenance.sh writes next to the manifests.yaml of a component.
+const provenanceFileName = "provenance.json"
+
+// Provenance describes what the manifests of a component were built from.
+type Provenance struct {
+	// KustomizePath is the kustomization, relative to the repository root, that the
+	// manifests were built from. Empty for manifests loaded from a Source.
+	KustomizePath string `json:"kustomizePath,omitempty"`
+	// Upstreams are the upstream resources the kustomization pulls in.
+	Upstreams []Upstream `json:"upstreams,omitempty"`
+	// BuildTime is when the manifests were built. Zero for manifests loaded from a Source.
+	BuildTime time.Time `json:"buildTime,omitzero"`
+	// ContentHash is the sha256 digest of the manifests, "sha256:<hex>".
+	ContentHash string `json:"contentHash"`
+}
+
+// Upstream is a remote resource of a kustomization.
+type Upstream struct {
+	// Repository is the GitHub repository, "<owner>/<repo>".
+	Repository string `json:"repository"`
+	// Path is the directory of the resource in the repository.
+	Path string `json:"path,omitempty"`
+	// Revision is the git ref the resource is pinned to.
+	Revision string `json:"revision"`
+}
+
+// String returns the upstream as "<owner>/<repo>@<ref>".
+func (u Upstream) String() string {
+	return u.Repository + "@" + u.Revision
+}
+
+// UpstreamRevisions returns the distinct "<owner>/<repo>@<ref>" revisions of the upstreams,
+// sorted.
+func (p Provenance) UpstreamRevisions() []string {
+	revisions := make([]string, 0, len(p.Upstreams))
+	for _, upstream := range p.Upstreams {
+		revisions = append(revisions, upstream.String())
+	}
+	slices.Sort(revisions)
+	return slices.Compact(revisions)
+}
+
+// Annotations returns the annotations stamped on the objects of the manifests.
+func (p Provenance) Annotations() map[string]string {
+	annotations := map[string]string{ContentHashAnnotation: p.ContentHash}
+	if revisions := p.UpstreamRevisions(); len(revisions) > 0 {
+		annotations[UpstreamRevisionsAnnotation] = strings.Join(revisions, ",")
+	}
+	if !p.BuildTime.IsZero() {
+		annotations[BuildTimeAnnotation] = p.BuildTime.UTC().Format(time.RFC3339)
+	}
+	return annotations
+}
+
+// GetProvenance returns the provenance of the embedded manifests of component, as recorded
+// by provenance.sh when they were built.
+func GetProvenance(component Component) (Provenance, error) {
+	content, err := embeddedFS.ReadFile(filepath.Join(string(component), provenanceFileName))
+	if err != nil {
+		return Provenance{}, err
+	}
+	provenance := Provenance{}
+	if err := json.Unmarshal(content, &provenance); err != nil {
+		return Provenance{}, fmt.Errorf("invalid provenance of %s: %w", component, err)
+	}
+	return provenance, nil
+}
+
+// stampProvenance sets the annotations of provenance on every object.
+func stampProvenance(objects []client.Object, provenance Provenance) {
+	annotations := provenance.Annotations()
+	for _, obj := range objects {
+		merged := obj.GetAnnotations()
+		if merged == nil {
+			merged = make(map[string]string, len(annotations))
+		}
+		for key, value := range annotations {
+			merged[key] = value
+		}
+		obj.SetAnnotations(merged)
+	}
+}
@@ -0,0 +1,55 @@
+#!/usr/bin/env bash
+# Print the provenance of a component's built manifests.yaml as JSON: the kustomization
+# it is built from, the upstream repositories, paths and revisions the kustomization pulls
+# remote resources from, the build time and the sha256 digest of manifests.yaml. The
+# output is embedded next to manifests.yaml as provenance.json, printed by
+# `dump-manifests` and reported in the component CR status.
+#
+# The build time is taken from SOURCE_DATE_EPOCH when it is set. When nothing but the
+# build time differs from the committed provenance.json, its build time is kept, so that
+# rebuilding unchanged manifests does not change the tree.
+#
+# Usage: provenance.sh <workspace-root> <component>
+# Requires: jq on PATH.
+set -euo pipefail
+
+WORKSPACE_ROOT="${1:-}"
+COMPONENT="${2:-}"
+if [[ -z "${WORKSPACE_ROOT}" || -z "${COMPONENT}" ]]; then
+  echo "Usage: $0 <workspace-root> <component>" >&2
+  exit 1
+fi
+
+KUSTOMIZE_PATH="operator/upstream-kustomizations/${COMPONENT}"
+MANIFESTS="${WORKSPACE_ROOT}/operator/pkg/manifests/${COMPONENT}/manifests.yaml"
+PREVIOUS="${WORKSPACE_ROOT}/operator/pkg/manifests/${COMPONENT}/provenance.json"
+if [[ ! -d "${WORKSPACE_ROOT}/${KUSTOMIZE_PATH}" || ! -f "${MANIFESTS}" ]]; then
+  echo "Error: ${KUSTOMIZE_PATH} or the built manifests of ${COMPONENT} do not exist" >&2
+  exit 1
+fi
+
+BUILD_EPOCH="${SOURCE_DATE_EPOCH:-$(date +%s)}"
+BUILD_TIME="$(date -u -d "@${BUILD_EPOCH}" +%Y-%m-%dT%H:%M:%SZ 2>/dev/null ||
+  date -u -r "${BUILD_EPOCH}" +%Y-%m-%dT%H:%M:%SZ)"
+CONTENT_HASH="sha256:$({ sha256sum "${MANIFESTS}" 2>/dev/null || shasum -a 256 "${MANIFESTS}"; } | cut -d' ' -f1)"
+
+# One "<owner>/<repo><TAB><path><TAB><ref>" line per remote resource, sorted.
+provenance="$(
+  { grep -rhoE 'github\.com/[^/[:space:]]+/[^/?[:space:]]+[^?[:space:]]*\?ref=[^&[:space:]"'"'"']+' \
+      --include='*.yaml' --include='*.yml' "${WORKSPACE_ROOT}/${KUSTOMIZE_PATH}" || true; } |
+    sed -E 's#^github\.com/([^/]+)/([^/?]+)/*([^?]*)\?ref=(.*)$#\1/\2\t\3\t\4#' |
+    sort -u |
+    jq -Rn --arg kustomizePath "${KUSTOMIZE_PATH}" --arg buildTime "${BUILD_TIME}" \
+      --arg contentHash "${CONTENT_HASH}" '{
+      kustomizePath: $kustomizePath,
+      upstreams: [inputs | split("\t") | {repository: .[0], path: .[1], revision: .[2]}],
+      buildTime: $buildTime,
+      contentHash: $contentHash
+    }'
+)"
+
+if [[ -f "${PREVIOUS}" ]] &&
+  [[ "$(jq -c 'del(.buildTime)' "${PREVIOUS}")" == "$(jq -c 'del(.buildTime)' <<<"${provenance}")" ]]; then
+  provenance="$(jq --arg buildTime "$(jq -r .buildTime "${PREVIOUS}")" '.buildTime = $buildTime' <<<"${provenance}")"
+fi
+printf '%s\n' "${provenance}"
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestGetProvenance(t *testing.T) {
+	for _, component := range AllComponents() {
+		t.Run(string(component), func(t *testing.T) {
+			provenance, err := GetProvenance(component)
+			if err != nil {
+				t.Fatalf("GetProvenance(%s) error = %v", component, err)
+			}
+			if want := "operator/upstream-kustomizations/" + string(component); provenance.KustomizePath != want {
+				t.Errorf("KustomizePath = %q, want %q", provenance.KustomizePath, want)
+			}
+			if provenance.BuildTime.IsZero() {
+				t.Error("BuildTime is not set")
+			}
+			// provenance.sh must be rerun whenever manifests.yaml changes.
+			content, err := GetManifest(component)
+			if err != nil {
+				t.Fatalf("GetManifest(%s) error = %v", component, err)
+			}
+			if want := contentDigest(content); provenance.ContentHash != want {
+				t.Errorf("ContentHash = %s, want %s; rerun provenance.sh", provenance.ContentHash, want)
+			}
+			for _, upstream := range provenance.Upstreams {
+				if upstream.Repository == "" || upstream.Revision == "" {
+					t.Errorf("malformed upstream %+v", upstream)
+				}
+			}
+		})
+	}
+
+	if _, err := GetProvenance(Component("nonexistent")); err == nil {
+		t.Error("GetProvenance(nonexistent) expected an error")
+	}
+}
+
+func TestProvenanceAnnotations(t *testing.T) {
+	provenance := Provenance{
+		Upstreams: []Upstream{
+			{Repository: "konflux-ci/integration-service", Path: "config/snapshotgc", Revision: "abc"},
+			{Repository: "konflux-ci/integration-service", Path: "config/default", Revision: "abc"},
+			{Repository: "conforma/crds", Path: "config/crd", Revision: "def"},
+		},
+		BuildTime:   time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+		ContentHash: "sha256:0123",
+	}
+
+	if got, want := provenance.UpstreamRevisions(), []string{"conforma/crds@def", "konflux-ci/integration-service@abc"}; !slices.Equal(got, want) {
+		t.Errorf("UpstreamRevisions() = %v, want %v", got, want)
+	}
+	annotations := provenance.Annotations()
+	if got := annotations[UpstreamRevisionsAnnotation]; got != "conforma/crds@def,konflux-ci/integration-service@abc" {
+		t.Errorf("%s = %q", UpstreamRevisionsAnnotation, got)
+	}
+	if got := annotations[BuildTimeAnnotation]; got != "2025-06-01T12:00:00Z" {
+		t.Errorf("%s = %q", BuildTimeAnnotation, got)
+	}
+	if got := annotations[ContentHashAnnotation]; got != "sha256:0123" {
+		t.Errorf("%s = %q", ContentHashAnnotation, got)
+	}
+
+	// Manifests loaded from a source only know their content hash.
+	annotations = Provenance{ContentHash: "sha256:0123"}.Annotations()
+	if len(annotations) != 1 || annotations[ContentHashAnnotation] != "sha256:0123" {
+		t.Errorf("Annotations() = %v, want only the content hash", annotations)
+	}
+}
+
+func TestObjectStoreStampsProvenance(t *testing.T) {
+	dir := t.TempDir()
+	content := []byte(hotfixManifests)
+	if err := os.WriteFile(filepath.Join(dir, manifestsFileName), content, 0o600); err != nil {
+		t.Fatalf("failed to write manifests: %v", err)
+	}
+	store, err := NewObjectStoreWithSources(context.Background(), newSourceScheme(t), Sources{
+		CLI: {Type: SourceDirectory, Location: dir, Digest: contentDigest(content)},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	embedded, err := GetProvenance(BuildService)
+	if err != nil {
+		t.Fatalf("GetProvenance(%s) error = %v", BuildService, err)
+	}
+	if got := store.Provenance(BuildService); got.ContentHash != embedded.ContentHash {
+		t.Errorf("Provenance(%s) = %+v, want %+v", BuildService, got, embedded)
+	}
+	objects, err := store.GetForComponent(BuildService)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, obj := range objects {
+		for key, value := range embedded.Annotations() {
+			if obj.GetAnnotations()[key] != value {
+				t.Fatalf("%s %s: annotation %s = %q, want %q",
+					obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), key, obj.GetAnnotations()[key], value)
+			}
+		}
+	}
+
+	if got := store.Provenance(CLI); got.ContentHash != contentDigest(content) || got.KustomizePath != "" {
+		t.Errorf("Provenance(%s) = %+v, want only the content hash of the source", CLI, got)
+	}
+	objects, err = store.GetForComponent(CLI)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := objects[0].GetAnnotations()[ContentHashAnnotation]; got != contentDigest(content) {
+		t.Errorf("%s = %q, want the digest of the source", ContentHashAnnotation, got)
+	}
+}
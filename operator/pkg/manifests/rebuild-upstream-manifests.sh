@@ -5,7 +5,7 @@
 # Usage:
 #   rebuild-upstream-manifests.sh <workspace-root>
 #
-# Requires: kustomize on PATH.
+# Requires: kustomize, yq and jq on PATH.
 set -euo pipefail
 
 WORKSPACE_ROOT="${1:-}"
@@ -31,6 +31,10 @@ for dir in "${component_dirs[@]}"; do
   echo "kustomize build -> operator/pkg/manifests/${component}/manifests.yaml"
   kustomize build "${WORKSPACE_ROOT}/operator/upstream-kustomizations/${component}" \
     > "${out_dir}/manifests.yaml"
+  # provenance.sh reads the previous provenance.json, so write it through a temporary file.
+  bash "${WORKSPACE_ROOT}/operator/pkg/manifests/provenance.sh" "${WORKSPACE_ROOT}" "${component}" \
+    > "${out_dir}/provenance.json.tmp"
+  mv "${out_dir}/provenance.json.tmp" "${out_dir}/provenance.json"
 done
 
 # Extract CRDs from rendered manifests into test/crds/ for envtest.
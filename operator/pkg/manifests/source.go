@@ -0,0 +1,214 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SourceType is where the manifests of a component are loaded from.
+type SourceType string
+
+const (
+	// SourceEmbedded is the manifests embedded in the operator binary.
+	SourceEmbedded SourceType = "Embedded"
+	// SourceDirectory is a manifests.yaml file in a directory, such as a mounted ConfigMap.
+	SourceDirectory SourceType = "Directory"
+	// SourceOCI is an OCI artifact whose layer is a manifests.yaml file.
+	SourceOCI SourceType = "OCI"
+)
+
+const (
+	// manifestsFileName is the name of the manifests file of a component, embedded or in a
+	// directory source, and the title of the layer of an OCI source.
+	manifestsFileName = "manifests.yaml"
+	// maxManifestsSize bounds the manifests loaded from a source.
+	maxManifestsSize = 16 << 20
+	// sourceLoadTimeout bounds loading a single source.
+	sourceLoadTimeout = 30 * time.Second
+)
+
+var digestPattern = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// Source locates the manifests of a component outside the operator binary. Every source is
+// pinned by the sha256 digest of its content, so that the manifests cannot change unnoticed.
+type Source struct {
+	Type SourceType
+	// Location is the directory that holds manifests.yaml, or the OCI repository
+	// ("<registry>/<repository>") of the artifact.
+	Location string
+	// Digest is "sha256:<hex>": for a directory, the digest of manifests.yaml; for an OCI
+	// artifact, the digest of its manifest.
+	Digest string
+}
+
+// ParseSource parses a source: "dir:<path>@sha256:<hex>" or "oci:<registry>/<repository>@sha256:<hex>".
+func ParseSource(value string) (Source, error) {
+	kind, rest, ok := strings.Cut(value, ":")
+	if !ok {
+		return Source{}, fmt.Errorf("invalid manifest source %q: expected dir:<path>@sha256:<hex> or oci:<registry>/<repository>@sha256:<hex>", value)
+	}
+	at := strings.LastIndex(rest, "@")
+	if at < 0 {
+		return Source{}, fmt.Errorf("invalid manifest source %q: it must be pinned by digest (@sha256:<hex>)", value)
+	}
+	source := Source{Location: rest[:at], Digest: rest[at+1:]}
+	if !digestPattern.MatchString(source.Digest) {
+		return Source{}, fmt.Errorf("invalid manifest source %q: digest must be sha256:<64 hex characters>", value)
+	}
+
+	switch kind {
+	case "dir":
+		source.Type = SourceDirectory
+		if !filepath.IsAbs(source.Location) {
+			return Source{}, fmt.Errorf("invalid manifest source %q: directory must be an absolute path", value)
+		}
+	case "oci":
+		source.Type = SourceOCI
+		registry, repository, ok := strings.Cut(source.Location, "/")
+		if !ok || registry == "" || repository == "" {
+			return Source{}, fmt.Errorf("invalid manifest source %q: expected oci:<registry>/<repository>@sha256:<hex>", value)
+		}
+	default:
+		return Source{}, fmt.Errorf("invalid manifest source %q: unknown type %q, expected dir or oci", value, kind)
+	}
+	return source, nil
+}
+
+// String returns the source in the form ParseSource accepts, or "embedded".
+func (s Source) String() string {
+	switch s.Type {
+	case SourceDirectory:
+		return "dir:" + s.Location + "@" + s.Digest
+	case SourceOCI:
+		return "oci:" + s.Location + "@" + s.Digest
+	default:
+		return "embedded"
+	}
+}
+
+// Sources maps components to the source of their manifests. Components it does not list use
+// their embedded manifests. It implements flag.Value for a repeatable
+// "--manifest-source=<component>=<source>" flag.
+type Sources map[Component]Source
+
+// String implements flag.Value.
+func (s Sources) String() string {
+	entries := make([]string, 0, len(s))
+	for component, source := range s {
+		entries = append(entries, string(component)+"="+source.String())
+	}
+	slices.Sort(entries)
+	return strings.Join(entries, ",")
+}
+
+// Set implements flag.Value; it parses a "<component>=<source>" entry.
+func (s Sources) Set(value string) error {
+	name, raw, ok := strings.Cut(value, "=")
+	if !ok {
+		return fmt.Errorf("invalid manifest source %q: expected <component>=<source>", value)
+	}
+	component := Component(name)
+	if !slices.Contains(AllComponents(), component) {
+		return fmt.Errorf("invalid manifest source %q: unknown component %q", value, name)
+	}
+	if _, exists := s[component]; exists {
+		return fmt.Errorf("invalid manifest source %q: component %q has more than one source", value, name)
+	}
+	source, err := ParseSource(raw)
+	if err != nil {
+		return err
+	}
+	s[component] = source
+	return nil
+}
+
+// SourceStatus reports where the manifests of a component were loaded from.
+type SourceStatus struct {
+	// Source is the configured source; its Type is SourceEmbedded when none is configured.
+	Source
+	// FallbackReason is set when the configured source could not be loaded, in which case the
+	// embedded manifests are used instead.
+	FallbackReason string
+}
+
+// Active returns the source the manifests were loaded from.
+func (s SourceStatus) Active() Source {
+	if s.FallbackReason != "" {
+		return Source{Type: SourceEmbedded}
+	}
+	return s.Source
+}
+
+// loadSource returns the content of source, verified against its digest.
+func loadSource(ctx context.Context, httpClient *http.Client, source Source) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, sourceLoadTimeout)
+	defer cancel()
+
+	switch source.Type {
+	case SourceDirectory:
+		return loadDirectory(source)
+	case SourceOCI:
+		return fetchOCIArtifact(ctx, httpClient, source)
+	default:
+		return nil, fmt.Errorf("unsupported manifest source type %q", source.Type)
+	}
+}
+
+// loadDirectory reads manifests.yaml from the directory of source and verifies its digest.
+func loadDirectory(source Source) ([]byte, error) {
+	path := filepath.Join(source.Location, manifestsFileName)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxManifestsSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", path, maxManifestsSize)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyDigest(content, source.Digest); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return content, nil
+}
+
+// verifyDigest checks that content has the sha256 digest want.
+func verifyDigest(content []byte, want string) error {
+	if got := contentDigest(content); got != want {
+		return fmt.Errorf("digest mismatch: got %s, want %s", got, want)
+	}
+	return nil
+}
+
+// contentDigest returns the sha256 digest of content, "sha256:<hex>".
+func contentDigest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+const hotfixManifests = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: hotfix
+  namespace: konflux-cli
+`
+
+func newSourceScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	return scheme
+}
+
+func TestParseSource(t *testing.T) {
+	digest := contentDigest([]byte("x"))
+	tests := []struct {
+		value   string
+		want    Source
+		wantErr string
+	}{
+		{
+			value: "dir:/etc/konflux/cli@" + digest,
+			want:  Source{Type: SourceDirectory, Location: "/etc/konflux/cli", Digest: digest},
+		},
+		{
+			value: "oci:registry.example.com:5000/konflux/cli@" + digest,
+			want:  Source{Type: SourceOCI, Location: "registry.example.com:5000/konflux/cli", Digest: digest},
+		},
+		{value: "dir:/etc/konflux/cli", wantErr: "pinned by digest"},
+		{value: "dir:relative@" + digest, wantErr: "absolute path"},
+		{value: "oci:registry.example.com@" + digest, wantErr: "expected oci:"},
+		{value: "oci:registry.example.com/cli@sha256:abc", wantErr: "digest must be"},
+		{value: "http://example.com@" + digest, wantErr: "unknown type"},
+		{value: "embedded", wantErr: "expected dir:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSource(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseSource(%q) error = %v, want error containing %q", tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSource(%q) unexpected error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSource(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+			if got.String() != tt.value {
+				t.Errorf("String() = %q, want %q", got.String(), tt.value)
+			}
+		})
+	}
+}
+
+func TestSourcesSet(t *testing.T) {
+	digest := contentDigest([]byte("x"))
+	sources := Sources{}
+
+	if err := sources.Set("cli=dir:/etc/konflux/cli@" + digest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sources[CLI].Type != SourceDirectory {
+		t.Errorf("expected a directory source for cli, got %+v", sources[CLI])
+	}
+	if err := sources.Set("cli=dir:/etc/konflux/other@" + digest); err == nil {
+		t.Error("expected an error for a second source of the same component")
+	}
+	if err := sources.Set("nonexistent=dir:/etc/konflux@" + digest); err == nil {
+		t.Error("expected an error for an unknown component")
+	}
+	if err := sources.Set("dir:/etc/konflux@" + digest); err == nil {
+		t.Error("expected an error for an entry without a component")
+	}
+	if got, want := sources.String(), "cli=dir:/etc/konflux/cli@"+digest; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestNewObjectStoreWithSources_Directory(t *testing.T) {
+	dir := t.TempDir()
+	content := []byte(hotfixManifests)
+	if err := os.WriteFile(filepath.Join(dir, manifestsFileName), content, 0o600); err != nil {
+		t.Fatalf("failed to write manifests: %v", err)
+	}
+
+	store, err := NewObjectStoreWithSources(context.Background(), newSourceScheme(t), Sources{
+		CLI: {Type: SourceDirectory, Location: dir, Digest: contentDigest(content)},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	objects, err := store.GetForComponent(CLI)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(objects) != 1 || objects[0].GetName() != "hotfix" {
+		t.Errorf("expected the hotfix ConfigMap from the directory, got %d objects", len(objects))
+	}
+	status := store.Source(CLI)
+	if status.FallbackReason != "" || status.Active().Type != SourceDirectory {
+		t.Errorf("expected the directory source to be active, got %+v", status)
+	}
+	if store.Source(UI).Type != SourceEmbedded {
+		t.Errorf("expected components without a source to use the embedded manifests, got %+v", store.Source(UI))
+	}
+}
+
+func TestNewObjectStoreWithSources_FallsBackOnDigestMismatch(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, manifestsFileName), []byte(hotfixManifests), 0o600); err != nil {
+		t.Fatalf("failed to write manifests: %v", err)
+	}
+
+	store, err := NewObjectStoreWithSources(context.Background(), newSourceScheme(t), Sources{
+		CLI: {Type: SourceDirectory, Location: dir, Digest: contentDigest([]byte("something else"))},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	status := store.Source(CLI)
+	if !strings.Contains(status.FallbackReason, "digest mismatch") {
+		t.Errorf("expected a digest mismatch fallback reason, got %q", status.FallbackReason)
+	}
+	if status.Active().Type != SourceEmbedded {
+		t.Errorf("expected the embedded manifests to be active, got %+v", status.Active())
+	}
+	objects, err := store.GetForComponent(CLI)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, obj := range objects {
+		if obj.GetName() == "hotfix" {
+			t.Fatal("expected the embedded manifests, got the unverified ones")
+		}
+	}
+}
+
+// newRegistry serves content as the single layer of an OCI artifact in repository konflux/cli,
+// requiring an anonymous bearer token like public registries do. It returns the server and the
+// digest of the artifact manifest.
+func newRegistry(t *testing.T, content []byte) (*httptest.Server, string) {
+	t.Helper()
+	layerDigest := contentDigest(content)
+	manifest, err := json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     ociManifestMediaType,
+		"layers": []ociDescriptor{{
+			MediaType:   "application/yaml",
+			Digest:      layerDigest,
+			Size:        int64(len(content)),
+			Annotations: map[string]string{ociTitleAnnotation: manifestsFileName},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal manifest: %v", err)
+	}
+	manifestDigest := contentDigest(manifest)
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if r.URL.Query().Get("scope") != "repository:konflux/cli:pull" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"anonymous"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer anonymous" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/konflux/cli/manifests/" + manifestDigest:
+			w.Header().Set("Content-Type", ociManifestMediaType)
+			_, _ = w.Write(manifest)
+		case "/v2/konflux/cli/blobs/" + layerDigest:
+			_, _ = w.Write(content)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, manifestDigest
+}
+
+func TestNewObjectStoreWithSources_OCI(t *testing.T) {
+	server, digest := newRegistry(t, []byte(hotfixManifests))
+	source := Source{
+		Type:     SourceOCI,
+		Location: strings.TrimPrefix(server.URL, "https://") + "/konflux/cli",
+		Digest:   digest,
+	}
+
+	store, err := NewObjectStoreWithSources(context.Background(), newSourceScheme(t), Sources{CLI: source}, server.Client())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status := store.Source(CLI); status.FallbackReason != "" {
+		t.Fatalf("expected the OCI source to load, got fallback reason %q", status.FallbackReason)
+	}
+	objects, err := store.GetForComponent(CLI)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(objects) != 1 || objects[0].GetName() != "hotfix" {
+		t.Errorf("expected the hotfix ConfigMap from the artifact, got %d objects", len(objects))
+	}
+}
+
+func TestNewObjectStoreWithSources_OCIUnknownDigest(t *testing.T) {
+	server, _ := newRegistry(t, []byte(hotfixManifests))
+	source := Source{
+		Type:     SourceOCI,
+		Location: strings.TrimPrefix(server.URL, "https://") + "/konflux/cli",
+		Digest:   contentDigest([]byte("unknown")),
+	}
+
+	store, err := NewObjectStoreWithSources(context.Background(), newSourceScheme(t), Sources{CLI: source}, server.Client())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status := store.Source(CLI); !strings.Contains(status.FallbackReason, "404") {
+		t.Errorf("expected a not found fallback reason, got %q", status.FallbackReason)
+	}
+}
+
+func TestParseBearerChallenge(t *testing.T) {
+	params, ok := parseBearerChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:a:pull"`)
+	if !ok {
+		t.Fatal("expected the challenge to parse")
+	}
+	if params["realm"] != "https://auth.example.com/token" || params["service"] != "registry.example.com" {
+		t.Errorf("unexpected params: %v", params)
+	}
+	if _, ok := parseBearerChallenge(`Basic realm="registry"`); ok {
+		t.Error("expected a Basic challenge to be rejected")
+	}
+}
@@ -15,6 +15,8 @@ import (
 
 	"sigs.k8s.io/yaml"
 	yamlv3 "sigs.k8s.io/yaml/goyaml.v3"
+
+	"github.com/konflux-ci/konflux-ci/operator/pkg/imageref"
 )
 
 type Overrides []ComponentOverride
@@ -407,7 +409,7 @@ func (r *Runner) applyImageOverridesInKustomizations(upstreamDir string) error {
 		}
 		changed := false
 		for _, ov := range overrides {
-			newName, newTag, newDigest := parseImageReference(ov.Replacement)
+			newName, newTag, newDigest := imageref.Parse(ov.Replacement)
 			for _, iv := range images {
 				im, ok := iv.(map[string]any)
 				if !ok {
@@ -617,7 +619,7 @@ func validateOverrides(overrides Overrides) error {
 			if strings.TrimSpace(img.Orig) == "" || strings.TrimSpace(img.Replacement) == "" {
 				return fmt.Errorf("entry %d (%s) images[%d]: orig/replacement are required", i, c.Name, j)
 			}
-			repName, _, _ := parseImageReference(img.Replacement)
+			repName, _, _ := imageref.Parse(img.Replacement)
 			if strings.TrimSpace(repName) == "" {
 				return fmt.Errorf(
 					"entry %d (%s) images[%d]: replacement must be a valid image reference (non-empty name)",
@@ -690,37 +692,10 @@ func firstMatchingRule(orgRepo string, rules []GitRule) *GitRule {
 	return nil
 }
 
-// parseImageReference splits a container image reference into kustomize newName and either
-// newTag or digest (OCI image digest: algorithm + hex after first ':').
-func parseImageReference(s string) (name, tag, digest string) {
-	s = strings.TrimSpace(s)
-	if at := strings.Index(s, "@"); at >= 0 {
-		name, rest := s[:at], s[at+1:]
-		if isOCIImageDigest(rest) {
-			return name, "", rest
-		}
-		return name, rest, ""
-	}
-	if i := strings.LastIndex(s, ":"); i > strings.LastIndex(s, "/") {
-		return s[:i], s[i+1:], ""
-	}
-	return s, "latest", ""
-}
-
-func isOCIImageDigest(s string) bool {
-	// OCI manifest digest: sha256:<64 hex> or sha512:<128 hex>, etc.
-	for _, prefix := range []string{"sha256:", "sha512:", "sha384:"} {
-		if strings.HasPrefix(s, prefix) {
-			return true
-		}
-	}
-	return false
-}
-
 // splitImageReference returns (name, tag) for callers that only distinguish tag vs bare name;
 // when the reference uses a digest, tag is empty (digest is not a kustomize newTag).
 func splitImageReference(outputImage string) (name, tag string) {
-	n, t, d := parseImageReference(outputImage)
+	n, t, d := imageref.Parse(outputImage)
 	if d != "" {
 		return n, ""
 	}
@@ -734,7 +709,7 @@ func imageRefMatchesOrgRepo(imageRef, orgRepo string) bool {
 	if orgRepo == "" || !strings.Contains(orgRepo, "/") {
 		return false
 	}
-	base, _, _ := parseImageReference(strings.TrimSpace(imageRef))
+	base, _, _ := imageref.Parse(strings.TrimSpace(imageRef))
 	base = strings.ToLower(base)
 	return strings.HasSuffix(base, "/"+orgRepo)
 }
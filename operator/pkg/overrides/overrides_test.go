@@ -736,61 +736,6 @@ spec:
 	g.Expect(string(got)).ToNot(ContainSubstring(oldImg))
 }
 
-func TestParseImageReference(t *testing.T) {
-	t.Parallel()
-
-	cases := []struct {
-		name       string
-		input      string
-		wantName   string
-		wantTag    string
-		wantDigest string
-	}{
-		{
-			name:       "digest",
-			input:      "quay.io/org/app@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
-			wantName:   "quay.io/org/app",
-			wantTag:    "",
-			wantDigest: "sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
-		},
-		{
-			name:       "tag then digest uses digest branch",
-			input:      "quay.io/org/app:mytag@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
-			wantName:   "quay.io/org/app:mytag",
-			wantTag:    "",
-			wantDigest: "sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
-		},
-		{
-			name:     "tag only",
-			input:    "registry:5000/ns/img:v1.2",
-			wantName: "registry:5000/ns/img",
-			wantTag:  "v1.2",
-		},
-		{
-			name:     "at without oci digest becomes tag",
-			input:    "quay.io/org/app@edge",
-			wantName: "quay.io/org/app",
-			wantTag:  "edge",
-		},
-		{
-			name:     "bare name defaults latest",
-			input:    "quay.io/org/app",
-			wantName: "quay.io/org/app",
-			wantTag:  "latest",
-		},
-	}
-	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-			gotName, gotTag, gotDigest := parseImageReference(tc.input)
-			if gotName != tc.wantName || gotTag != tc.wantTag || gotDigest != tc.wantDigest {
-				t.Fatalf("parseImageReference(%q) = (%q, %q, %q), want (%q, %q, %q)",
-					tc.input, gotName, gotTag, gotDigest, tc.wantName, tc.wantTag, tc.wantDigest)
-			}
-		})
-	}
-}
-
 func TestNormalizeOrgRepo(t *testing.T) {
 	t.Parallel()
 
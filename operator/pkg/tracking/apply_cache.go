@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultApplyCacheMaxAge is how long an ApplyCache entry lets ApplyOwned skip an object
+// before it is applied again regardless.
+const DefaultApplyCacheMaxAge = 10 * time.Minute
+
+// ApplyCache remembers, across reconciles, the content hash of every object ApplyOwned applied
+// and the resourceVersion the API server returned for it. ApplyOwned skips the server-side apply
+// of an object whose desired state hashes the same and whose live resourceVersion is still the
+// one it applied: nobody changed the object since, so the apply would be a no-op. Drift detection
+// is skipped as well, since an unchanged resourceVersion rules out drift.
+//
+// The live resourceVersion is read through LiveMetadata, from an informer that may lag behind
+// the API server. A lagging informer still shows an older resourceVersion after an apply, so
+// the object is applied again, which is harmless. Objects whose status changes often, such as
+// Deployments, get a new resourceVersion with every status update and are applied as usual.
+// Every entry expires after maxAge, so that each object is applied again periodically even if
+// a stale read hid a change.
+//
+// Create one ApplyCache per reconciler and set it on every tracking client the reconciler
+// creates through OwnershipConfig.ApplyCache. It is safe for concurrent use.
+type ApplyCache struct {
+	live   *LiveMetadata
+	maxAge time.Duration
+
+	mu      sync.Mutex
+	entries map[applyCacheKey]applyCacheEntry
+}
+
+type applyCacheKey struct {
+	ResourceKey
+	fieldManager string
+}
+
+type applyCacheEntry struct {
+	hash            string
+	resourceVersion string
+	appliedAt       time.Time
+}
+
+// NewApplyCache returns an empty ApplyCache that reads live resourceVersions from live.
+func NewApplyCache(live *LiveMetadata, maxAge time.Duration) *ApplyCache {
+	return &ApplyCache{
+		live:    live,
+		maxAge:  maxAge,
+		entries: make(map[applyCacheKey]applyCacheEntry),
+	}
+}
+
+// Len returns the number of objects the cache remembers.
+func (a *ApplyCache) Len() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return len(a.entries)
+}
+
+// unchanged reports whether obj, whose desired state hashes to hash, was applied with the same
+// content and has not changed on the server since.
+func (a *ApplyCache) unchanged(ctx context.Context, key applyCacheKey, hash string) bool {
+	a.mu.Lock()
+	entry, ok := a.entries[key]
+	a.mu.Unlock()
+	if !ok || entry.hash != hash || time.Since(entry.appliedAt) > a.maxAge {
+		return false
+	}
+
+	live, err := a.live.get(ctx, key.ResourceKey)
+	if err != nil {
+		// Missing or unreadable: apply it.
+		return false
+	}
+	return live.GetResourceVersion() == entry.resourceVersion
+}
+
+// remember records that obj was applied with the content hashing to hash.
+func (a *ApplyCache) remember(key applyCacheKey, hash, resourceVersion string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.entries[key] = applyCacheEntry{hash: hash, resourceVersion: resourceVersion, appliedAt: time.Now()}
+}
+
+// forget drops the entry of an object whose apply failed.
+func (a *ApplyCache) forget(key applyCacheKey) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.entries, key)
+}
+
+// hashObject returns the hash of the desired state of obj.
+func hashObject(obj client.Object) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
+)
+
+// DefaultMaxConcurrentApplies is how many objects ApplyOwnedBatch applies at a time unless
+// OwnershipConfig.MaxConcurrentApplies says otherwise.
+const DefaultMaxConcurrentApplies = 8
+
+// applyPhase orders the objects of a batch. Objects of a phase are applied concurrently, and
+// only once every object of the previous phases was applied.
+type applyPhase int
+
+const (
+	// phaseDefinitions holds what other objects need to exist first: Namespaces for the
+	// namespaced objects and CustomResourceDefinitions for the custom resources.
+	phaseDefinitions applyPhase = iota
+	// phaseResources holds every other object.
+	phaseResources
+	// phaseAdmission holds admission webhook configurations, which are applied last so that
+	// the webhooks do not intercept the objects of the batch before their backends are applied.
+	phaseAdmission
+	applyPhases
+)
+
+// ApplyOwnedBatch applies objs like ApplyOwned, up to OwnershipConfig.MaxConcurrentApplies at a
+// time. Namespaces and CustomResourceDefinitions are applied before the objects that may need
+// them, and admission webhook configurations after everything else. Within these phases,
+// objects are started in the order of objs.
+//
+// It stops at the first error and returns it, naming the object that failed. Objects applied
+// before that stay applied and tracked, like with a sequence of ApplyOwned calls.
+func (c *Client) ApplyOwnedBatch(ctx context.Context, objs []client.Object) error {
+	if c.ownership == nil {
+		return fmt.Errorf("ApplyOwnedBatch called but client was not created with ownership config; use NewClientWithOwnership")
+	}
+	limit := c.ownership.MaxConcurrentApplies
+	if limit <= 0 {
+		limit = DefaultMaxConcurrentApplies
+	}
+
+	var phases [applyPhases][]client.Object
+	for _, obj := range objs {
+		phase := c.applyPhaseOf(obj)
+		phases[phase] = append(phases[phase], obj)
+	}
+
+	for _, phase := range phases {
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(limit)
+		for _, obj := range phase {
+			g.Go(func() error {
+				if err := c.ApplyOwned(gctx, obj); err != nil {
+					return fmt.Errorf("failed to apply object %s/%s (%s): %w",
+						obj.GetNamespace(), obj.GetName(), GetKind(obj), err)
+				}
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyPhaseOf returns the phase in which ApplyOwnedBatch applies obj.
+func (c *Client) applyPhaseOf(obj client.Object) applyPhase {
+	if kubernetes.IsCustomResourceDefinition(obj) {
+		return phaseDefinitions
+	}
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		// ApplyOwned reports the error.
+		return phaseResources
+	}
+	switch {
+	case gvk.Group == "" && gvk.Kind == "Namespace":
+		return phaseDefinitions
+	case gvk.Group == "admissionregistration.k8s.io":
+		return phaseAdmission
+	default:
+		return phaseResources
+	}
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+)
+
+// apiServer wraps a fake client and counts, and optionally delays, the requests made through it,
+// standing in for the round trips to a real API server.
+type apiServer struct {
+	client.WithWatch
+	// store holds the objects, and is read without counting or delaying the requests.
+	store   client.WithWatch
+	latency time.Duration
+	// failName makes patches of the objects with this name fail.
+	failName string
+	gets     atomic.Int64
+	patches  atomic.Int64
+	inFlight atomic.Int64
+	peak     atomic.Int64
+
+	mu      sync.Mutex
+	patched []string
+}
+
+func newAPIServer(g *WithT, latency time.Duration, objs ...client.Object) *apiServer {
+	scheme := setupScheme(g)
+	g.Expect(admissionregistrationv1.AddToScheme(scheme)).To(Succeed())
+	s := &apiServer{latency: latency}
+	s.store = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithReturnManagedFields().Build()
+	s.WithWatch = interceptor.NewClient(s.store, interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			s.gets.Add(1)
+			time.Sleep(s.latency)
+			return c.Get(ctx, key, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			s.patches.Add(1)
+			patched := GetKind(obj) + "/" + obj.GetName()
+			if obj.GetName() == s.failName {
+				return fmt.Errorf("patch of %s rejected", patched)
+			}
+			inFlight := s.inFlight.Add(1)
+			defer s.inFlight.Add(-1)
+			for peak := s.peak.Load(); inFlight > peak && !s.peak.CompareAndSwap(peak, inFlight); peak = s.peak.Load() {
+			}
+			time.Sleep(s.latency)
+			if err := c.Patch(ctx, obj, patch, opts...); err != nil {
+				return err
+			}
+			s.mu.Lock()
+			s.patched = append(s.patched, patched)
+			s.mu.Unlock()
+			return nil
+		},
+	})
+	return s
+}
+
+// informerCache stands in for the informers of a LiveMetadata: once synced, it serves reads
+// from the objects of an apiServer without making requests to it.
+type informerCache struct {
+	cache.Cache
+	server *apiServer
+	synced bool
+}
+
+func (c *informerCache) GetInformer(context.Context, client.Object, ...cache.InformerGetOption) (cache.Informer, error) {
+	if c.synced {
+		return controllertest.NewFakeInformer(controllertest.Synced), nil
+	}
+	return controllertest.NewFakeInformer(), nil
+}
+
+func (c *informerCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.server.store.Get(ctx, key, obj, opts...)
+}
+
+// batchOwner returns a cluster-scoped owner, which may own the cluster-scoped objects of a batch.
+func batchOwner() *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testOwnerValue, UID: "test-owner-uid"}}
+}
+
+func batchOwnership(owner client.Object) OwnershipConfig {
+	return OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     testOwnerLabel,
+		ComponentLabelKey: testComponentLabel,
+		Component:         testComponent,
+		FieldManager:      testFieldManager,
+	}
+}
+
+// batchObjects returns a Namespace and n ConfigMaps in it, like a component manifest.
+func batchObjects(n int, value string) []client.Object {
+	objs := []client.Object{&corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: testNamespace},
+	}}
+	for i := range n {
+		objs = append(objs, &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("cm-%02d", i), Namespace: testNamespace},
+			Data:       map[string]string{"key": value},
+		})
+	}
+	return objs
+}
+
+func TestClient_ApplyOwnedBatch(t *testing.T) {
+	owner := batchOwner()
+
+	t.Run("applies definitions first and admission webhooks last", func(t *testing.T) {
+		g := NewWithT(t)
+		server := newAPIServer(g, 0, owner)
+		objs := []client.Object{
+			&admissionregistrationv1.ValidatingWebhookConfiguration{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: "ValidatingWebhookConfiguration"},
+				ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+			},
+			&corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: testNamespace},
+			},
+			&apiextensionsv1.CustomResourceDefinition{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition"},
+				ObjectMeta: metav1.ObjectMeta{Name: "widgets.example.com"},
+			},
+			&corev1.Namespace{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+				ObjectMeta: metav1.ObjectMeta{Name: testNamespace},
+			},
+		}
+
+		ownership := batchOwnership(owner)
+		ownership.MaxConcurrentApplies = 1
+		tc := NewClientWithOwnership(server, ownership)
+		g.Expect(tc.ApplyOwnedBatch(context.Background(), objs)).To(Succeed())
+
+		g.Expect(server.patched).To(Equal([]string{
+			"CustomResourceDefinition/widgets.example.com",
+			"Namespace/" + testNamespace,
+			"ConfigMap/config",
+			"ValidatingWebhookConfiguration/webhook",
+		}))
+		g.Expect(tc.TrackedResources()).To(HaveLen(4))
+	})
+
+	t.Run("bounds the concurrent applies", func(t *testing.T) {
+		g := NewWithT(t)
+		server := newAPIServer(g, 5*time.Millisecond, owner)
+		ownership := batchOwnership(owner)
+		ownership.MaxConcurrentApplies = 3
+		tc := NewClientWithOwnership(server, ownership)
+		g.Expect(tc.ApplyOwnedBatch(context.Background(), batchObjects(12, "value"))).To(Succeed())
+
+		g.Expect(server.patches.Load()).To(BeEquivalentTo(13))
+		g.Expect(server.peak.Load()).To(BeNumerically(">", 1))
+		g.Expect(server.peak.Load()).To(BeNumerically("<=", 3))
+	})
+
+	t.Run("names the object that failed", func(t *testing.T) {
+		g := NewWithT(t)
+		server := newAPIServer(g, 0, owner)
+		server.failName = "cm-01"
+		tc := NewClientWithOwnership(server, batchOwnership(owner))
+		err := tc.ApplyOwnedBatch(context.Background(), batchObjects(3, "value"))
+		g.Expect(err).To(MatchError(ContainSubstring("failed to apply object " + testNamespace + "/cm-01 (ConfigMap)")))
+	})
+}
+
+func TestClient_ApplyOwned_ApplyCache(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	owner := batchOwner()
+	server := newAPIServer(g, 0, owner)
+	informers := &informerCache{server: server}
+	cache := NewApplyCache(NewLiveMetadata(informers, server), DefaultApplyCacheMaxAge)
+	ownership := batchOwnership(owner)
+	ownership.DetectDrift = true
+	ownership.ApplyCache = cache
+	newConfigMap := func(value string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "cached", Namespace: testNamespace},
+			Data:       map[string]string{"key": value},
+		}
+	}
+	// reconcile applies the ConfigMap with a fresh tracking client and returns the number of
+	// patches it took.
+	reconcile := func(value string) int64 {
+		before := server.patches.Load()
+		tc := NewClientWithOwnership(server, ownership)
+		g.Expect(tc.ApplyOwned(ctx, newConfigMap(value))).To(Succeed())
+		g.Expect(tc.IsTracked(configMapGVK, testNamespace, "cached")).To(BeTrue())
+		return server.patches.Load() - before
+	}
+
+	g.Expect(reconcile("v1")).To(BeNumerically(">", 0))
+	g.Expect(cache.Len()).To(Equal(1))
+
+	// An unchanged object is read from the API server until the informers have synced.
+	gets := server.gets.Load()
+	g.Expect(reconcile("v1")).To(BeZero())
+	g.Expect(server.gets.Load() - gets).To(BeEquivalentTo(1))
+
+	// And takes no request at all afterwards.
+	informers.synced = true
+	gets = server.gets.Load()
+	g.Expect(reconcile("v1")).To(BeZero())
+	g.Expect(server.gets.Load() - gets).To(BeZero())
+
+	// A changed desired state is applied.
+	g.Expect(reconcile("v2")).To(BeNumerically(">", 0))
+	g.Expect(reconcile("v2")).To(BeZero())
+
+	// So is an object changed on the server.
+	live := &corev1.ConfigMap{}
+	g.Expect(server.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "cached"}, live)).To(Succeed())
+	live.Data["key"] = "edited"
+	g.Expect(server.Update(ctx, live)).To(Succeed())
+	g.Expect(reconcile("v2")).To(BeNumerically(">", 0))
+	g.Expect(server.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "cached"}, live)).To(Succeed())
+	g.Expect(live.Data).To(HaveKeyWithValue("key", "v2"))
+
+	// And a deleted one.
+	g.Expect(server.Delete(ctx, live)).To(Succeed())
+	g.Expect(reconcile("v2")).To(BeNumerically(">", 0))
+
+	// Expired entries are applied again.
+	cache.mu.Lock()
+	for key, entry := range cache.entries {
+		entry.appliedAt = time.Now().Add(-2 * DefaultApplyCacheMaxAge)
+		cache.entries[key] = entry
+	}
+	cache.mu.Unlock()
+	g.Expect(reconcile("v2")).To(BeNumerically(">", 0))
+
+	// Plans never use the cache.
+	planOwnership := ownership
+	planOwnership.Plan = true
+	planner := NewClientWithOwnership(server, planOwnership)
+	g.Expect(planner.ApplyOwned(ctx, newConfigMap("v2"))).To(Succeed())
+	g.Expect(planner.Plan()).To(HaveLen(1))
+}
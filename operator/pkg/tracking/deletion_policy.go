@@ -0,0 +1,206 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DeletionPolicyAnnotation overrides, on an orphaned resource, the deletion policy that
+	// CleanupOrphans applies to it.
+	DeletionPolicyAnnotation = "konflux.konflux-ci.dev/deletion-policy"
+	// ConfirmDeletionAnnotation confirms the deletion of an orphaned resource with the
+	// RequireConfirmation policy when set to "true".
+	ConfirmDeletionAnnotation = "konflux.konflux-ci.dev/confirm-deletion"
+
+	// EventReasonOrphanReleased is the reason of the Event emitted on the owner when
+	// CleanupOrphans releases a resource with the Orphan policy instead of deleting it.
+	EventReasonOrphanReleased = "OrphanReleased"
+	// EventReasonOrphanDeletionPending is the reason of the Event emitted on the owner when
+	// CleanupOrphans keeps a resource until its deletion is confirmed.
+	EventReasonOrphanDeletionPending = "OrphanDeletionPending"
+)
+
+// DeletionPolicy decides what CleanupOrphans does with an orphaned resource.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the resource. It is the default.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan keeps the resource and releases it: the owner and component labels
+	// and the owner reference are removed, so that the operator no longer manages it.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyRequireConfirmation keeps the resource until it is annotated with
+	// ConfirmDeletionAnnotation, and deletes it then.
+	DeletionPolicyRequireConfirmation DeletionPolicy = "RequireConfirmation"
+)
+
+// DeletionPolicies sets the deletion policy of orphaned resources per GVK. Resources of the
+// GVKs it does not list are deleted, unless they set DeletionPolicyAnnotation.
+type DeletionPolicies map[schema.GroupVersionKind]DeletionPolicy
+
+// WithDeletionPolicies sets the deletion policies of orphaned resources.
+func WithDeletionPolicies(policies DeletionPolicies) CleanupOption {
+	return func(opts *CleanupOptions) {
+		opts.DeletionPolicies = policies
+	}
+}
+
+// policyFor returns the deletion policy of an orphaned resource: its annotation, else the
+// policy of its GVK, else Delete. An annotation with an unknown policy requires confirmation,
+// so that a typo never deletes a resource that was meant to be kept.
+func (p DeletionPolicies) policyFor(gvk schema.GroupVersionKind, obj client.Object) DeletionPolicy {
+	if value, ok := obj.GetAnnotations()[DeletionPolicyAnnotation]; ok {
+		switch policy := DeletionPolicy(value); policy {
+		case DeletionPolicyDelete, DeletionPolicyOrphan, DeletionPolicyRequireConfirmation:
+			return policy
+		default:
+			return DeletionPolicyRequireConfirmation
+		}
+	}
+	if policy, ok := p[gvk]; ok {
+		return policy
+	}
+	return DeletionPolicyDelete
+}
+
+// CleanupOutcome is what CleanupOrphans did with an orphaned resource.
+type CleanupOutcome string
+
+const (
+	CleanupDeleted              CleanupOutcome = "Deleted"
+	CleanupOrphaned             CleanupOutcome = "Orphaned"
+	CleanupAwaitingConfirmation CleanupOutcome = "AwaitingConfirmation"
+)
+
+// CleanupResult records what CleanupOrphans did with an orphaned resource.
+type CleanupResult struct {
+	Key     ResourceKey
+	Policy  DeletionPolicy
+	Outcome CleanupOutcome
+}
+
+// CleanupResults returns what CleanupOrphans did with the orphaned resources during this
+// reconcile, sorted by resource. In plan mode nothing is deleted or released, but resources
+// awaiting confirmation are still reported.
+func (c *Client) CleanupResults() []CleanupResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := slices.Clone(c.cleanupResults)
+	slices.SortFunc(results, func(a, b CleanupResult) int {
+		return strings.Compare(a.Key.String(), b.Key.String())
+	})
+	return results
+}
+
+func (c *Client) recordCleanup(result CleanupResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cleanupResults = append(c.cleanupResults, result)
+}
+
+// handleRetainedOrphan applies a policy other than Delete to an orphaned resource. It returns
+// false when the resource is to be deleted after all: its policy is Delete, or its deletion
+// was confirmed.
+func (c *Client) handleRetainedOrphan(
+	ctx context.Context,
+	item *unstructured.Unstructured,
+	key ResourceKey,
+	policy DeletionPolicy,
+	ownerLabelKey string,
+) (bool, error) {
+	switch policy {
+	case DeletionPolicyOrphan:
+		if c.Planning() {
+			c.recordPlanned(PlannedChange{Key: key, Action: ActionUpdate, Diff: c.releaseDiff(item, ownerLabelKey)})
+			return true, nil
+		}
+		if err := c.release(ctx, item, ownerLabelKey); err != nil {
+			return true, fmt.Errorf("failed to release %s: %w", key.String(), err)
+		}
+		logf.FromContext(ctx).Info("Released orphaned resource", "resource", key.String(), "policy", policy)
+		c.recordCleanup(CleanupResult{Key: key, Policy: policy, Outcome: CleanupOrphaned})
+		c.emitCleanupEvent(item, corev1.EventTypeNormal, EventReasonOrphanReleased, "Release",
+			"Released orphaned resource %s; it is kept but no longer managed", key.String())
+		return true, nil
+
+	case DeletionPolicyRequireConfirmation:
+		if item.GetAnnotations()[ConfirmDeletionAnnotation] == "true" {
+			return false, nil
+		}
+		logf.FromContext(ctx).Info("Keeping orphaned resource until its deletion is confirmed",
+			"resource", key.String(), "annotation", ConfirmDeletionAnnotation)
+		c.recordCleanup(CleanupResult{Key: key, Policy: policy, Outcome: CleanupAwaitingConfirmation})
+		if !c.Planning() {
+			c.emitCleanupEvent(item, corev1.EventTypeWarning, EventReasonOrphanDeletionPending, "Delete",
+				"Orphaned resource %s is kept until it is annotated with %s=true", key.String(), ConfirmDeletionAnnotation)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// release removes the owner and component labels and the owner reference of the owner from
+// item, so that neither orphan cleanup nor garbage collection deletes it.
+func (c *Client) release(ctx context.Context, item *unstructured.Unstructured, ownerLabelKey string) error {
+	original := item.DeepCopy()
+
+	labels := item.GetLabels()
+	delete(labels, ownerLabelKey)
+	if c.ownership != nil {
+		delete(labels, c.ownership.ComponentLabelKey)
+	}
+	item.SetLabels(labels)
+	if c.ownership != nil {
+		item.SetOwnerReferences(slices.DeleteFunc(item.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+			return ref.UID == c.ownership.Owner.GetUID()
+		}))
+	}
+	return c.Client.Patch(ctx, item, client.MergeFrom(original))
+}
+
+// releaseDiff returns the diff lines of releasing item, for plans.
+func (c *Client) releaseDiff(item *unstructured.Unstructured, ownerLabelKey string) []string {
+	diff := []string{"- metadata.labels." + ownerLabelKey}
+	if c.ownership != nil {
+		if _, ok := item.GetLabels()[c.ownership.ComponentLabelKey]; ok {
+			diff = append(diff, "- metadata.labels."+c.ownership.ComponentLabelKey)
+		}
+		diff = append(diff, fmt.Sprintf("- metadata.ownerReferences[name=%s]", c.ownership.Owner.GetName()))
+	}
+	return diff
+}
+
+func (c *Client) emitCleanupEvent(item client.Object, eventType, reason, action, note string, args ...any) {
+	if c.ownership == nil || c.ownership.Recorder == nil {
+		return
+	}
+	c.ownership.Recorder.Eventf(c.ownership.Owner, item, eventType, reason, action, note, args...)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// installPolicyOrphans applies ConfigMaps owned by owner, one per name, with the given
+// deletion policy and confirm-deletion annotations, so that a later cleanup finds them orphaned.
+func installPolicyOrphans(g *WithT, tc *Client, annotations map[string]map[string]string) {
+	for name, a := range annotations {
+		g.Expect(tc.ApplyOwned(context.Background(), &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Annotations: a},
+		})).To(Succeed())
+	}
+}
+
+func TestClient_CleanupOrphans_DeletionPolicies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := setupScheme(g)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	owner := createTestOwner(g, fakeClient)
+	recorder := events.NewFakeRecorder(10)
+	ownership := OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     testOwnerLabel,
+		ComponentLabelKey: testComponentLabel,
+		Component:         testComponent,
+		FieldManager:      testFieldManager,
+		Recorder:          recorder,
+	}
+	installPolicyOrphans(g, NewClientWithOwnership(fakeClient, ownership), map[string]map[string]string{
+		"pending":   nil,
+		"confirmed": {ConfirmDeletionAnnotation: "true"},
+		"deleted":   {DeletionPolicyAnnotation: string(DeletionPolicyDelete)},
+		"orphaned":  {DeletionPolicyAnnotation: string(DeletionPolicyOrphan)},
+		"unknown":   {DeletionPolicyAnnotation: "Retain"},
+	})
+
+	// Nothing is applied anymore, so every ConfigMap is orphaned.
+	tc := NewClientWithOwnership(fakeClient, ownership)
+	err := tc.CleanupOrphans(ctx, testOwnerLabel, testOwnerValue, []schema.GroupVersionKind{configMapGVK},
+		WithDeletionPolicies(DeletionPolicies{configMapGVK: DeletionPolicyRequireConfirmation}))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	key := func(name string) ResourceKey {
+		return ResourceKey{GVK: configMapGVK, Namespace: testNamespace, Name: name}
+	}
+	g.Expect(tc.CleanupResults()).To(Equal([]CleanupResult{
+		{Key: key("confirmed"), Policy: DeletionPolicyRequireConfirmation, Outcome: CleanupDeleted},
+		{Key: key("deleted"), Policy: DeletionPolicyDelete, Outcome: CleanupDeleted},
+		{Key: key("orphaned"), Policy: DeletionPolicyOrphan, Outcome: CleanupOrphaned},
+		{Key: key("pending"), Policy: DeletionPolicyRequireConfirmation, Outcome: CleanupAwaitingConfirmation},
+		{Key: key("unknown"), Policy: DeletionPolicyRequireConfirmation, Outcome: CleanupAwaitingConfirmation},
+	}))
+
+	for _, name := range []string{"confirmed", "deleted"} {
+		err := fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: name}, &corev1.ConfigMap{})
+		g.Expect(errors.IsNotFound(err)).To(BeTrue(), "%s should be deleted", name)
+	}
+	for _, name := range []string{"pending", "unknown"} {
+		cm := &corev1.ConfigMap{}
+		g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: name}, cm)).To(Succeed())
+		g.Expect(cm.Labels).To(HaveKeyWithValue(testOwnerLabel, testOwnerValue))
+	}
+
+	released := &corev1.ConfigMap{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "orphaned"}, released)).To(Succeed())
+	g.Expect(released.Labels).NotTo(HaveKey(testOwnerLabel))
+	g.Expect(released.Labels).NotTo(HaveKey(testComponentLabel))
+	g.Expect(released.OwnerReferences).To(BeEmpty())
+
+	var emitted []string
+	for len(recorder.Events) > 0 {
+		emitted = append(emitted, <-recorder.Events)
+	}
+	g.Expect(emitted).To(ContainElements(
+		"Normal OrphanReleased Released orphaned resource ConfigMap/test-namespace/orphaned; it is kept but no longer managed",
+		"Warning OrphanDeletionPending Orphaned resource ConfigMap/test-namespace/pending is kept until it is annotated with konflux.konflux-ci.dev/confirm-deletion=true",
+	))
+	g.Expect(emitted).To(HaveLen(5))
+
+	// A released resource is no longer found by the next cleanup.
+	next := NewClientWithOwnership(fakeClient, ownership)
+	g.Expect(next.CleanupOrphans(ctx, testOwnerLabel, testOwnerValue, []schema.GroupVersionKind{configMapGVK},
+		WithDeletionPolicies(DeletionPolicies{configMapGVK: DeletionPolicyRequireConfirmation}))).To(Succeed())
+	g.Expect(next.CleanupResults()).To(HaveLen(2))
+}
+
+func TestClient_CleanupOrphans_DeletionPoliciesInPlan(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := setupScheme(g)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	owner := createTestOwner(g, fakeClient)
+	ownership := OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     testOwnerLabel,
+		ComponentLabelKey: testComponentLabel,
+		Component:         testComponent,
+		FieldManager:      testFieldManager,
+	}
+	installPolicyOrphans(g, NewClientWithOwnership(fakeClient, ownership), map[string]map[string]string{
+		"pending":  {DeletionPolicyAnnotation: string(DeletionPolicyRequireConfirmation)},
+		"orphaned": {DeletionPolicyAnnotation: string(DeletionPolicyOrphan)},
+	})
+
+	ownership.Plan = true
+	planner := NewClientWithOwnership(fakeClient, ownership)
+	g.Expect(planner.CleanupOrphans(ctx, testOwnerLabel, testOwnerValue,
+		[]schema.GroupVersionKind{configMapGVK})).To(Succeed())
+
+	key := func(name string) ResourceKey {
+		return ResourceKey{GVK: configMapGVK, Namespace: testNamespace, Name: name}
+	}
+	g.Expect(planner.Plan()).To(Equal(Plan{{
+		Key:    key("orphaned"),
+		Action: ActionUpdate,
+		Diff: []string{
+			"- metadata.labels." + testOwnerLabel,
+			"- metadata.labels." + testComponentLabel,
+			"- metadata.ownerReferences[name=" + testOwnerValue + "]",
+		},
+	}}))
+	g.Expect(planner.CleanupResults()).To(Equal([]CleanupResult{
+		{Key: key("pending"), Policy: DeletionPolicyRequireConfirmation, Outcome: CleanupAwaitingConfirmation},
+	}))
+
+	// Nothing was released.
+	cm := &corev1.ConfigMap{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "orphaned"}, cm)).To(Succeed())
+	g.Expect(cm.Labels).To(HaveKeyWithValue(testOwnerLabel, testOwnerValue))
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// beforeFirstApplyManager is the field manager the API server assigns to fields that were
+// set before the first server-side apply of an object. It is not an actual editor.
+const beforeFirstApplyManager = "before-first-apply"
+
+// AppliedHashAnnotation records, on the objects applied with OwnershipConfig.DetectDrift, the
+// hash of the desired state they were last applied with.
+const AppliedHashAnnotation = "konflux.konflux-ci.dev/applied-hash"
+
+// Drift describes a managed resource whose live state differed from the desired state
+// when it was applied, because another field manager had taken over or removed fields
+// owned by this client's field manager (e.g. kubectl edit, kubectl scale or another controller).
+type Drift struct {
+	Key ResourceKey
+	// Managers are the field managers whose changes the apply reverted, sorted by name.
+	// It is empty when the apply only restored removed fields, whose remover is not recorded.
+	Managers []string
+}
+
+// Drifts returns the drift detected by ApplyOwned during this reconcile.
+// It is always empty unless the client was created with OwnershipConfig.DetectDrift.
+func (c *Client) Drifts() []Drift {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.drifts)
+}
+
+// liveMetadata returns the metadata of the live counterpart of obj, before it is applied, or
+// nil if there is none. It is read through OwnershipConfig.LiveMetadata if set. Otherwise,
+// reading the live object as unstructured bypasses the informer cache, so detecting drift does
+// not start watches for every applied kind.
+func (c *Client) liveMetadata(ctx context.Context, obj client.Object) (metav1.Object, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine GVK: %w", err)
+	}
+
+	if c.ownership.LiveMetadata != nil {
+		live, err := c.ownership.LiveMetadata.get(ctx, ResourceKey{GVK: gvk, Namespace: obj.GetNamespace(), Name: obj.GetName()})
+		if err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return live, nil
+	}
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(gvk)
+	if err := c.Client.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return live, nil
+}
+
+// setAppliedHash records the hash of the desired state of obj in its AppliedHashAnnotation.
+func setAppliedHash(obj client.Object) error {
+	annotations := obj.GetAnnotations()
+	delete(annotations, AppliedHashAnnotation)
+	obj.SetAnnotations(annotations)
+	hash, err := hashObject(obj)
+	if err != nil {
+		return err
+	}
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[AppliedHashAnnotation] = hash
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// detectDrift compares the metadata of the live object before the apply with applied, the
+// object as returned by the apply. A forced apply takes the fields it reverts away from the
+// managers that changed them, so no separate comparison of contents is needed. Fields removed
+// by another manager leave no trace in its managedFields; they are instead re-added to those of
+// fieldManager, which only happens without a change of the desired state when they had drifted.
+func (c *Client) detectDrift(applied client.Object, before metav1.Object, fieldManager string) (*Drift, error) {
+	after := applied.GetManagedFields()
+	// The API server always returns managedFields; clients that do not maintain them
+	// leave nothing to compare against. A missing object is not drift.
+	if len(after) == 0 || before == nil {
+		return nil, nil
+	}
+
+	managers := revertedManagers(before.GetManagedFields(), after, fieldManager)
+	desiredUnchanged := before.GetAnnotations()[AppliedHashAnnotation] == applied.GetAnnotations()[AppliedHashAnnotation]
+	if len(managers) == 0 && (!desiredUnchanged || !readdedFields(before.GetManagedFields(), after, fieldManager)) {
+		// Nothing changed, or only the desired state did (e.g. a spec update or an operator upgrade).
+		return nil, nil
+	}
+	gvk, err := apiutil.GVKForObject(applied, c.Scheme())
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine GVK: %w", err)
+	}
+	return &Drift{
+		Key:      ResourceKey{GVK: gvk, Namespace: applied.GetNamespace(), Name: applied.GetName()},
+		Managers: managers,
+	}, nil
+}
+
+// readdedFields reports whether the apply of fieldManager owns different fields after the apply
+// than before it. With an unchanged desired state, these are fields that had been removed.
+func readdedFields(before, after []metav1.ManagedFieldsEntry, fieldManager string) bool {
+	owned := func(entries []metav1.ManagedFieldsEntry) []byte {
+		for _, entry := range entries {
+			if entry.Manager == fieldManager && entry.Operation == metav1.ManagedFieldsOperationApply && entry.Subresource == "" {
+				return fieldsRaw(entry.FieldsV1)
+			}
+		}
+		return nil
+	}
+	return !bytes.Equal(owned(before), owned(after))
+}
+
+// revertedManagers returns the field managers, other than fieldManager and the one it hands
+// fields off to, that own fewer fields after the apply than before it. A forced apply takes conflicting fields away
+// from their current owners, so those are the managers whose changes are reverted.
+func revertedManagers(before, after []metav1.ManagedFieldsEntry, fieldManager string) []string {
+	var managers []string
+	for _, b := range before {
+		if b.Manager == fieldManager || b.Manager == fieldManager+handOffFieldManagerSuffix ||
+			b.Manager == beforeFirstApplyManager || slices.Contains(managers, b.Manager) {
+			continue
+		}
+		idx := slices.IndexFunc(after, func(a metav1.ManagedFieldsEntry) bool {
+			return a.Manager == b.Manager && a.Operation == b.Operation && a.Subresource == b.Subresource
+		})
+		if idx < 0 || !bytes.Equal(fieldsRaw(b.FieldsV1), fieldsRaw(after[idx].FieldsV1)) {
+			managers = append(managers, b.Manager)
+		}
+	}
+	slices.Sort(managers)
+	return managers
+}
+
+func fieldsRaw(f *metav1.FieldsV1) []byte {
+	if f == nil {
+		return nil
+	}
+	return f.Raw
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Drift detection relies on the API server maintaining managedFields across forced applies,
+// which fake clients do not fully model.
+
+func newDriftDetectingClient(c client.Client, owner client.Object) *Client {
+	return NewClientWithOwnership(c, OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     testOwnerLabel,
+		ComponentLabelKey: testComponentLabel,
+		Component:         testComponent,
+		FieldManager:      testFieldManager,
+		DetectDrift:       true,
+	})
+}
+
+func TestApplyOwned_DetectDrift(t *testing.T) {
+	ctx := context.Background()
+	c, owner := setupSSAOwner(t, ctx, "drift")
+	g := NewWithT(t)
+
+	desired := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "drift-cm", Namespace: ssaUpgradeNamespace},
+		Data:       map[string]string{"key": "value"},
+	}
+	t.Cleanup(func() { _ = c.Delete(context.Background(), desired.DeepCopy()) })
+
+	tc := newDriftDetectingClient(c, owner)
+	g.Expect(tc.ApplyOwned(ctx, desired.DeepCopy())).To(Succeed())
+	g.Expect(tc.Drifts()).To(BeEmpty(), "creating an object is not drift")
+
+	tc = newDriftDetectingClient(c, owner)
+	g.Expect(tc.ApplyOwned(ctx, desired.DeepCopy())).To(Succeed())
+	g.Expect(tc.Drifts()).To(BeEmpty(), "re-applying the same state is not drift")
+
+	// Edit a managed field with another field manager, as kubectl edit would.
+	live := &corev1.ConfigMap{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(desired), live)).To(Succeed())
+	live.Data["key"] = "hotfix"
+	g.Expect(c.Update(ctx, live, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+	tc = newDriftDetectingClient(c, owner)
+	g.Expect(tc.ApplyOwned(ctx, desired.DeepCopy())).To(Succeed())
+	drifts := tc.Drifts()
+	g.Expect(drifts).To(HaveLen(1))
+	g.Expect(drifts[0].Key.GVK.Kind).To(Equal("ConfigMap"))
+	g.Expect(drifts[0].Key.Name).To(Equal("drift-cm"))
+	g.Expect(drifts[0].Managers).To(Equal([]string{"kubectl-edit"}))
+
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(desired), live)).To(Succeed())
+	g.Expect(live.Data).To(HaveKeyWithValue("key", "value"), "the apply reverts the drift")
+
+	// Remove a managed label with another field manager.
+	labeled := desired.DeepCopy()
+	labeled.Labels = map[string]string{"app": "demo"}
+	tc = newDriftDetectingClient(c, owner)
+	g.Expect(tc.ApplyOwned(ctx, labeled.DeepCopy())).To(Succeed())
+	g.Expect(tc.Drifts()).To(BeEmpty(), "adding a label to the desired state is not drift")
+
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(desired), live)).To(Succeed())
+	delete(live.Labels, "app")
+	g.Expect(c.Update(ctx, live, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+	tc = newDriftDetectingClient(c, owner)
+	g.Expect(tc.ApplyOwned(ctx, labeled.DeepCopy())).To(Succeed())
+	drifts = tc.Drifts()
+	g.Expect(drifts).To(HaveLen(1), "removing a managed field is drift")
+	g.Expect(drifts[0].Key.Name).To(Equal("drift-cm"))
+	g.Expect(drifts[0].Managers).To(BeEmpty())
+
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(desired), live)).To(Succeed())
+	g.Expect(live.Labels).To(HaveKeyWithValue("app", "demo"), "the apply restores the label")
+
+	// Change only the desired state, as a spec update or operator upgrade would.
+	changed := desired.DeepCopy()
+	changed.Data["key"] = "new-value"
+	tc = newDriftDetectingClient(c, owner)
+	g.Expect(tc.ApplyOwned(ctx, changed)).To(Succeed())
+	g.Expect(tc.Drifts()).To(BeEmpty(), "a change of desired state is not drift")
+}
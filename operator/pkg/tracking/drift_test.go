@@ -0,0 +1,186 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func managedFieldsEntry(manager string, op metav1.ManagedFieldsOperationType, fields string) metav1.ManagedFieldsEntry {
+	return metav1.ManagedFieldsEntry{
+		Manager:   manager,
+		Operation: op,
+		FieldsV1:  &metav1.FieldsV1{Raw: []byte(fields)},
+	}
+}
+
+func TestRevertedManagers(t *testing.T) {
+	ours := managedFieldsEntry(testFieldManager, metav1.ManagedFieldsOperationApply, `{"f:data":{"f:a":{},"f:b":{}}}`)
+
+	tests := []struct {
+		name   string
+		before []metav1.ManagedFieldsEntry
+		after  []metav1.ManagedFieldsEntry
+		want   []string
+	}{
+		{
+			name:   "no other managers",
+			before: []metav1.ManagedFieldsEntry{ours},
+			after:  []metav1.ManagedFieldsEntry{ours},
+		},
+		{
+			name: "manager losing some fields",
+			before: []metav1.ManagedFieldsEntry{
+				ours,
+				managedFieldsEntry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:b":{},"f:c":{}}}`),
+			},
+			after: []metav1.ManagedFieldsEntry{
+				ours,
+				managedFieldsEntry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:c":{}}}`),
+			},
+			want: []string{"kubectl-edit"},
+		},
+		{
+			name: "manager losing all fields",
+			before: []metav1.ManagedFieldsEntry{
+				ours,
+				managedFieldsEntry("kubectl-patch", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:a":{}}}`),
+				managedFieldsEntry("argocd", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:b":{}}}`),
+			},
+			after: []metav1.ManagedFieldsEntry{ours},
+			want:  []string{"argocd", "kubectl-patch"},
+		},
+		{
+			name: "manager owning unrelated fields",
+			before: []metav1.ManagedFieldsEntry{
+				ours,
+				managedFieldsEntry("kubectl-annotate", metav1.ManagedFieldsOperationUpdate, `{"f:metadata":{"f:annotations":{}}}`),
+			},
+			after: []metav1.ManagedFieldsEntry{
+				ours,
+				managedFieldsEntry("kubectl-annotate", metav1.ManagedFieldsOperationUpdate, `{"f:metadata":{"f:annotations":{}}}`),
+			},
+		},
+		{
+			name: "before-first-apply is ignored",
+			before: []metav1.ManagedFieldsEntry{
+				ours,
+				managedFieldsEntry(beforeFirstApplyManager, metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:a":{}}}`),
+			},
+			after: []metav1.ManagedFieldsEntry{ours},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(revertedManagers(tt.before, tt.after, testFieldManager)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClient_ApplyOwned_DetectDrift_FakeClient(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := setupScheme(g)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	owner := createTestOwner(g, fakeClient)
+	tc := NewClientWithOwnership(fakeClient, OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     testOwnerLabel,
+		ComponentLabelKey: testComponentLabel,
+		Component:         testComponent,
+		FieldManager:      testFieldManager,
+		DetectDrift:       true,
+	})
+
+	cm := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "drift-cm", Namespace: testNamespace},
+		Data:       map[string]string{"key": "value"},
+	}
+	g.Expect(tc.ApplyOwned(ctx, cm.DeepCopy())).To(Succeed())
+	g.Expect(tc.ApplyOwned(ctx, cm.DeepCopy())).To(Succeed())
+
+	// Re-applying the same state is not drift, and the apply itself must go through.
+	g.Expect(tc.Drifts()).To(BeEmpty())
+	live := &corev1.ConfigMap{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cm), live)).To(Succeed())
+	g.Expect(live.Data).To(HaveKeyWithValue("key", "value"))
+}
+
+func TestClient_ApplyOwned_DetectDrift_RemovedField(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	owner := batchOwner()
+	server := newAPIServer(g, 0, owner)
+	newDriftClient := func() *Client {
+		ownership := batchOwnership(owner)
+		ownership.DetectDrift = true
+		ownership.LiveMetadata = NewLiveMetadata(&informerCache{server: server, synced: true}, server)
+		return NewClientWithOwnership(server, ownership)
+	}
+	desired := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "drift-cm",
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app": "demo"},
+		},
+		Data: map[string]string{"key": "value"},
+	}
+
+	tc := newDriftClient()
+	g.Expect(tc.ApplyOwned(ctx, desired.DeepCopy())).To(Succeed())
+	tc = newDriftClient()
+	g.Expect(tc.ApplyOwned(ctx, desired.DeepCopy())).To(Succeed())
+	g.Expect(tc.Drifts()).To(BeEmpty(), "re-applying the same state is not drift")
+
+	// Remove an operator-owned label with another field manager, as kubectl edit would.
+	live := &corev1.ConfigMap{}
+	g.Expect(server.Get(ctx, client.ObjectKeyFromObject(desired), live)).To(Succeed())
+	delete(live.Labels, "app")
+	g.Expect(server.Update(ctx, live, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+	gets := server.gets.Load()
+	tc = newDriftClient()
+	g.Expect(tc.ApplyOwned(ctx, desired.DeepCopy())).To(Succeed())
+	g.Expect(server.gets.Load()-gets).To(BeZero(), "the live metadata is read from the informers")
+	drifts := tc.Drifts()
+	g.Expect(drifts).To(HaveLen(1))
+	g.Expect(drifts[0].Key.Name).To(Equal("drift-cm"))
+	g.Expect(drifts[0].Managers).To(BeEmpty())
+
+	g.Expect(server.Get(ctx, client.ObjectKeyFromObject(desired), live)).To(Succeed())
+	g.Expect(live.Labels).To(HaveKeyWithValue("app", "demo"), "the apply restores the label")
+
+	// Adding a field to the desired state is not drift.
+	changed := desired.DeepCopy()
+	changed.Labels["tier"] = "web"
+	tc = newDriftClient()
+	g.Expect(tc.ApplyOwned(ctx, changed)).To(Succeed())
+	g.Expect(tc.Drifts()).To(BeEmpty())
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/konflux-ci/konflux-ci/operator/pkg/kubernetes"
+)
+
+// handOffFieldManagerSuffix names, after the field manager of the client, the field manager
+// that keeps the fields handed off with HandOff.
+const handOffFieldManagerSuffix = "-handoff"
+
+// HandOff prepares the client to stop applying the field at path, which another controller
+// manages from now on (e.g. the replicas of a Deployment scaled by a HorizontalPodAutoscaler).
+// A server-side apply that omits a field it is the only manager of removes the field, which
+// resets it to its default. So when the client's field manager owns the field on live, the
+// live object, HandOff first applies value at path with a separate field manager, which keeps
+// the field set until the other controller takes it over. Otherwise it does nothing.
+// The client must be created with NewClientWithOwnership.
+func (c *Client) HandOff(ctx context.Context, live client.Object, value any, path ...string) error {
+	if c.ownership == nil {
+		return fmt.Errorf("HandOff called but client was not created with ownership config; use NewClientWithOwnership")
+	}
+	if !ownsField(live.GetManagedFields(), c.ownership.FieldManager, path) {
+		return nil
+	}
+	gvk, err := apiutil.GVKForObject(live, c.Scheme())
+	if err != nil {
+		return fmt.Errorf("failed to determine GVK: %w", err)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(live.GetName())
+	obj.SetNamespace(live.GetNamespace())
+	if err := unstructured.SetNestedField(obj.Object, value, path...); err != nil {
+		return fmt.Errorf("failed to set %s: %w", strings.Join(path, "."), err)
+	}
+	opts := []client.PatchOption{client.FieldOwner(c.ownership.FieldManager + handOffFieldManagerSuffix), client.ForceOwnership}
+	if c.Planning() {
+		opts = append(opts, client.DryRunAll)
+	}
+	if err := c.Client.Patch(ctx, obj, kubernetes.SSAPatch, opts...); err != nil {
+		return fmt.Errorf("failed to hand off %s of %s/%s: %w", strings.Join(path, "."), live.GetNamespace(), live.GetName(), err)
+	}
+	return nil
+}
+
+// ownsField reports whether the apply of fieldManager owns the field at path.
+func ownsField(entries []metav1.ManagedFieldsEntry, fieldManager string, path []string) bool {
+	for _, entry := range entries {
+		if entry.Manager != fieldManager || entry.Operation != metav1.ManagedFieldsOperationApply || entry.FieldsV1 == nil {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		for i, name := range path {
+			raw, ok := fields["f:"+name]
+			if !ok {
+				break
+			}
+			if i == len(path)-1 {
+				return true
+			}
+			fields = nil
+			if err := json.Unmarshal(raw, &fields); err != nil {
+				break
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LiveMetadata reads the metadata of the objects tracking clients apply from metadata-only
+// informers, so that reading it does not cost a request to the API server. Until the informer
+// of a kind has synced, its objects are read from the API server instead.
+//
+// Create one LiveMetadata per manager and share it between reconcilers. Its cache only needs
+// to hold the applied objects, so it can select them by their owner label.
+type LiveMetadata struct {
+	informers cache.Cache
+	reader    client.Reader
+}
+
+// NewLiveMetadata returns a LiveMetadata that reads from the informers of informers, and from
+// reader, typically the manager's API reader, while they sync.
+func NewLiveMetadata(informers cache.Cache, reader client.Reader) *LiveMetadata {
+	return &LiveMetadata{informers: informers, reader: reader}
+}
+
+// get returns the live metadata of the object at key.
+func (l *LiveMetadata) get(ctx context.Context, key ResourceKey) (*metav1.PartialObjectMetadata, error) {
+	live := &metav1.PartialObjectMetadata{}
+	live.SetGroupVersionKind(key.GVK)
+	objectKey := client.ObjectKey{Namespace: key.Namespace, Name: key.Name}
+
+	// Starting the informer of a kind must not block the reconcile that first applies it.
+	informer, err := l.informers.GetInformer(ctx, live, cache.BlockUntilSynced(false))
+	if err != nil || !informer.HasSynced() {
+		return live, l.reader.Get(ctx, objectKey, live)
+	}
+	return live, l.informers.Get(ctx, objectKey, live)
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// maxPlannedDiffLines bounds the diff recorded for a single object.
+	maxPlannedDiffLines = 40
+	// maxPlannedValueLength bounds a value shown in a diff line.
+	maxPlannedValueLength = 120
+)
+
+// Action is the change that a write planned for an object would make.
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionDelete    Action = "delete"
+	ActionUnchanged Action = "unchanged"
+)
+
+// PlannedChange describes what a reconcile in plan mode would have done to an object.
+type PlannedChange struct {
+	Key    ResourceKey
+	Action Action
+	// Diff lists the fields an update changes, one "~ path: old -> new", "+ path: new" or
+	// "- path" line each. The values of Secrets are redacted.
+	Diff []string
+}
+
+// Plan lists the changes of a reconcile in plan mode, sorted by resource.
+type Plan []PlannedChange
+
+// Count returns the number of changes with the given action.
+func (p Plan) Count(action Action) int {
+	n := 0
+	for _, change := range p {
+		if change.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// HasChanges reports whether the plan creates, updates or deletes anything.
+func (p Plan) HasChanges() bool {
+	return p.Count(ActionUnchanged) < len(p)
+}
+
+// Summary returns a one-line count of the planned changes.
+func (p Plan) Summary() string {
+	return fmt.Sprintf("%d to create, %d to update, %d to delete, %d unchanged",
+		p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete), p.Count(ActionUnchanged))
+}
+
+// String renders the plan for humans: one line per created, updated or deleted object,
+// followed by the fields an update changes, and the summary.
+func (p Plan) String() string {
+	var b strings.Builder
+	for _, change := range p {
+		switch change.Action {
+		case ActionCreate:
+			fmt.Fprintf(&b, "+ %s\n", change.Key)
+		case ActionDelete:
+			fmt.Fprintf(&b, "- %s\n", change.Key)
+		case ActionUpdate:
+			fmt.Fprintf(&b, "~ %s\n", change.Key)
+			for _, line := range change.Diff {
+				fmt.Fprintf(&b, "    %s\n", line)
+			}
+		}
+	}
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString(p.Summary())
+	b.WriteString(".\n")
+	return b.String()
+}
+
+// Planning reports whether the client runs in plan mode; see OwnershipConfig.Plan. In plan mode
+// nothing was applied or deleted, so callers publish Plan instead of updating the status as if
+// the reconcile had taken effect.
+func (c *Client) Planning() bool {
+	return c.ownership != nil && c.ownership.Plan
+}
+
+// Plan returns the changes recorded in plan mode, sorted by resource.
+func (c *Client) Plan() Plan {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	plan := make(Plan, 0, len(c.planned))
+	for _, change := range c.planned {
+		plan = append(plan, change)
+	}
+	sort.Slice(plan, func(i, j int) bool { return plan[i].Key.String() < plan[j].Key.String() })
+	return plan
+}
+
+// Writer returns the client through which helpers that manage their own objects, such as
+// hashed ConfigMaps, should write: c in plan mode, so that their writes are planned like every
+// other write, and base otherwise, so that their objects stay untracked.
+func (c *Client) Writer(base client.Client) client.Client {
+	if c.Planning() {
+		return c
+	}
+	return base
+}
+
+// planWrite runs write, which must be a dry run of a write of obj, and records the change it
+// would make by comparing the live object with the object the dry run returned.
+func (c *Client) planWrite(ctx context.Context, obj client.Object, write func() error) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return fmt.Errorf("failed to determine GVK: %w", err)
+	}
+	key := ResourceKey{GVK: gvk, Namespace: obj.GetNamespace(), Name: obj.GetName()}
+
+	// Read the live object as unstructured to bypass the informer cache, like detectDrift.
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(gvk)
+	if err := c.Client.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+		if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to get %s: %w", key, err)
+		}
+		live = nil
+	}
+
+	if err := write(); err != nil {
+		// The API server cannot evaluate the creation of an object whose namespace or CRD the
+		// plan creates itself; it would be created.
+		if live == nil && (apierrors.IsNotFound(err) || meta.IsNoMatchError(err)) {
+			c.recordPlanned(PlannedChange{Key: key, Action: ActionCreate})
+			c.track(obj)
+			return nil
+		}
+		return err
+	}
+
+	change := PlannedChange{Key: key, Action: ActionCreate}
+	if live != nil {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("failed to convert to unstructured: %w", err)
+		}
+		result := &unstructured.Unstructured{Object: content}
+		// The API server returns the whole would-be object. Clients that do not evaluate dry
+		// runs leave obj as it was sent, so only the fields it sets can be compared.
+		evaluated := result.GetResourceVersion() != ""
+		change.Diff = diffObjects(withoutBookkeeping(live), withoutBookkeeping(result), evaluated, gvk.Kind == "Secret")
+		change.Action = ActionUnchanged
+		if len(change.Diff) > 0 {
+			change.Action = ActionUpdate
+		}
+	}
+	c.recordPlanned(change)
+	c.track(obj)
+	return nil
+}
+
+// recordPlanned records change, replacing an earlier change of the same object.
+func (c *Client) recordPlanned(change PlannedChange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.planned == nil {
+		c.planned = make(map[ResourceKey]PlannedChange)
+	}
+	c.planned[change.Key] = change
+}
+
+// withoutBookkeeping returns the content of u without status and the metadata fields the API
+// server maintains on every write.
+func withoutBookkeeping(u *unstructured.Unstructured) map[string]any {
+	c := u.DeepCopy()
+	unstructured.RemoveNestedField(c.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(c.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(c.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(c.Object, "status")
+	return c.Object
+}
+
+// diffObjects returns the diff lines between the live object and the would-be object. Fields
+// missing from the would-be object are only reported as removed when complete is set.
+func diffObjects(live, result map[string]any, complete, redact bool) []string {
+	var lines []string
+	diffValues("", live, result, complete, redact, &lines)
+	if len(lines) > maxPlannedDiffLines {
+		more := len(lines) - maxPlannedDiffLines
+		lines = append(lines[:maxPlannedDiffLines], fmt.Sprintf("... %d more changed fields", more))
+	}
+	return lines
+}
+
+func diffValues(path string, live, result any, complete, redact bool, lines *[]string) {
+	if reflect.DeepEqual(live, result) {
+		return
+	}
+	liveMap, liveIsMap := live.(map[string]any)
+	resultMap, resultIsMap := result.(map[string]any)
+	if liveIsMap && resultIsMap {
+		keys := make([]string, 0, len(liveMap)+len(resultMap))
+		for k := range resultMap {
+			keys = append(keys, k)
+		}
+		for k := range liveMap {
+			if _, ok := resultMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			childPath := joinPath(path, k)
+			resultValue, inResult := resultMap[k]
+			liveValue, inLive := liveMap[k]
+			switch {
+			case !inResult:
+				if complete {
+					*lines = append(*lines, "- "+childPath)
+				}
+			case !inLive:
+				*lines = append(*lines, fmt.Sprintf("+ %s: %s", childPath, renderValue(childPath, resultValue, redact)))
+			default:
+				diffValues(childPath, liveValue, resultValue, complete, redact, lines)
+			}
+		}
+		return
+	}
+	if liveNamed, ok := namedItems(live); ok {
+		if resultNamed, ok := namedItems(result); ok {
+			diffValues(path, liveNamed, resultNamed, complete, redact, lines)
+			return
+		}
+	}
+	*lines = append(*lines, fmt.Sprintf("~ %s: %s -> %s", path,
+		renderValue(path, live, redact), renderValue(path, result, redact)))
+}
+
+// namedItems turns a list whose items all have a name, such as containers or env, into a map
+// keyed by "[name=...]" so that its items are compared one by one.
+func namedItems(v any) (map[string]any, bool) {
+	list, ok := v.([]any)
+	if !ok || len(list) == 0 {
+		return nil, false
+	}
+	items := make(map[string]any, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok {
+			return nil, false
+		}
+		key := "[name=" + name + "]"
+		if _, duplicate := items[key]; duplicate {
+			return nil, false
+		}
+		items[key] = item
+	}
+	return items, true
+}
+
+func joinPath(path, key string) string {
+	if path == "" || strings.HasPrefix(key, "[") {
+		return path + key
+	}
+	return path + "." + key
+}
+
+// renderValue renders a value of a diff line as compact JSON, redacting the data of Secrets.
+func renderValue(path string, v any, redact bool) string {
+	if redact && (strings.HasPrefix(path, "data") || strings.HasPrefix(path, "stringData")) {
+		return "(redacted)"
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	s := string(raw)
+	if len(s) > maxPlannedValueLength {
+		s = s[:maxPlannedValueLength] + "..."
+	}
+	return s
+}
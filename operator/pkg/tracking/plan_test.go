@@ -0,0 +1,200 @@
+/*
+Copyright 2025 Konflux CI.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClient_Plan(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := setupScheme(g)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	owner := createTestOwner(g, fakeClient)
+	ownership := OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     testOwnerLabel,
+		ComponentLabelKey: testComponentLabel,
+		Component:         testComponent,
+		FieldManager:      testFieldManager,
+	}
+	newConfigMap := func(name, value string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Data:       map[string]string{"key": value},
+		}
+	}
+
+	// Install the current state with a regular client.
+	tc := NewClientWithOwnership(fakeClient, ownership)
+	g.Expect(tc.ApplyOwned(ctx, newConfigMap("unchanged", "value"))).To(Succeed())
+	g.Expect(tc.ApplyOwned(ctx, newConfigMap("changed", "old"))).To(Succeed())
+	g.Expect(tc.ApplyOwned(ctx, newConfigMap("orphan", "value"))).To(Succeed())
+
+	ownership.Plan = true
+	planner := NewClientWithOwnership(fakeClient, ownership)
+	g.Expect(planner.Planning()).To(BeTrue())
+	g.Expect(planner.ApplyOwned(ctx, newConfigMap("unchanged", "value"))).To(Succeed())
+	g.Expect(planner.ApplyOwned(ctx, newConfigMap("changed", "new"))).To(Succeed())
+	g.Expect(planner.ApplyOwned(ctx, newConfigMap("created", "value"))).To(Succeed())
+	g.Expect(planner.CleanupOrphans(ctx, testOwnerLabel, testOwnerValue, []schema.GroupVersionKind{configMapGVK})).To(Succeed())
+
+	key := func(name string) ResourceKey {
+		return ResourceKey{GVK: configMapGVK, Namespace: testNamespace, Name: name}
+	}
+	g.Expect(planner.Plan()).To(Equal(Plan{
+		{Key: key("changed"), Action: ActionUpdate, Diff: []string{`~ data.key: "old" -> "new"`}},
+		{Key: key("created"), Action: ActionCreate},
+		{Key: key("orphan"), Action: ActionDelete},
+		{Key: key("unchanged"), Action: ActionUnchanged},
+	}))
+	g.Expect(planner.Plan().Summary()).To(Equal("1 to create, 1 to update, 1 to delete, 1 unchanged"))
+
+	// Nothing was changed.
+	changed := &corev1.ConfigMap{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "changed"}, changed)).To(Succeed())
+	g.Expect(changed.Data).To(HaveKeyWithValue("key", "old"))
+	err := fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "created"}, &corev1.ConfigMap{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "orphan"}, &corev1.ConfigMap{})).To(Succeed())
+}
+
+func TestClient_Plan_DeleteAndWriter(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := setupScheme(g)
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "old-hashed", Namespace: testNamespace}}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+
+	tc := NewClientWithOwnership(fakeClient, OwnershipConfig{Owner: cm, Plan: true})
+	g.Expect(tc.Writer(fakeClient)).To(BeIdenticalTo(tc))
+	g.Expect(tc.Delete(ctx, cm.DeepCopy())).To(Succeed())
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})).To(Succeed())
+	g.Expect(tc.Plan()).To(Equal(Plan{{
+		Key:    ResourceKey{GVK: configMapGVK, Namespace: testNamespace, Name: "old-hashed"},
+		Action: ActionDelete,
+	}}))
+
+	regular := NewClientWithOwnership(fakeClient, OwnershipConfig{Owner: cm})
+	g.Expect(regular.Planning()).To(BeFalse())
+	g.Expect(regular.Writer(fakeClient)).To(BeIdenticalTo(fakeClient))
+	g.Expect(regular.Delete(ctx, cm.DeepCopy())).To(Succeed())
+	err := fakeClient.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestDiffObjects(t *testing.T) {
+	live := map[string]any{
+		"metadata": map[string]any{"name": "controller", "labels": map[string]any{"app": "controller"}},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"containers": []any{
+				map[string]any{"name": "manager", "image": "manager:v1"},
+				map[string]any{"name": "sidecar", "image": "sidecar:v1"},
+			},
+			"paused": true,
+		},
+	}
+	result := map[string]any{
+		"metadata": map[string]any{"name": "controller", "labels": map[string]any{"app": "controller"}},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"containers": []any{
+				map[string]any{"name": "sidecar", "image": "sidecar:v1"},
+				map[string]any{"name": "manager", "image": "manager:v2", "args": []any{"--leader-elect"}},
+			},
+		},
+	}
+
+	t.Run("complete results report removed fields", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(diffObjects(live, result, true, false)).To(Equal([]string{
+			`+ spec.containers[name=manager].args: ["--leader-elect"]`,
+			`~ spec.containers[name=manager].image: "manager:v1" -> "manager:v2"`,
+			`- spec.paused`,
+			`~ spec.replicas: 1 -> 2`,
+		}))
+	})
+
+	t.Run("partial results only report the fields they set", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(diffObjects(live, result, false, false)).NotTo(ContainElement(`- spec.paused`))
+	})
+
+	t.Run("secret values are redacted", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(diffObjects(
+			map[string]any{"data": map[string]any{"password": "b2xk"}},
+			map[string]any{"data": map[string]any{"password": "bmV3"}, "type": "Opaque"},
+			true, true,
+		)).To(Equal([]string{
+			`~ data.password: (redacted) -> (redacted)`,
+			`+ type: "Opaque"`,
+		}))
+	})
+
+	t.Run("long diffs are bounded", func(t *testing.T) {
+		g := NewWithT(t)
+		before, after := map[string]any{}, map[string]any{}
+		for i := range maxPlannedDiffLines + 5 {
+			k := strings.Repeat("k", i+1)
+			before[k], after[k] = "a", strings.Repeat("b", 2*maxPlannedValueLength)
+		}
+		lines := diffObjects(before, after, true, false)
+		g.Expect(lines).To(HaveLen(maxPlannedDiffLines + 1))
+		g.Expect(lines[0]).To(HaveSuffix("..."))
+		g.Expect(lines[maxPlannedDiffLines]).To(Equal("... 5 more changed fields"))
+	})
+}
+
+func TestPlan_String(t *testing.T) {
+	g := NewWithT(t)
+	key := func(name string) ResourceKey {
+		return ResourceKey{GVK: configMapGVK, Namespace: testNamespace, Name: name}
+	}
+	plan := Plan{
+		{Key: key("a"), Action: ActionCreate},
+		{Key: key("b"), Action: ActionUpdate, Diff: []string{`~ data.key: "old" -> "new"`}},
+		{Key: key("c"), Action: ActionDelete},
+		{Key: key("d"), Action: ActionUnchanged},
+	}
+	g.Expect(plan.HasChanges()).To(BeTrue())
+	g.Expect(plan.String()).To(Equal(
+		"+ " + key("a").String() + "\n" +
+			"~ " + key("b").String() + "\n" +
+			"    ~ data.key: \"old\" -> \"new\"\n" +
+			"- " + key("c").String() + "\n" +
+			"\n" +
+			"1 to create, 1 to update, 1 to delete, 1 unchanged.\n"))
+
+	g.Expect(Plan{{Key: key("d"), Action: ActionUnchanged}}.HasChanges()).To(BeFalse())
+	g.Expect(Plan{}.String()).To(Equal("0 to create, 0 to update, 0 to delete, 0 unchanged.\n"))
+}
@@ -54,16 +54,21 @@ limitations under the License.
 //	if err := tc.ApplyObject(ctx, deployment, fieldManager); err != nil {
 //	    return ctrl.Result{}, err
 //	}
+//
+// With OwnershipConfig.Plan set, the same reconcile changes nothing: writes become server-side
+// dry runs and orphans are only recorded, and Plan returns what would have changed.
 package tracking
 
 import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"sync"
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -71,7 +76,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -151,8 +158,33 @@ type OwnershipConfig struct {
 	Component string
 	// FieldManager identifies this controller for server-side apply
 	FieldManager string
+	// Annotations are set on every owned object in addition to the ownership labels.
+	Annotations map[string]string
+	// DetectDrift makes ApplyOwned compare the managedFields of each existing object before
+	// and after the apply and record the objects other field managers changed; see Drifts.
+	// Applied objects carry the AppliedHashAnnotation.
+	DetectDrift bool
+	// LiveMetadata, if set, serves the live metadata drift detection reads before each apply;
+	// otherwise it is read from the API server.
+	LiveMetadata *LiveMetadata
+	// Recorder, if set, emits an Event on Owner for every orphaned resource CleanupOrphans deletes.
+	Recorder events.EventRecorder
+	// Plan makes the client compute what the reconcile would change without changing anything:
+	// writes become server-side dry runs, CleanupOrphans only records the orphans it would
+	// delete, and the changes are returned by Plan.
+	Plan bool
+	// ApplyCache, if set, lets ApplyOwned skip objects that are unchanged since it last applied
+	// them; see ApplyCache. It is not used in plan mode.
+	ApplyCache *ApplyCache
+	// MaxConcurrentApplies bounds how many objects ApplyOwnedBatch applies at a time.
+	// Zero means DefaultMaxConcurrentApplies.
+	MaxConcurrentApplies int
 }
 
+// EventReasonOrphanDeleted is the reason of the Event emitted on the owner when
+// CleanupOrphans deletes a resource that was not applied during the reconcile.
+const EventReasonOrphanDeleted = "OrphanDeleted"
+
 // Client wraps a controller-runtime client and tracks all resources that are
 // applied during a reconciliation. This enables cleanup of orphaned resources
 // at the end of a successful reconcile.
@@ -163,7 +195,11 @@ type Client struct {
 	client.Client
 	ownership *OwnershipConfig
 	tracked   map[ResourceKey]struct{}
-	mu        sync.Mutex
+	drifts    []Drift
+	planned   map[ResourceKey]PlannedChange
+	// cleanupResults records what CleanupOrphans did with orphaned resources.
+	cleanupResults []CleanupResult
+	mu             sync.Mutex
 }
 
 // NewClient creates a new tracking client wrapping the given client.
@@ -206,6 +242,11 @@ func (c *Client) ApplyObject(
 	opts ...client.PatchOption,
 ) error {
 	patchOpts := append([]client.PatchOption{client.FieldOwner(fieldManager), client.ForceOwnership}, opts...)
+	if c.Planning() {
+		return c.planWrite(ctx, obj, func() error {
+			return c.Client.Patch(ctx, obj, kubernetes.SSAPatch, append(patchOpts, client.DryRunAll)...)
+		})
+	}
 	if err := c.Client.Patch(ctx, obj, kubernetes.SSAPatch, patchOpts...); err != nil {
 		return err
 	}
@@ -216,11 +257,76 @@ func (c *Client) ApplyObject(
 // ApplyOwned sets ownership (labels + owner reference) on the object and applies it
 // using server-side apply. The client must be created with NewClientWithOwnership.
 // This combines SetOwnership + ApplyObject into a single call for cleaner reconciler code.
+// With OwnershipConfig.ApplyCache set, an object that is unchanged since the last apply is
+// only tracked, and obj is not updated from the server.
 func (c *Client) ApplyOwned(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 	if err := c.SetOwnership(obj); err != nil {
 		return err
 	}
-	return c.ApplyObject(ctx, obj, c.ownership.FieldManager, opts...)
+	if c.ownership.DetectDrift {
+		if err := setAppliedHash(obj); err != nil {
+			return fmt.Errorf("failed to hash %s: %w", obj.GetName(), err)
+		}
+	}
+
+	// Only plain applies are cached: options such as a dry run change what the apply does.
+	cache := c.ownership.ApplyCache
+	if c.ownership.Plan || len(opts) > 0 {
+		cache = nil
+	}
+	var cacheKey applyCacheKey
+	var hash string
+	if cache != nil {
+		gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+		if err != nil {
+			return fmt.Errorf("failed to determine GVK: %w", err)
+		}
+		cacheKey = applyCacheKey{
+			ResourceKey:  ResourceKey{GVK: gvk, Namespace: obj.GetNamespace(), Name: obj.GetName()},
+			fieldManager: c.ownership.FieldManager,
+		}
+		if hash, err = hashObject(obj); err != nil {
+			return fmt.Errorf("failed to hash %s: %w", cacheKey.String(), err)
+		}
+		if cache.unchanged(ctx, cacheKey, hash) {
+			c.track(obj)
+			return nil
+		}
+	}
+
+	// A plan reports the whole diff, which includes any drift.
+	// Drift detection is best effort and must never block the apply that corrects it.
+	detectDrift := c.ownership.DetectDrift && !c.ownership.Plan
+	var before metav1.Object
+	if detectDrift {
+		var err error
+		if before, err = c.liveMetadata(ctx, obj); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to detect drift", "resource", obj.GetName())
+			detectDrift = false
+		}
+	}
+	if err := c.ApplyObject(ctx, obj, c.ownership.FieldManager, opts...); err != nil {
+		if cache != nil {
+			cache.forget(cacheKey)
+		}
+		return err
+	}
+	if detectDrift {
+		drift, err := c.detectDrift(obj, before, c.ownership.FieldManager)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to detect drift", "resource", obj.GetName())
+		} else if drift != nil {
+			logf.FromContext(ctx).Info("Reverted drift of managed resource",
+				"resource", drift.Key.String(), "managers", drift.Managers)
+			c.mu.Lock()
+			c.drifts = append(c.drifts, *drift)
+			c.mu.Unlock()
+		}
+	}
+	if cache != nil {
+		cache.remember(cacheKey, hash, obj.GetResourceVersion())
+	}
+	return nil
 }
 
 // SetOwnership sets ownership labels and owner reference on the object without applying it.
@@ -241,6 +347,15 @@ func (c *Client) SetOwnership(obj client.Object) error {
 	labels[c.ownership.ComponentLabelKey] = c.ownership.Component
 	obj.SetLabels(labels)
 
+	if len(c.ownership.Annotations) > 0 {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string, len(c.ownership.Annotations))
+		}
+		maps.Copy(annotations, c.ownership.Annotations)
+		obj.SetAnnotations(annotations)
+	}
+
 	if kubernetes.IsCustomResourceDefinition(obj) {
 		return nil
 	}
@@ -256,6 +371,11 @@ func (c *Client) SetOwnership(obj client.Object) error {
 // Patch applies a patch to an object and tracks it if the patch succeeds.
 // This overrides the embedded client's Patch method to add tracking.
 func (c *Client) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if c.Planning() {
+		return c.planWrite(ctx, obj, func() error {
+			return c.Client.Patch(ctx, obj, patch, append(opts, client.DryRunAll)...)
+		})
+	}
 	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
 		return err
 	}
@@ -268,6 +388,11 @@ func (c *Client) Patch(ctx context.Context, obj client.Object, patch client.Patc
 // Note: If the object already exists (AlreadyExists error), it is still tracked to prevent
 // orphan cleanup from deleting it in subsequent reconcile attempts.
 func (c *Client) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if c.Planning() {
+		return c.planWrite(ctx, obj, func() error {
+			return c.Client.Create(ctx, obj, append(opts, client.DryRunAll)...)
+		})
+	}
 	err := c.Client.Create(ctx, obj, opts...)
 	if err == nil || apierrors.IsAlreadyExists(err) {
 		c.track(obj)
@@ -278,6 +403,11 @@ func (c *Client) Create(ctx context.Context, obj client.Object, opts ...client.C
 // Update updates an object and tracks it if the update succeeds.
 // This overrides the embedded client's Update method to add tracking.
 func (c *Client) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if c.Planning() {
+		return c.planWrite(ctx, obj, func() error {
+			return c.Client.Update(ctx, obj, append(opts, client.DryRunAll)...)
+		})
+	}
 	if err := c.Client.Update(ctx, obj, opts...); err != nil {
 		return err
 	}
@@ -294,6 +424,15 @@ func (c *Client) CreateOrUpdate(
 	obj client.Object,
 	f controllerutil.MutateFn,
 ) (controllerutil.OperationResult, error) {
+	if c.Planning() {
+		var result controllerutil.OperationResult
+		err := c.planWrite(ctx, obj, func() error {
+			var err error
+			result, err = controllerutil.CreateOrUpdate(ctx, client.NewDryRunClient(c.Client), obj, f)
+			return err
+		})
+		return result, err
+	}
 	result, err := controllerutil.CreateOrUpdate(ctx, c.Client, obj, f)
 	if err != nil {
 		return result, err
@@ -302,6 +441,30 @@ func (c *Client) CreateOrUpdate(
 	return result, nil
 }
 
+// Delete deletes an object. In plan mode the deletion is only recorded.
+func (c *Client) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	if c.Planning() {
+		gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+		if err != nil {
+			return fmt.Errorf("failed to determine GVK: %w", err)
+		}
+		c.recordPlanned(PlannedChange{
+			Key:    ResourceKey{GVK: gvk, Namespace: obj.GetNamespace(), Name: obj.GetName()},
+			Action: ActionDelete,
+		})
+		return nil
+	}
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+// Keep marks a resource as part of the desired state without writing it, so that
+// CleanupOrphans leaves an existing copy in place. Use it for resources whose apply
+// is deferred in this reconcile (e.g. waiting on a prerequisite) but that must not
+// be treated as orphans.
+func (c *Client) Keep(obj client.Object) {
+	c.track(obj)
+}
+
 // track adds a resource to the tracked set.
 func (c *Client) track(obj client.Object) {
 	c.mu.Lock()
@@ -357,8 +520,18 @@ type CleanupOptions struct {
 	// This is a security measure to prevent deletion of arbitrary cluster resources
 	// that an attacker might have labeled with the owner label.
 	ClusterScopedAllowList ClusterScopedAllowList
+	// DeletionPolicies sets what happens to orphaned resources of the listed GVKs instead of
+	// being deleted; see DeletionPolicy. A resource can override it with DeletionPolicyAnnotation.
+	DeletionPolicies DeletionPolicies
+	// Namespaces restricts the cleanup of the listed GVKs to their namespaces; resources of
+	// other GVKs are listed in all namespaces.
+	Namespaces CleanupNamespaces
 }
 
+// CleanupNamespaces maps a namespaced GVK to the only namespaces in which its orphans are
+// cleaned up. Listing a GVK with no namespaces disables its cleanup.
+type CleanupNamespaces map[schema.GroupVersionKind][]string
+
 // CleanupOption is a functional option for configuring CleanupOrphans.
 type CleanupOption func(*CleanupOptions)
 
@@ -371,6 +544,15 @@ func WithClusterScopedAllowList(allowList ClusterScopedAllowList) CleanupOption
 	}
 }
 
+// WithCleanupNamespaces restricts the cleanup of some GVKs to the given namespaces. This keeps
+// the cleanup of kinds that other controllers also label, such as Secrets, from listing and
+// deleting them across the cluster.
+func WithCleanupNamespaces(namespaces CleanupNamespaces) CleanupOption {
+	return func(opts *CleanupOptions) {
+		opts.Namespaces = namespaces
+	}
+}
+
 // CleanupOrphans deletes resources that have the specified owner label but were
 // not applied during this reconcile. Only resources matching the provided GVKs
 // are considered for cleanup.
@@ -439,16 +621,26 @@ func (c *Client) cleanupOrphansForGVK(
 		Kind:    gvk.Kind + "List",
 	})
 
-	// List all resources with the owner label
-	if err := c.List(ctx, list, client.MatchingLabels{
-		ownerLabelKey: ownerLabelValue,
-	}); err != nil {
-		// If the CRD doesn't exist (e.g., ConsoleLink on non-OpenShift), skip cleanup
-		if meta.IsNoMatchError(err) {
-			log.V(1).Info("Skipping cleanup for GVK (CRD not installed)", "gvk", gvk.String())
-			return nil
+	// List all resources with the owner label, in the namespaces the cleanup is restricted to
+	listOpts := [][]client.ListOption{{}}
+	if namespaces, ok := options.Namespaces[gvk]; ok {
+		listOpts = nil
+		for _, namespace := range namespaces {
+			listOpts = append(listOpts, []client.ListOption{client.InNamespace(namespace)})
+		}
+	}
+	for _, opts := range listOpts {
+		page := &unstructured.UnstructuredList{}
+		page.SetGroupVersionKind(list.GroupVersionKind())
+		if err := c.List(ctx, page, append(opts, client.MatchingLabels{ownerLabelKey: ownerLabelValue})...); err != nil {
+			// If the CRD doesn't exist (e.g., ConsoleLink on non-OpenShift), skip cleanup
+			if meta.IsNoMatchError(err) {
+				log.V(1).Info("Skipping cleanup for GVK (CRD not installed)", "gvk", gvk.String())
+				return nil
+			}
+			return fmt.Errorf("failed to list resources: %w", err)
 		}
-		return fmt.Errorf("failed to list resources: %w", err)
+		list.Items = append(list.Items, page.Items...)
 	}
 
 	// Delete resources that weren't tracked this reconcile
@@ -484,6 +676,20 @@ func (c *Client) cleanupOrphansForGVK(
 				continue
 			}
 
+			policy := options.DeletionPolicies.policyFor(gvk, item)
+			retained, err := c.handleRetainedOrphan(ctx, item, key, policy, ownerLabelKey)
+			if err != nil {
+				return err
+			}
+			if retained {
+				continue
+			}
+
+			if c.Planning() {
+				c.recordPlanned(PlannedChange{Key: key, Action: ActionDelete})
+				continue
+			}
+
 			log.Info("Deleting orphaned resource",
 				"gvk", gvk.String(),
 				"resource", key.String(),
@@ -494,6 +700,9 @@ func (c *Client) cleanupOrphansForGVK(
 				}
 				// Resource already deleted, continue
 			}
+			c.recordCleanup(CleanupResult{Key: key, Policy: policy, Outcome: CleanupDeleted})
+			c.emitCleanupEvent(item, corev1.EventTypeNormal, EventReasonOrphanDeleted, "Delete",
+				"Deleted orphaned resource %s", key.String())
 		}
 	}
 
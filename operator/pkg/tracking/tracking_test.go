@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -413,6 +414,80 @@ func TestClient_CleanupOrphans(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 }
 
+func TestClient_CleanupOrphans_WithCleanupNamespaces(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	orphan := func(namespace string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "orphan",
+				Namespace: namespace,
+				Labels:    map[string]string{testOwnerLabel: testOwnerValue},
+			},
+		}
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(setupScheme(g)).
+		WithObjects(orphan(testNamespace), orphan("other-namespace")).
+		Build()
+	tc := NewClient(fakeClient)
+
+	err := tc.CleanupOrphans(ctx, testOwnerLabel, testOwnerValue, []schema.GroupVersionKind{configMapGVK},
+		WithCleanupNamespaces(CleanupNamespaces{configMapGVK: {testNamespace}}))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Only the orphan in the listed namespace is deleted
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "orphan"}, &corev1.ConfigMap{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "expected NotFound error")
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: "other-namespace", Name: "orphan"}, &corev1.ConfigMap{})
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestClient_Keep(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := setupScheme(g)
+
+	existing := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deferred",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				testOwnerLabel: testOwnerValue,
+			},
+			ResourceVersion: "1",
+		},
+		Data: map[string]string{"key": "old"},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(existing).
+		Build()
+	tc := NewClient(fakeClient)
+
+	// Keep a resource without applying it; the desired data must not be written.
+	tc.Keep(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "deferred", Namespace: testNamespace},
+		Data:       map[string]string{"key": "new"},
+	})
+	g.Expect(tc.IsTracked(configMapGVK, testNamespace, "deferred")).To(BeTrue())
+
+	err := tc.CleanupOrphans(ctx, testOwnerLabel, testOwnerValue, []schema.GroupVersionKind{configMapGVK})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var kept corev1.ConfigMap
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "deferred"}, &kept)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(kept.Data).To(HaveKeyWithValue("key", "old"))
+}
+
 func TestClient_CleanupOrphans_ClusterScoped(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
@@ -813,6 +888,37 @@ func TestClient_SetOwnership_PreservesExistingLabels(t *testing.T) {
 	g.Expect(cm.Labels).To(HaveKeyWithValue(testComponentLabel, testComponent))
 }
 
+func TestClient_SetOwnership_SetsAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := setupScheme(g)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	owner := createTestOwner(g, fakeClient)
+
+	tc := NewClientWithOwnership(fakeClient, OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     testOwnerLabel,
+		ComponentLabelKey: testComponentLabel,
+		Component:         testComponent,
+		FieldManager:      testFieldManager,
+		Annotations:       map[string]string{"example.com/version": "v1.2.3"},
+	})
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "owned-cm",
+			Namespace:   testNamespace,
+			Annotations: map[string]string{"existing-annotation": "existing-value"},
+		},
+	}
+
+	err := tc.SetOwnership(cm)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(cm.Annotations).To(HaveKeyWithValue("existing-annotation", "existing-value"))
+	g.Expect(cm.Annotations).To(HaveKeyWithValue("example.com/version", "v1.2.3"))
+}
+
 func TestClient_SetOwnership_ErrorWithoutOwnershipConfig(t *testing.T) {
 	g := NewWithT(t)
 
@@ -1459,6 +1565,49 @@ func TestClient_CleanupOrphans_DeletesResourcesWithCorrectOwnerReference(t *test
 	g.Expect(errors.IsNotFound(err)).To(BeTrue(), "ConfigMap should be deleted - it has correct owner reference")
 }
 
+// TestClient_CleanupOrphans_RecordsEvent verifies that deleting an orphan emits an Event on the owner.
+func TestClient_CleanupOrphans_RecordsEvent(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := setupScheme(g)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	owner := createTestOwner(g, fakeClient)
+	recorder := events.NewFakeRecorder(10)
+
+	tc := NewClientWithOwnership(fakeClient, OwnershipConfig{
+		Owner:             owner,
+		OwnerLabelKey:     testOwnerLabel,
+		ComponentLabelKey: testComponentLabel,
+		Component:         testComponent,
+		FieldManager:      testFieldManager,
+		Recorder:          recorder,
+	})
+
+	kept := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "kept-cm", Namespace: testNamespace},
+	}
+	g.Expect(tc.ApplyOwned(ctx, kept)).To(Succeed())
+
+	orphan := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "orphan-cm",
+			Namespace: testNamespace,
+			Labels:    map[string]string{testOwnerLabel: testOwnerValue},
+		},
+	}
+	g.Expect(controllerutil.SetControllerReference(owner, orphan, scheme)).To(Succeed())
+	g.Expect(fakeClient.Create(ctx, orphan)).To(Succeed())
+
+	err := tc.CleanupOrphans(ctx, testOwnerLabel, testOwnerValue, []schema.GroupVersionKind{configMapGVK})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(recorder.Events).To(Receive(Equal(
+		"Normal OrphanDeleted Deleted orphaned resource ConfigMap/test-namespace/orphan-cm")))
+	g.Expect(recorder.Events).NotTo(Receive())
+}
+
 // TestClient_CleanupOrphans_WithoutOwnershipConfig verifies that when no ownership config
 // is set, the owner reference check is skipped (backward compatibility).
 func TestClient_CleanupOrphans_WithoutOwnershipConfig(t *testing.T) {
@@ -388,7 +388,7 @@ case "${INSTALL_METHOD}" in
         RELEASE_URL="$(release_asset_url "${OPERATOR_RELEASE}" "install.yaml")"
         echo "Installing from GitHub release (${OPERATOR_RELEASE})..."
         echo "Downloading: ${RELEASE_URL}"
-        kubectl apply -f "${RELEASE_URL}"
+        kubectl apply --server-side -f "${RELEASE_URL}"
         ;;
 
     none)